@@ -19,6 +19,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/y"
@@ -92,6 +93,48 @@ func (txn *Txn) QueryWithVars(ctx context.Context, q string,
 	return resp, err
 }
 
+// QueryStream is like QueryWithVars, but the results are streamed back from the
+// server. recv is called with the JSON of a page of root nodes of a query block at
+// a time, e.g. {"me": [...]}, so large results can be consumed with bounded memory.
+func (txn *Txn) QueryStream(ctx context.Context, q string, vars map[string]string,
+	recv func(json []byte) error) error {
+	if txn.finished {
+		return ErrFinished
+	}
+	req := &protos.Request{
+		Query:   q,
+		Vars:    vars,
+		StartTs: txn.context.StartTs,
+		LinRead: txn.context.LinRead,
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	dc := txn.dg.anyClient()
+	stream, err := dc.QueryStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if resp.Txn != nil {
+			// The last response only has the transaction context.
+			if err := txn.mergeContext(resp.Txn); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := recv(resp.Json); err != nil {
+			return err
+		}
+	}
+}
+
 func (txn *Txn) mergeContext(src *protos.TxnContext) error {
 	if src == nil {
 		return nil
@@ -101,7 +101,7 @@ func newLoader(opt options) *loader {
 		mappers: make([]*mapper, opt.NumGoroutines),
 	}
 	for i := 0; i < opt.NumGoroutines; i++ {
-		ld.mappers[i] = newMapper(st)
+		ld.mappers[i] = newMapper(st, uint64(i+1))
 	}
 	go ld.prog.report()
 	return ld
@@ -27,6 +27,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -37,6 +38,7 @@ import (
 	"github.com/dgraph-io/dgraph/rdf"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 	farm "github.com/dgryski/go-farm"
 	"github.com/gogo/protobuf/proto"
@@ -46,6 +48,13 @@ import (
 type mapper struct {
 	*state
 	shards []shardState // shard is based on predicate
+	id     uint64       // Distinguishes the full-text totals of the mappers.
+	// The full-text totals of the values mapped, by predicate and shard of the uids.
+	docStats map[string]*[posting.DocStatsShards]docStats
+}
+
+type docStats struct {
+	numDocs, length int
 }
 
 type shardState struct {
@@ -55,10 +64,12 @@ type shardState struct {
 	mu         sync.Mutex // Allow only 1 write per shard at a time.
 }
 
-func newMapper(st *state) *mapper {
+func newMapper(st *state, id uint64) *mapper {
 	return &mapper{
-		state:  st,
-		shards: make([]shardState, st.opt.MapShards),
+		state:    st,
+		shards:   make([]shardState, st.opt.MapShards),
+		id:       id,
+		docStats: make(map[string]*[posting.DocStatsShards]docStats),
 	}
 }
 
@@ -141,6 +152,7 @@ func (m *mapper) run() {
 			}
 		}
 	}
+	m.addDocStatsEntries()
 	for i := range m.shards {
 		sh := &m.shards[i]
 		if len(sh.entriesBuf) > 0 {
@@ -318,16 +330,77 @@ func (m *mapper) addIndexMapEntries(nq gql.NQuad, de *protos.DirectedEdge) {
 		toks, err := tok.BuildTokens(schemaVal.Value, toker)
 		x.Check(err)
 
+		// Full-text postings keep the statistics used to score matches.
+		var freqs map[string]int
+		if ft, ok := toker.(tok.FullTextTokenizer); ok {
+			var length int
+			freqs, length, err = ft.TermFrequencies(schemaVal.Value)
+			x.Check(err)
+			if length > 0 {
+				m.addMapEntry(
+					x.IndexKey(nq.Predicate, tok.DocLengthToken(de.GetEntity())),
+					&protos.Posting{
+						Uid:         de.GetEntity(),
+						PostingType: protos.Posting_REF,
+						Facets:      []*protos.Facet{intFacet(posting.DocLengthFacet, length)},
+					},
+					m.state.shards.shardFor(nq.Predicate),
+				)
+				stats, ok := m.docStats[nq.Predicate]
+				if !ok {
+					stats = new([posting.DocStatsShards]docStats)
+					m.docStats[nq.Predicate] = stats
+				}
+				st := &stats[de.GetEntity()%posting.DocStatsShards]
+				st.numDocs++
+				st.length += length
+			}
+		}
+
 		// Store index posting.
 		for _, t := range toks {
+			p := &protos.Posting{
+				Uid:         de.GetEntity(),
+				PostingType: protos.Posting_REF,
+			}
+			if freq, ok := freqs[t]; ok {
+				p.Facets = []*protos.Facet{intFacet(posting.TermFrequencyFacet, freq)}
+			}
 			m.addMapEntry(
 				x.IndexKey(nq.Predicate, t),
+				p,
+				m.state.shards.shardFor(nq.Predicate),
+			)
+		}
+	}
+}
+
+// addDocStatsEntries adds the full-text totals of the values mapped. Every mapper has its
+// own posting in the lists of the totals, whose values are added up when they are read.
+func (m *mapper) addDocStatsEntries() {
+	for pred, stats := range m.docStats {
+		for i, st := range stats {
+			if st.numDocs == 0 {
+				continue
+			}
+			m.addMapEntry(
+				x.IndexKey(pred, tok.DocStatsToken(i)),
 				&protos.Posting{
-					Uid:         de.GetEntity(),
+					Uid:         m.id,
 					PostingType: protos.Posting_REF,
+					Facets: []*protos.Facet{
+						intFacet(posting.DocLengthFacet, st.length),
+						intFacet(posting.NumDocsFacet, st.numDocs),
+					},
 				},
-				m.state.shards.shardFor(nq.Predicate),
+				m.state.shards.shardFor(pred),
 			)
 		}
 	}
 }
+
+func intFacet(key string, val int) *protos.Facet {
+	f, err := facets.FacetFor(key, strconv.Itoa(val))
+	x.Check(err)
+	return f
+}
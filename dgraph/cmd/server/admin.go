@@ -19,6 +19,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -119,3 +120,35 @@ func memoryLimitGetHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
+
+// queriesHandler lists the queries which are being processed by this server.
+func queriesHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r) {
+		return
+	}
+	js, err := json.Marshal(edgraph.ActiveQueries())
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
+
+// killQueryHandler cancels the in-flight query with the id given in the request.
+func killQueryHandler(w http.ResponseWriter, r *http.Request) {
+	if !handlerInit(w, r) {
+		return
+	}
+	id, err := strconv.ParseUint(r.URL.Query().Get("id"), 0, 64)
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, "Invalid query id")
+		return
+	}
+	if !edgraph.KillQuery(id) {
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("No query with id: %d", id))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(`{"code": "Success", "message": "Query killed."}`))
+}
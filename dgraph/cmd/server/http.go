@@ -27,6 +27,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/gql"
@@ -105,24 +106,80 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	req.Query = string(q)
 
+	// The timeout and result budget set on the server can be overridden per request.
+	if t := r.URL.Query().Get("timeout"); t != "" {
+		d, err := time.ParseDuration(t)
+		if err != nil || d <= 0 {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid timeout: "+t)
+			return
+		}
+		req.TimeoutMs = uint64(d / time.Millisecond)
+		if req.TimeoutMs == 0 {
+			req.TimeoutMs = 1
+		}
+	}
+	if b := r.URL.Query().Get("budget"); b != "" {
+		if req.ResultBudget, err = strconv.ParseUint(b, 0, 64); err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid budget: "+b)
+			return
+		}
+	}
+
+	// The result can be asked for as N-Quads, CSV or protocol buffers instead of JSON.
+	req.Output = r.URL.Query().Get("output")
+
 	d := r.URL.Query().Get("debug")
+	// The plan can be asked for using either debug=explain or explain=true.
+	req.Explain = d == "explain" || r.URL.Query().Get("explain") == "true"
 	ctx := context.WithValue(context.Background(), "debug", d)
+	if r.URL.Query().Get("stream") == "true" {
+		streamQuery(ctx, w, &req)
+		return
+	}
 	resp, err := (&edgraph.Server{}).Query(ctx, &req)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
 
+	switch req.Output {
+	case query.OutputRDF:
+		w.Header().Set("Content-Type", "application/n-quads")
+		w.Write(resp.Rdf)
+		return
+	case query.OutputCSV:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write(resp.Csv)
+		return
+	case query.OutputProto:
+		// The whole response is sent, so that the client gets the txn and latency too.
+		b, err := resp.Marshal()
+		if err != nil {
+			x.SetStatusWithData(w, x.Error, "Unable to marshal response")
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.Write(b)
+		return
+	}
+
 	response := map[string]interface{}{}
 
 	e := query.Extensions{
 		Txn:     resp.Txn,
 		Latency: resp.Latency,
+		Plan:    resp.Plan,
+	}
+	if len(resp.Cursors) > 0 {
+		e.Cursors = make(map[string]string)
+		for _, c := range resp.Cursors {
+			e.Cursors[c.Alias] = c.Cursor
+		}
 	}
 	response["extensions"] = e
 
 	// User can either ask for schema or have a query.
-	if len(resp.Schema) > 0 {
+	if len(resp.Schema) > 0 || len(resp.Types) > 0 {
 		sort.Slice(resp.Schema, func(i, j int) bool {
 			return resp.Schema[i].Predicate < resp.Schema[j].Predicate
 		})
@@ -133,6 +190,9 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		}
 		mp := map[string]interface{}{}
 		mp["schema"] = json.RawMessage(string(js))
+		if len(resp.Types) > 0 {
+			mp["types"] = resp.Types
+		}
 		response["data"] = mp
 	} else {
 		response["data"] = json.RawMessage(string(resp.Json))
@@ -145,6 +205,55 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// streamQuery writes the results of the query using chunked transfer encoding, as
+// newline delimited JSON. Every line has the "data" of a page of root nodes of a
+// query block, the last line has the "extensions". An error after the first line is
+// sent as the last line, with the "errors".
+func streamQuery(ctx context.Context, w http.ResponseWriter, req *protos.Request) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := w.(http.Flusher)
+	var started bool
+	err := (&edgraph.Server{}).StreamQuery(ctx, req, func(resp *protos.Response) error {
+		response := map[string]interface{}{}
+		if resp.Json != nil {
+			response["data"] = json.RawMessage(resp.Json)
+		} else {
+			response["extensions"] = query.Extensions{
+				Txn:     resp.Txn,
+				Latency: resp.Latency,
+			}
+		}
+		js, err := json.Marshal(response)
+		if err != nil {
+			return err
+		}
+		started = true
+		if _, err := w.Write(append(js, '\n')); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err == nil {
+		return
+	}
+	if !started {
+		w.Header().Set("Content-Type", "application/json")
+		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	// The status and the results already sent can't be taken back, the error follows them.
+	js, merr := json.Marshal(map[string]interface{}{
+		"errors": []map[string]string{{"code": x.ErrorInvalidRequest, "message": err.Error()}},
+	})
+	if merr != nil {
+		return
+	}
+	w.Write(append(js, '\n'))
+}
+
 func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -216,6 +325,9 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	mp["code"] = x.Success
 	mp["message"] = "Done"
 	mp["uids"] = resp.Uids
+	if len(mu.Cond) > 0 {
+		mp["cond_passed"] = resp.CondPassed
+	}
 	response["data"] = mp
 
 	js, err := json.Marshal(response)
@@ -86,6 +86,11 @@ func init() {
 	flag.BoolVar(&config.ExpandEdge, "expand_edge", defaults.ExpandEdge,
 		"Enables the expand() feature. This is very expensive for large data loads because it"+
 			" doubles the number of mutations going on in the system.")
+	flag.DurationVar(&config.QueryTimeout, "query_timeout", defaults.QueryTimeout,
+		"Maximum time a query can run for, 0 means no limit. Can be overridden per request.")
+	flag.Uint64Var(&config.QueryBudget, "query_budget", defaults.QueryBudget,
+		"Maximum number of uids and values a query can fetch, 0 means no limit."+
+			" Can be overridden per request.")
 
 	flag.Float64Var(&config.AllottedMemory, "memory_mb", defaults.AllottedMemory,
 		"Estimated memory the process can take. "+
@@ -256,6 +261,8 @@ func setupServer() {
 	http.HandleFunc("/debug/store", storeStatsHandler)
 	http.HandleFunc("/admin/shutdown", shutDownHandler)
 	http.HandleFunc("/admin/export", exportHandler)
+	http.HandleFunc("/admin/queries", queriesHandler)
+	http.HandleFunc("/admin/queries/kill", killQueryHandler)
 	http.HandleFunc("/admin/config/memory_mb", memoryLimitHandler)
 
 	// UI related API's.
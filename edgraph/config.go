@@ -19,6 +19,7 @@ package edgraph
 import (
 	"expvar"
 	"path/filepath"
+	"time"
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/worker"
@@ -42,6 +43,9 @@ type Options struct {
 	MaxPendingCount     uint64
 	ExpandEdge          bool
 
+	QueryTimeout time.Duration
+	QueryBudget  uint64
+
 	ConfigFile string
 	DebugMode  bool
 }
@@ -66,6 +70,9 @@ var DefaultConfig = Options{
 	MaxPendingCount:     1000,
 	ExpandEdge:          true,
 
+	QueryTimeout: 0,
+	QueryBudget:  0,
+
 	ConfigFile: "",
 	DebugMode:  false,
 }
@@ -110,6 +117,8 @@ func setConfVar(conf Options) {
 	x.Conf.Set("max_pending_count", newInt(int(conf.MaxPendingCount)))
 	x.Conf.Set("num_pending_proposals", newInt(conf.NumPendingProposals))
 	x.Conf.Set("expand_edge", newIntFromBool(conf.ExpandEdge))
+	x.Conf.Set("query_timeout", newStr(conf.QueryTimeout.String()))
+	x.Conf.Set("query_budget", newInt(int(conf.QueryBudget)))
 }
 
 func SetConfiguration(newConfig Options) {
@@ -17,6 +17,8 @@
 package edgraph
 
 import (
+	"io"
+
 	"golang.org/x/net/context"
 
 	"google.golang.org/grpc"
@@ -54,3 +56,53 @@ func (i *inmemoryClient) CheckVersion(ctx context.Context, in *protos.Check,
 	_ ...grpc.CallOption) (*protos.Version, error) {
 	return i.srv.CheckVersion(ctx, in)
 }
+
+func (i *inmemoryClient) QueryStream(ctx context.Context, in *protos.Request,
+	_ ...grpc.CallOption) (protos.Dgraph_QueryStreamClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	st := &inmemoryStream{ctx: ctx, cancel: cancel, ch: make(chan *protos.Response)}
+	go func() {
+		st.err = i.srv.StreamQuery(ctx, in, st.send)
+		close(st.ch)
+	}()
+	return st, nil
+}
+
+// inmemoryStream passes the responses of a streamed query from the server to the
+// client over a channel. Only the methods used by the client are implemented.
+type inmemoryStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	ch     chan *protos.Response
+	err    error // Set before ch is closed.
+}
+
+func (st *inmemoryStream) send(resp *protos.Response) error {
+	select {
+	case st.ch <- resp:
+		return nil
+	case <-st.ctx.Done():
+		return st.ctx.Err()
+	}
+}
+
+func (st *inmemoryStream) Recv() (*protos.Response, error) {
+	resp, ok := <-st.ch
+	if ok {
+		return resp, nil
+	}
+	st.cancel()
+	if st.err != nil {
+		return nil, st.err
+	}
+	return nil, io.EOF
+}
+
+func (st *inmemoryStream) Context() context.Context {
+	return st.ctx
+}
+
+func (st *inmemoryStream) CloseSend() error {
+	return nil
+}
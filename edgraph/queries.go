@@ -0,0 +1,96 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// ActiveQuery describes a query which is being processed by this server.
+type ActiveQuery struct {
+	Id       uint64    `json:"id"`
+	Query    string    `json:"query"`
+	Started  time.Time `json:"started"`
+	Duration string    `json:"duration"`
+}
+
+type activeQuery struct {
+	query   string
+	started time.Time
+	cancel  context.CancelFunc
+}
+
+// queryRegistry keeps track of the in-flight queries, so that they can be listed
+// and killed.
+type queryRegistry struct {
+	lastId  uint64   // Accessed atomically.
+	queries sync.Map // id -> *activeQuery
+}
+
+var activeQueries queryRegistry
+
+func (r *queryRegistry) add(q string, cancel context.CancelFunc) uint64 {
+	id := atomic.AddUint64(&r.lastId, 1)
+	r.queries.Store(id, &activeQuery{query: q, started: time.Now(), cancel: cancel})
+	return id
+}
+
+func (r *queryRegistry) remove(id uint64) {
+	r.queries.Delete(id)
+}
+
+func (r *queryRegistry) list() []ActiveQuery {
+	var out []ActiveQuery
+	r.queries.Range(func(k, v interface{}) bool {
+		q := v.(*activeQuery)
+		out = append(out, ActiveQuery{
+			Id:       k.(uint64),
+			Query:    q.query,
+			Started:  q.started,
+			Duration: time.Since(q.started).String(),
+		})
+		return true
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Id < out[j].Id })
+	return out
+}
+
+func (r *queryRegistry) kill(id uint64) bool {
+	v, ok := r.queries.Load(id)
+	if ok {
+		v.(*activeQuery).cancel()
+	}
+	return ok
+}
+
+// ActiveQueries returns the queries which are currently being processed, ordered
+// by the time they were received.
+func ActiveQueries() []ActiveQuery {
+	return activeQueries.list()
+}
+
+// KillQuery cancels the in-flight query with the given id. It returns false if
+// there is no such query.
+func KillQuery(id uint64) bool {
+	return activeQueries.kill(id)
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestQueryRegistry(t *testing.T) {
+	var r queryRegistry
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	_, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+
+	id1 := r.add("{ q1 }", cancel1)
+	id2 := r.add("{ q2 }", cancel2)
+	require.NotEqual(t, id1, id2)
+
+	list := r.list()
+	require.Len(t, list, 2)
+	require.Equal(t, id1, list[0].Id)
+	require.Equal(t, "{ q1 }", list[0].Query)
+	require.Equal(t, id2, list[1].Id)
+
+	require.True(t, r.kill(id1))
+	require.Equal(t, context.Canceled, ctx1.Err())
+	require.False(t, r.kill(id2+1))
+
+	r.remove(id1)
+	list = r.list()
+	require.Len(t, list, 1)
+	require.Equal(t, id2, list[0].Id)
+}
@@ -23,6 +23,7 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -214,7 +215,7 @@ func (s *Server) Alter(ctx context.Context, op *protos.Operation) (*protos.Paylo
 		_, err = query.ApplyMutations(ctx, m)
 		return empty, err
 	}
-	updates, err := schema.Parse(op.Schema)
+	updates, typeUpdates, analyzers, err := schema.ParseDefinitions(op.Schema)
 	if err != nil {
 		return empty, err
 	}
@@ -226,7 +227,8 @@ func (s *Server) Alter(ctx context.Context, op *protos.Operation) (*protos.Paylo
 	if op.StartTs == 0 {
 		op.StartTs = State.getTimestamp()
 	}
-	m := &protos.Mutations{Schema: updates, StartTs: op.StartTs}
+	m := &protos.Mutations{Schema: updates, Types: typeUpdates, Analyzers: analyzers,
+		StartTs: op.StartTs}
 	_, err = query.ApplyMutations(ctx, m)
 	return empty, err
 }
@@ -255,6 +257,16 @@ func (s *Server) Mutate(ctx context.Context, mu *protos.Mutation) (resp *protos.
 	if err != nil {
 		return resp, err
 	}
+	condPassed, readKeys, err := processUpsertQuery(ctx, mu, gmu)
+	if err != nil {
+		return resp, err
+	}
+	resp.CondPassed = condPassed
+	if !condPassed {
+		// Nothing to apply, the transaction stays as it was apart from what the query read.
+		resp.Context = &protos.TxnContext{StartTs: mu.StartTs, Keys: readKeys}
+		return resp, nil
+	}
 	newUids, err := query.AssignUids(ctx, gmu.Set)
 	if err != nil {
 		return resp, err
@@ -267,6 +279,9 @@ func (s *Server) Mutate(ctx context.Context, mu *protos.Mutation) (resp *protos.
 
 	m := &protos.Mutations{Edges: edges, StartTs: mu.StartTs}
 	resp.Context, err = query.ApplyMutations(ctx, m)
+	if resp.Context != nil {
+		resp.Context.Keys = append(resp.Context.Keys, readKeys...)
+	}
 	if !mu.CommitNow {
 		if err != nil {
 			// TODO: Investigate if this is really necessary.
@@ -293,6 +308,62 @@ func (s *Server) Mutate(ctx context.Context, mu *protos.Mutation) (resp *protos.
 	return resp, aerr
 }
 
+// processUpsertQuery runs the query block of an upsert mutation at the start ts of the
+// mutation and substitutes the variables it computes into the N-Quads of gmu. It returns
+// false if the condition of the mutation doesn't hold, in which case gmu is left as is.
+// The keys of the posting lists read by the query are returned, so that the transaction
+// conflicts with the ones which change them before it commits.
+func processUpsertQuery(ctx context.Context, mu *protos.Mutation,
+	gmu *gql.Mutation) (bool, []string, error) {
+	needVars := gmu.NeededVars()
+	var cond *gql.FilterTree
+	if len(mu.Cond) > 0 {
+		var condVars []string
+		var err error
+		if cond, condVars, err = gql.ParseCond(mu.Cond); err != nil {
+			return false, nil, err
+		}
+		needVars = x.RemoveDuplicates(append(needVars, condVars...))
+	}
+	if len(mu.Query) == 0 {
+		if len(needVars) > 0 {
+			return false, nil,
+				x.Errorf("Variables %v are used in mutation without a query block", needVars)
+		}
+		return true, nil, nil
+	}
+
+	parsedReq, err := gql.ParseWithNeedVars(gql.Request{
+		Str:  mu.Query,
+		Http: false,
+	}, needVars)
+	if err != nil {
+		return false, nil, err
+	}
+	queryRequest := query.QueryRequest{
+		Latency:  &query.Latency{},
+		GqlQuery: &parsedReq,
+		ReadTs:   mu.StartTs,
+	}
+	ctx, readKeys := query.WithReadKeys(ctx)
+	if err = queryRequest.ProcessQuery(ctx); err != nil {
+		return false, nil, x.Wrapf(err, "While processing query of upsert mutation")
+	}
+	if tr, ok := trace.FromContext(ctx); ok {
+		tr.LazyPrintf("Processed query of upsert mutation")
+	}
+	if cond != nil {
+		ok, err := queryRequest.EvalCond(cond)
+		if tr, tok := trace.FromContext(ctx); tok {
+			tr.LazyPrintf("Condition of mutation %s evaluated to %v, err: %v", mu.Cond, ok, err)
+		}
+		if err != nil || !ok {
+			return false, readKeys(), err
+		}
+	}
+	return true, readKeys(), queryRequest.SubstituteVars(gmu)
+}
+
 // This method is used to execute the query and return the response to the
 // client as a protocol buffer message.
 func (s *Server) Query(ctx context.Context, req *protos.Request) (resp *protos.Response, err error) {
@@ -324,6 +395,17 @@ func (s *Server) Query(ctx context.Context, req *protos.Request) (resp *protos.R
 		}
 		return resp, fmt.Errorf("empty query")
 	}
+	if !validOutput(req.Output) {
+		return resp, x.Errorf("Invalid output encoding: %q. Must be one of json, rdf, csv or proto",
+			req.Output)
+	}
+
+	ctx, cancel, timeout := withQueryLimits(ctx, req)
+	defer cancel()
+
+	// Track the query so that it can be listed and killed through /admin/queries.
+	id := activeQueries.add(req.Query, cancel)
+	defer activeQueries.remove(id)
 
 	if Config.DebugMode {
 		x.Printf("Received query: %+v\n", req.Query)
@@ -362,18 +444,32 @@ func (s *Server) Query(ctx context.Context, req *protos.Request) (resp *protos.R
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Error while processing query: %+v", err)
 		}
-		return resp, x.Wrap(err)
+		return resp, queryError(ctx, timeout, err)
 	}
 	resp.Schema = er.SchemaNode
-
-	json, err := query.ToJson(&l, er.Subgraphs)
+	resp.Types = er.Types
+	if req.Explain {
+		if resp.Plan, err = json.Marshal(queryRequest.Plan()); err != nil {
+			return resp, x.Wrapf(err, "While encoding the query plan")
+		}
+	}
+	cursors, err := queryRequest.Cursors()
 	if err != nil {
+		return resp, x.Wrapf(err, "While encoding the cursors")
+	}
+	for alias, c := range cursors {
+		resp.Cursors = append(resp.Cursors, &protos.BlockCursor{Alias: alias, Cursor: c})
+	}
+	sort.Slice(resp.Cursors, func(i, j int) bool {
+		return resp.Cursors[i].Alias < resp.Cursors[j].Alias
+	})
+
+	if err = encodeResult(req.Output, &l, er.Subgraphs, resp); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Error while converting to protocol buffer: %+v", err)
 		}
 		return resp, err
 	}
-	resp.Json = json
 
 	gl := &protos.Latency{
 		ParsingNs:    uint64(l.Parsing.Nanoseconds()),
@@ -386,6 +482,151 @@ func (s *Server) Query(ctx context.Context, req *protos.Request) (resp *protos.R
 	return resp, err
 }
 
+func validOutput(output string) bool {
+	switch output {
+	case "", query.OutputJson, query.OutputRDF, query.OutputCSV, query.OutputProto:
+		return true
+	}
+	return false
+}
+
+// encodeResult sets the result of the query on the response, in the output encoding
+// asked for by the request.
+func encodeResult(output string, l *query.Latency, sgl []*query.SubGraph,
+	resp *protos.Response) (err error) {
+	switch output {
+	case query.OutputRDF:
+		resp.Rdf, err = query.ToRDF(l, sgl)
+	case query.OutputCSV:
+		resp.Csv, err = query.ToCSV(l, sgl)
+	case query.OutputProto:
+		resp.Nodes, err = query.ToProtocolBuf(l, sgl)
+	default:
+		resp.Json, err = query.ToJson(l, sgl)
+	}
+	return err
+}
+
+// withQueryLimits returns a context with the timeout and the result budget for the
+// query. The values set on the server can be overridden by the request.
+func withQueryLimits(ctx context.Context, req *protos.Request) (context.Context,
+	context.CancelFunc, time.Duration) {
+	timeout := Config.QueryTimeout
+	if req.TimeoutMs > 0 {
+		timeout = time.Duration(req.TimeoutMs) * time.Millisecond
+	}
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	budget := Config.QueryBudget
+	if req.ResultBudget > 0 {
+		budget = req.ResultBudget
+	}
+	return query.WithBudget(ctx, budget), cancel, timeout
+}
+
+// queryError returns the error to send back for a failed query. Queries which ran
+// past their deadline or were killed get a clear error instead of the one from
+// whichever task noticed it first.
+func queryError(ctx context.Context, timeout time.Duration, err error) error {
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return x.Errorf("Query exceeded the timeout of %v", timeout)
+	case context.Canceled:
+		return x.Errorf("Query was cancelled")
+	}
+	return x.Wrap(err)
+}
+
+// QueryStream handles the query like Query, but sends the results back a page of
+// root nodes at a time, so that large results don't have to be held in memory on
+// either side.
+func (s *Server) QueryStream(req *protos.Request, stream protos.Dgraph_QueryStreamServer) error {
+	return s.StreamQuery(stream.Context(), req, stream.Send)
+}
+
+// StreamQuery processes the query and calls send with the results of a page of root
+// nodes at a time. Each response has a JSON object with the alias of the query block
+// and a list of nodes. The last response has the transaction context and latency.
+func (s *Server) StreamQuery(ctx context.Context, req *protos.Request,
+	send func(*protos.Response) error) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+
+	x.PendingQueries.Add(1)
+	x.NumQueries.Add(1)
+	defer x.PendingQueries.Add(-1)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if rand.Float64() < worker.Config.Tracing {
+		var tr trace.Trace
+		tr, ctx = x.NewTrace("GrpcQueryStream", ctx)
+		defer tr.Finish()
+	}
+
+	if len(req.Query) == 0 {
+		return fmt.Errorf("empty query")
+	}
+	if req.Output != "" && req.Output != query.OutputJson {
+		return x.Errorf("Only JSON output can be streamed. Got: %q", req.Output)
+	}
+	ctx, cancel, timeout := withQueryLimits(ctx, req)
+	defer cancel()
+	id := activeQueries.add(req.Query, cancel)
+	defer activeQueries.remove(id)
+
+	var l query.Latency
+	l.Start = time.Now()
+	parsedReq, err := gql.Parse(gql.Request{
+		Str:       req.Query,
+		Variables: req.Vars,
+		Http:      false,
+	})
+	if err != nil {
+		return err
+	}
+	if parsedReq.Schema != nil {
+		return x.Errorf("Schema queries can't be streamed")
+	}
+
+	if req.StartTs == 0 {
+		req.StartTs = State.getTimestamp()
+	}
+	queryRequest := query.QueryRequest{
+		Latency:  &l,
+		GqlQuery: &parsedReq,
+		ReadTs:   req.StartTs,
+		LinRead:  req.LinRead,
+	}
+	err = queryRequest.ProcessStream(ctx, func(js []byte) error {
+		return send(&protos.Response{Json: js})
+	})
+	if err != nil {
+		if tr, ok := trace.FromContext(ctx); ok {
+			tr.LazyPrintf("Error while streaming query: %+v", err)
+		}
+		return queryError(ctx, timeout, err)
+	}
+
+	return send(&protos.Response{
+		Txn: &protos.TxnContext{
+			StartTs: req.StartTs,
+			LinRead: queryRequest.LinRead,
+		},
+		Latency: &protos.Latency{
+			ParsingNs:    uint64(l.Parsing.Nanoseconds()),
+			ProcessingNs: uint64(l.Processing.Nanoseconds()),
+			EncodingNs:   uint64(l.Json.Nanoseconds()),
+		},
+	})
+}
+
 func (s *Server) CommitOrAbort(ctx context.Context, tc *protos.TxnContext) (*protos.TxnContext,
 	error) {
 	commitTs, err := worker.CommitOverNetwork(ctx, tc)
@@ -562,6 +803,9 @@ func mapToNquads(m map[string]interface{}, idx *int, op int, parentPred string)
 		} else if id, ok := uidVal.(string); ok {
 			if u, err := strconv.ParseInt(id, 0, 64); err == nil {
 				uid = uint64(u)
+			} else if _, ok := uidVarName(id); ok {
+				// Resolved against the query block of an upsert mutation.
+				mr.uid = id
 			}
 		}
 		if uid > 0 {
@@ -733,14 +977,37 @@ func nquadsFromJson(b []byte, op int) ([]*protos.NQuad, error) {
 			checkForDeletion(&mr, obj.(map[string]interface{}), op)
 			nquads = append(nquads, mr.nquads...)
 		}
+		setUidVars(nquads)
 		return nquads, nil
 	}
 
 	mr, err := mapToNquads(ms, &idx, op, "")
 	checkForDeletion(&mr, ms, op)
+	setUidVars(mr.nquads)
 	return mr.nquads, err
 }
 
+// uidVarName returns the name of the variable if s is of the form uid(v).
+func uidVarName(s string) (string, bool) {
+	if !strings.HasPrefix(s, "uid(") || !strings.HasSuffix(s, ")") {
+		return "", false
+	}
+	return strings.TrimSpace(s[len("uid(") : len(s)-1]), true
+}
+
+// setUidVars moves the uid(v) references used as uid in JSON over to the variable
+// fields of the N-Quads.
+func setUidVars(nquads []*protos.NQuad) {
+	for _, nq := range nquads {
+		if v, ok := uidVarName(nq.Subject); ok {
+			nq.Subject, nq.SubjectVar = "", v
+		}
+		if v, ok := uidVarName(nq.ObjectId); ok {
+			nq.ObjectId, nq.ObjectVar = "", v
+		}
+	}
+}
+
 func parseNQuads(b []byte, op int) ([]*protos.NQuad, error) {
 	var nqs []*protos.NQuad
 	for _, line := range bytes.Split(b, []byte{'\n'}) {
@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"golang.org/x/net/context"
+
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
@@ -253,3 +255,54 @@ func TestParseNQuadsDelete(t *testing.T) {
 		makeNquad("_:a", x.Star, &protos.Value{&protos.Value_DefaultVal{x.Star}}),
 	}, nqs)
 }
+
+func TestNquadsFromJsonUidVar(t *testing.T) {
+	json := `{"uid":"uid(v)","name":"Alice","friend":{"uid":"uid(f)"}}`
+
+	nqs, err := nquadsFromJson([]byte(json), set)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(nqs))
+	for _, nq := range nqs {
+		require.Equal(t, "", nq.Subject)
+		require.Equal(t, "v", nq.SubjectVar)
+		if nq.Predicate == "friend" {
+			require.Equal(t, "", nq.ObjectId)
+			require.Equal(t, "f", nq.ObjectVar)
+		}
+	}
+}
+
+func TestParseMutationObjectNeededVars(t *testing.T) {
+	mu := &protos.Mutation{
+		SetNquads: []byte(`
+			uid(v) <name> "Alice" .
+			uid(v) <friend> uid(f) .
+			uid(f) <nick> val(n) .
+		`),
+		DelNquads: []byte(`uid(d) <name> * .`),
+	}
+	gmu, err := parseMutationObject(mu)
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "f", "n", "v"}, gmu.NeededVars())
+
+	_, _, err = processUpsertQuery(context.Background(), mu, gmu)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "without a query block")
+}
+
+func TestProcessUpsertQueryCondWithoutQuery(t *testing.T) {
+	mu := &protos.Mutation{
+		SetNquads: []byte(`_:a <name> "Alice" .`),
+		Cond:      `@if(eq(len(v), 0))`,
+	}
+	gmu, err := parseMutationObject(mu)
+	require.NoError(t, err)
+	_, _, err = processUpsertQuery(context.Background(), mu, gmu)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Variables [v] are used in mutation without a query block")
+
+	mu.Cond = `@if(eq(name, "Alice"))`
+	_, _, err = processUpsertQuery(context.Background(), mu, gmu)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Only len() of variables")
+}
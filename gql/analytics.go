@@ -0,0 +1,101 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package gql
+
+import (
+	"github.com/dgraph-io/dgraph/lex"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Analytic is a graph algorithm run over the uids of a block, following the edges of one
+// or more predicates. For example, pagerank(follows, iterations: 20, damping: 0.85).
+// distance(name) instead scores the uids by how far their name is from the string of the
+// match function of the block, and score(description) by how well their description
+// matches the alloftext or anyoftext function of the block. highlight(description) gives
+// their description with the words matching the text function of the block marked.
+type Analytic struct {
+	Name  string
+	Attrs []string
+	Args  map[string]string
+}
+
+func isAnalyticFunc(name string) bool {
+	switch name {
+	case "pagerank", "degree", "betweenness", "components", "communities", "distance",
+		"score", "highlight":
+		return true
+	}
+	return false
+}
+
+// isAnalyticCall returns true if the name is of an analytics function and is followed by
+// its arguments. Predicates with the same name, like degree, are still fetched as usual.
+func isAnalyticCall(it *lex.ItemIterator, name string) bool {
+	next, ok := it.PeekOne()
+	return ok && next.Typ == itemLeftRound && isAnalyticFunc(name)
+}
+
+// parseAnalytic parses the predicates and the named arguments of an analytics function.
+// The iterator is expected to be at the name of the function.
+func parseAnalytic(it *lex.ItemIterator, name string) (*Analytic, error) {
+	it.Next()
+	if it.Item().Typ != itemLeftRound {
+		return nil, x.Errorf("Expected ( after %s", name)
+	}
+	a := &Analytic{
+		Name: name,
+		Args: make(map[string]string),
+	}
+	for it.Next() {
+		item := it.Item()
+		if item.Typ != itemName {
+			return nil, x.Errorf("Expected a predicate or an argument in %s. Got: %v",
+				name, item.Val)
+		}
+		key := collectName(it, item.Val)
+		if next, ok := it.PeekOne(); ok && next.Typ == itemColon {
+			it.Next()
+			it.Next()
+			val := it.Item()
+			if val.Typ != itemName {
+				return nil, x.Errorf("Expected value of %s in %s. Got: %v", key, name, val.Val)
+			}
+			if _, ok := a.Args[key]; ok {
+				return nil, x.Errorf("Argument %s repeated in %s", key, name)
+			}
+			a.Args[key] = val.Val
+		} else if len(a.Args) > 0 {
+			return nil, x.Errorf("Predicates should come before the arguments in %s", name)
+		} else {
+			a.Attrs = append(a.Attrs, key)
+		}
+
+		it.Next()
+		item = it.Item()
+		if item.Typ == itemRightRound {
+			if len(a.Attrs) == 0 {
+				return nil, x.Errorf("Expected a predicate in %s", name)
+			}
+			return a, nil
+		}
+		if item.Typ != itemComma {
+			return nil, x.Errorf("Expected comma or ) in %s. Got: %v", name, item.Val)
+		}
+	}
+	return nil, x.Errorf("Unclosed %s", name)
+}
@@ -44,6 +44,22 @@ func (m Mutation) HasOps() bool {
 	return len(m.Set) > 0 || len(m.Del) > 0 || len(m.Schema) > 0 || m.DropAll
 }
 
+// NeededVars returns the names of the query variables referred to by uid() and val() in the
+// N-Quads of the mutation.
+func (m Mutation) NeededVars() []string {
+	var vars []string
+	for _, nquads := range [][]*protos.NQuad{m.Set, m.Del} {
+		for _, nq := range nquads {
+			for _, v := range []string{nq.SubjectVar, nq.ObjectVar, nq.ValueVar} {
+				if len(v) > 0 {
+					vars = append(vars, v)
+				}
+			}
+		}
+	}
+	return x.RemoveDuplicates(vars)
+}
+
 // Gets the uid corresponding
 func ParseUid(xid string) (uint64, error) {
 	// If string represents a UID, convert to uint64 and return.
@@ -31,8 +31,10 @@ import (
 )
 
 const (
-	uid   = "uid"
-	value = "val"
+	uid    = "uid"
+	value  = "val"
+	length = "len"
+	typeFn = "type"
 )
 
 // GraphQuery stores the parsed Query in a tree format. This gets converted to
@@ -55,14 +57,20 @@ type GraphQuery struct {
 	Order        []*protos.Order
 	Children     []*GraphQuery
 	Filter       *FilterTree
+	Avoid        *FilterTree // Nodes a shortest path can't go through.
+	Through      *FilterTree // Nodes a shortest path has to go through one of.
 	MathExp      *MathTree
+	Analytic     *Analytic
 	Normalize    bool
 	Recurse      bool
+	RecurseArgs  RecurseArgs
 	Cascade      bool
 	IgnoreReflex bool
+	WithCount    bool // Return the number of uids before pagination.
 	Facets       *Facets
 	FacetsFilter *FilterTree
 	GroupbyAttrs []AttrLang
+	GroupbyArgs  map[string]string // The order and pagination of the groups.
 	FacetVar     map[string]string
 	FacetOrder   string
 	FacetDesc    bool
@@ -83,6 +91,20 @@ type GraphQuery struct {
 type AttrLang struct {
 	Attr  string
 	Langs []string
+	// Var is the value variable to group by, instead of a predicate.
+	Var string
+}
+
+// RecurseArgs stores the arguments of the @recurse directive, other than depth which is
+// kept in the Args of the block.
+type RecurseArgs struct {
+	// NoLoop is set for loop: false, when the edges to nodes reached at an earlier depth
+	// aren't followed.
+	NoLoop bool
+	// Annotate adds the depth at which every node was first reached to the result.
+	Annotate bool
+	// Flat returns the reached nodes as a list instead of a tree.
+	Flat bool
 }
 
 // pair denotes the key value pair that is part of the GraphQL query root in parenthesis.
@@ -148,6 +170,7 @@ type Function struct {
 	NeedsVar   []VarContext // If the function requires some variable
 	IsCount    bool         // gt(count(friends),0)
 	IsValueVar bool         // eq(val(s), 5)
+	IsLenVar   bool         // eq(len(s), 0)
 }
 
 // Facet holds the information about gql Facets (edge key-value pairs).
@@ -446,8 +469,11 @@ func substituteVariables(gq *GraphQuery, vmap varMap) error {
 			return err
 		}
 	}
-	if gq.Filter != nil {
-		if err := substituteVariablesFilter(gq.Filter, vmap); err != nil {
+	for _, f := range []*FilterTree{gq.Filter, gq.Avoid, gq.Through} {
+		if f == nil {
+			continue
+		}
+		if err := substituteVariablesFilter(f, vmap); err != nil {
 			return err
 		}
 	}
@@ -493,6 +519,25 @@ type Result struct {
 // Parse initializes and runs the lexer. It also constructs the GraphQuery subgraph
 // from the lexed items.
 func Parse(r Request) (res Result, rerr error) {
+	return ParseWithNeedVars(r, nil)
+}
+
+// ParseWithNeedVars is like Parse, but treats needVars as used by a consumer outside of
+// the query. This is the case for the query block of an upsert mutation like
+//
+//	{
+//	  query {
+//	    me(func: eq(email, "someone@gmail.com")) {
+//	      v as uid
+//	    }
+//	  }
+//	  set {
+//	    uid(v) <name> "Some One" .
+//	  }
+//	}
+//
+// where v is defined by the query but only used by the set block.
+func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
 	query, vmap, err := parseQueryWithGqlVars(r)
 	if err != nil {
 		return res, err
@@ -572,8 +617,15 @@ func Parse(r Request) (res Result, rerr error) {
 			// Collect vars used and defined in Result struct.
 			qu.collectVars(res.QueryVars[i])
 		}
+	}
 
+	if len(res.Query) != 0 || len(needVars) != 0 {
 		allVars := res.QueryVars
+		if len(needVars) != 0 {
+			// Vars needed outside of the query are recorded separately, so that
+			// res.QueryVars stays aligned with res.Query.
+			allVars = append(allVars, &Vars{Needs: needVars})
+		}
 		if err := checkDependency(allVars); err != nil {
 			return res, err
 		}
@@ -637,8 +689,10 @@ func (qu *GraphQuery) collectVars(v *Vars) {
 	for _, ch := range qu.Children {
 		ch.collectVars(v)
 	}
-	if qu.Filter != nil {
-		qu.Filter.collectVars(v)
+	for _, f := range []*FilterTree{qu.Filter, qu.Avoid, qu.Through} {
+		if f != nil {
+			f.collectVars(v)
+		}
 	}
 	if qu.MathExp != nil {
 		qu.MathExp.collectVars(v)
@@ -758,17 +812,41 @@ L:
 				}
 				gq.Filter = filter
 
+			case "avoid", "through":
+				name := strings.ToLower(item.Val)
+				if (name == "avoid" && gq.Avoid != nil) || (name == "through" && gq.Through != nil) {
+					return nil, x.Errorf("Repeated %s at root", name)
+				}
+				filter, err := parseFilter(it)
+				if err != nil {
+					return nil, err
+				}
+				if name == "avoid" {
+					gq.Avoid = filter
+				} else {
+					gq.Through = filter
+				}
 			case "normalize":
 				gq.Normalize = true
 			case "cascade":
 				gq.Cascade = true
 			case "groupby":
 				gq.IsGroupby = true
-				parseGroupby(it, gq)
+				if err := parseGroupby(it, gq); err != nil {
+					return nil, err
+				}
 			case "ignorereflex":
 				gq.IgnoreReflex = true
+			case "withcount":
+				gq.WithCount = true
 			case "recurse":
+				if gq.Recurse {
+					return nil, x.Errorf("Repeated recurse at root")
+				}
 				gq.Recurse = true
+				if err := parseRecurseArgs(it, gq); err != nil {
+					return nil, err
+				}
 			default:
 				return nil, x.Errorf("Unknown directive [%s]", item.Val)
 			}
@@ -851,36 +929,50 @@ func parseListItemNames(it *lex.ItemIterator) ([]string, error) {
 
 // parses till rightround is found
 func parseSchemaPredicates(it *lex.ItemIterator, s *protos.SchemaRequest) error {
-	// pred should be followed by colon
-	it.Next()
-	item := it.Item()
-	if item.Typ != itemName && item.Val != "pred" {
-		return x.Errorf("Invalid schema block")
-	}
-	it.Next()
-	item = it.Item()
-	if item.Typ != itemColon {
-		return x.Errorf("Invalid schema block")
-	}
+	expectArg := true
+	for it.Next() {
+		item := it.Item()
+		switch {
+		case item.Typ == itemRightRound && !expectArg:
+			return nil
+		case item.Typ == itemComma && !expectArg:
+			expectArg = true
+			continue
+		case item.Typ != itemName || !expectArg:
+			return x.Errorf("Invalid schema block")
+		}
 
-	// can be a or [a,b]
-	it.Next()
-	item = it.Item()
-	if item.Typ == itemName {
-		s.Predicates = append(s.Predicates, item.Val)
-	} else if item.Typ == itemLeftSquare {
-		var err error
-		if s.Predicates, err = parseListItemNames(it); err != nil {
-			return err
+		// pred or type should be followed by colon
+		key := item.Val
+		if key != "pred" && key != "type" {
+			return x.Errorf("Invalid schema block")
+		}
+		it.Next()
+		item = it.Item()
+		if item.Typ != itemColon {
+			return x.Errorf("Invalid schema block")
 		}
-	} else {
-		return x.Errorf("Invalid schema block")
-	}
 
-	it.Next()
-	item = it.Item()
-	if item.Typ == itemRightRound {
-		return nil
+		// can be a or [a,b]
+		var names []string
+		it.Next()
+		item = it.Item()
+		if item.Typ == itemName {
+			names = append(names, item.Val)
+		} else if item.Typ == itemLeftSquare {
+			var err error
+			if names, err = parseListItemNames(it); err != nil {
+				return err
+			}
+		} else {
+			return x.Errorf("Invalid schema block")
+		}
+		if key == "pred" {
+			s.Predicates = append(s.Predicates, names...)
+		} else {
+			s.Types = append(s.Types, names...)
+		}
+		expectArg = false
 	}
 	return x.Errorf("Invalid schema blocks")
 }
@@ -1125,7 +1217,11 @@ func parseArguments(it *lex.ItemIterator, gq *GraphQuery) (result []pair, rerr e
 
 		// Get language list, if present
 		items, err := it.Peek(1)
-		if err == nil && items[0].Typ == itemAt {
+		if err == nil && items[0].Typ == itemLeftRound && isSortkey(p.Key) && p.Val == "count" {
+			if p.Val, err = parseCountOrder(it); err != nil {
+				return nil, err
+			}
+		} else if err == nil && items[0].Typ == itemAt {
 			it.Next() // consume '@'
 			it.Next() // move forward
 			langs, err := parseLanguageList(it)
@@ -1311,7 +1407,16 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof":
+		"has", "uid", "uid_in", "anyof", "allof", "prefix", "match":
+		return true
+	}
+	return isSetFn(name)
+}
+
+// isSetFn returns true for the functions which combine uid variables.
+func isSetFn(name string) bool {
+	switch name {
+	case "intersect", "difference", "union":
 		return true
 	}
 	return false
@@ -1381,6 +1486,18 @@ L:
 					}
 					function.NeedsVar = append(function.NeedsVar, nestedFunc.NeedsVar...)
 					function.NeedsVar[0].Typ = VALUE_VAR
+				} else if nestedFunc.Name == length {
+					if len(nestedFunc.NeedsVar) != 1 {
+						return nil, x.Errorf("Expected exactly one variable in len()")
+					}
+					if !isInequalityFn(function.Name) {
+						return nil, x.Errorf("len() can only be used within inequality functions. Got: %s",
+							function.Name)
+					}
+					// Number of uids in a variable, eq(len(a), 0)
+					function.Attr = nestedFunc.NeedsVar[0].Name
+					function.IsLenVar = true
+					function.NeedsVar = append(function.NeedsVar, nestedFunc.NeedsVar...)
 				} else {
 					if nestedFunc.Name != "count" {
 						return nil,
@@ -1493,8 +1610,9 @@ L:
 				continue
 			}
 
-			// Unlike other functions, uid function has no attribute, everything is args.
-			if len(function.Attr) == 0 && function.Name != "uid" {
+			// Unlike other functions, uid and set functions have no attribute, everything
+			// is args.
+			if len(function.Attr) == 0 && function.Name != "uid" && !isSetFn(function.Name) {
 				if strings.ContainsRune(itemInFunc.Val, '"') {
 					return nil, x.Errorf("Attribute in function must not be quoted with \": %s",
 						itemInFunc.Val)
@@ -1520,6 +1638,18 @@ L:
 					Name: val,
 					Typ:  VALUE_VAR,
 				})
+			} else if function.Name == length {
+				// E.g. @if(eq(len(a), 0))
+				function.NeedsVar = append(function.NeedsVar, VarContext{
+					Name: val,
+					Typ:  ANY_VAR,
+				})
+			} else if isSetFn(function.Name) {
+				// E.g. difference(a, b), the args keep the order of the variables.
+				function.NeedsVar = append(function.NeedsVar, VarContext{
+					Name: val,
+					Typ:  UID_VAR,
+				})
 			} else if function.Name == uid {
 				// uid function could take variables as well as actual uids.
 				// If we can parse the value that means its an uid otherwise a variable.
@@ -1543,9 +1673,23 @@ L:
 		}
 	}
 
-	if function.Name != uid && len(function.Attr) == 0 {
+	if isSetFn(function.Name) {
+		if len(function.Args) < 2 {
+			return nil, x.Errorf("Expected at least two variables in %s()", function.Name)
+		}
+	} else if function.Name != uid && len(function.Attr) == 0 {
 		return nil, x.Errorf("Got empty attr for function: [%s]", function.Name)
 	}
+	if function.Name == typeFn {
+		// type(Person) is the same as eq(_type_, "Person"), nodes store their types as
+		// values of the reserved type predicate.
+		if len(function.NeedsVar) > 0 || function.IsCount || len(function.Lang) > 0 {
+			return nil, x.Errorf("type() only accepts names of types")
+		}
+		function.Name = "eq"
+		function.Args = append([]Arg{{Value: function.Attr}}, function.Args...)
+		function.Attr = x.TypeAttr
+	}
 
 	return function, nil
 }
@@ -1743,6 +1887,28 @@ func parseGroupby(it *lex.ItemIterator, gq *GraphQuery) error {
 			if !expectArg {
 				return x.Errorf("Expected a comma or right round but got: %v", item.Val)
 			}
+			if items, err := it.Peek(1); err == nil && items[0].Typ == itemColon {
+				if err := parseGroupbyArg(it, gq); err != nil {
+					return err
+				}
+				expectArg = false
+				continue
+			}
+			if item.Val == value {
+				n, err := parseVarList(it, gq)
+				if err != nil {
+					return err
+				}
+				if n != 1 {
+					return x.Errorf("Expected one variable inside val() of groupby but got %v", n)
+				}
+				v := &gq.NeedsVar[len(gq.NeedsVar)-1]
+				v.Typ = VALUE_VAR
+				gq.GroupbyAttrs = append(gq.GroupbyAttrs, AttrLang{Var: v.Name})
+				count++
+				expectArg = false
+				continue
+			}
 			attr := collectName(it, item.Val)
 			var langs []string
 			items, err := it.Peek(1)
@@ -1772,6 +1938,73 @@ func parseGroupby(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+// parseGroupbyArg parses an argument of @groupby, which orders the groups by one of their
+// aggregates or paginates them.
+func parseGroupbyArg(it *lex.ItemIterator, gq *GraphQuery) error {
+	key := it.Item().Val
+	it.Next() // consume ':'
+	it.Next()
+	item := it.Item()
+	if item.Typ != itemName {
+		return x.Errorf("Expecting a value for %s in groupby. Got: %v", key, item.Val)
+	}
+	switch key {
+	case "orderasc", "orderdesc", "first", "offset":
+	default:
+		return x.Errorf("Unknown argument %s in groupby", key)
+	}
+	if gq.GroupbyArgs == nil {
+		gq.GroupbyArgs = make(map[string]string)
+	}
+	if _, ok := gq.GroupbyArgs[key]; ok {
+		return x.Errorf("Got repeated key %q in groupby", key)
+	}
+	gq.GroupbyArgs[key] = item.Val
+	if gq.GroupbyArgs["orderasc"] != "" && gq.GroupbyArgs["orderdesc"] != "" {
+		return x.Errorf("Groups can only be ordered by one aggregate")
+	}
+	return nil
+}
+
+// parseRecurseArgs parses the optional arguments of @recurse, like
+// @recurse(depth: 5, loop: false, annotate: true, flat: true).
+func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
+	items, err := it.Peek(1)
+	if err != nil || items[0].Typ != itemLeftRound {
+		return nil
+	}
+	it.Next() // consume '('
+	args, err := parseArguments(it, gq)
+	if err != nil {
+		return err
+	}
+	for _, p := range args {
+		switch p.Key {
+		case "depth":
+			if _, ok := gq.Args["depth"]; ok {
+				return x.Errorf("Depth given both as an argument of the block and of @recurse")
+			}
+			gq.Args["depth"] = p.Val
+		case "loop", "annotate", "flat":
+			b, err := strconv.ParseBool(p.Val)
+			if err != nil {
+				return x.Errorf("Expected true or false for %s in @recurse. Got: %s", p.Key, p.Val)
+			}
+			switch p.Key {
+			case "loop":
+				gq.RecurseArgs.NoLoop = !b
+			case "annotate":
+				gq.RecurseArgs.Annotate = b
+			default:
+				gq.RecurseArgs.Flat = b
+			}
+		default:
+			return x.Errorf("Unknown argument %s of @recurse", p.Key)
+		}
+	}
+	return nil
+}
+
 // parseFilter parses the filter directive to produce a QueryFilter / parse tree.
 func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
 	it.Next()
@@ -1957,7 +2190,9 @@ func parseDirective(it *lex.ItemIterator, curp *GraphQuery) error {
 		return x.Errorf("Expected directive or language list")
 	}
 
-	if item.Val == "facets" { // because @facets can come w/t '()'
+	if item.Val == "withcount" { // @withcount has no arguments, like a language list.
+		curp.WithCount = true
+	} else if item.Val == "facets" { // because @facets can come w/t '()'
 		res, err := parseFacets(it)
 		if err != nil {
 			return err
@@ -1999,7 +2234,9 @@ func parseDirective(it *lex.ItemIterator, curp *GraphQuery) error {
 				return x.Errorf("Only one group by directive allowed.")
 			}
 			curp.IsGroupby = true
-			parseGroupby(it, curp)
+			if err := parseGroupby(it, curp); err != nil {
+				return err
+			}
 		default:
 			return x.Errorf("Unknown directive [%s]", item.Val)
 		}
@@ -2045,10 +2282,10 @@ func parseLanguageList(it *lex.ItemIterator) ([]string, error) {
 
 func validKeyAtRoot(k string) bool {
 	switch k {
-	case "func", "orderasc", "orderdesc", "first", "offset", "after":
+	case "func", "orderasc", "orderdesc", "nulls", "first", "offset", "after", "cursor":
 		return true
-	case "from", "to", "numpaths":
-		// Specific to shortest path
+	case "from", "to", "numpaths", "maxhops", "algo", "heuristic", "minweight", "maxweight":
+		// Specific to shortest path and paths
 		return true
 	case "depth":
 		return true
@@ -2059,7 +2296,10 @@ func validKeyAtRoot(k string) bool {
 // Check for validity of key at non-root nodes.
 func validKey(k string) bool {
 	switch k {
-	case "orderasc", "orderdesc", "first", "offset", "after":
+	case "orderasc", "orderdesc", "nulls", "first", "offset", "after", "cursor":
+		return true
+	case "mindepth", "maxdepth":
+		// Specific to the predicates of a recurse block
 		return true
 	}
 	return false
@@ -2196,7 +2436,11 @@ func getRoot(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
 				// Get language list, if present
 				items, err := it.Peek(1)
 				if err == nil && items[0].Typ == itemLeftRound {
-					if (key == "orderasc" || key == "orderdesc") && val != value {
+					if isSortkey(key) && val == "count" {
+						if val, err = parseCountOrder(it); err != nil {
+							return nil, err
+						}
+					} else if isSortkey(key) && val != value {
 						return nil, x.Errorf("Expected val(). Got %s() with order.", val)
 					}
 				}
@@ -2225,8 +2469,7 @@ func getRoot(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
 				if order[val] {
 					return nil, x.Errorf("Sorting by an attribute: [%s] can only be done once", val)
 				}
-				attr, langs := attrAndLang(val)
-				gq.Order = append(gq.Order, &protos.Order{attr, key == "orderdesc", langs})
+				gq.Order = append(gq.Order, sortOrder(key, val))
 				order[val] = true
 				continue
 			}
@@ -2245,6 +2488,38 @@ func isSortkey(k string) bool {
 	return k == "orderasc" || k == "orderdesc"
 }
 
+// parseCountOrder parses the count(predicate) value of a sort argument, the count keyword
+// being the current item.
+func parseCountOrder(it *lex.ItemIterator) (string, error) {
+	it.Next()
+	if item := it.Item(); item.Typ != itemLeftRound {
+		return "", x.Errorf("Expected a left round bracket after count. Got: %v", item)
+	}
+	it.Next()
+	item := it.Item()
+	if item.Typ != itemName {
+		return "", x.Errorf("Expected a predicate inside count() with order. Got: %v", item)
+	}
+	attr := collectName(it, item.Val)
+	it.Next()
+	if item = it.Item(); item.Typ != itemRightRound {
+		return "", x.Errorf("Only one predicate allowed inside count() with order. Got: %v", item)
+	}
+	return "count(" + attr + ")", nil
+}
+
+// sortOrder returns the order given by the value of a sort argument, either a predicate
+// with an optional language list or count(predicate).
+func sortOrder(key, val string) *protos.Order {
+	o := &protos.Order{Desc: key == "orderdesc"}
+	if strings.HasPrefix(val, "count(") && strings.HasSuffix(val, ")") {
+		o.Attr, o.Count = val[len("count("):len(val)-1], true
+		return o
+	}
+	o.Attr, o.Langs = attrAndLang(val)
+	return o
+}
+
 type Count int
 
 const (
@@ -2270,6 +2545,10 @@ func validateEmptyBlockItem(it *lex.ItemIterator, val string) error {
 		fname = item.Val
 	}
 	ok := trySkipItemTyp(it, itemLeftRound)
+	if ok && fname == "count" && trySkipItemVal(it, "distinct") {
+		// count(distinct val(x)) is an aggregator too.
+		fname = "distinct"
+	}
 	if !ok || (!isMathBlock(fname) && !isAggregator(fname)) {
 		return x.Errorf("Only aggregation/math functions allowed inside empty blocks."+
 			" Got: %v", fname)
@@ -2326,8 +2605,10 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 
 			val := collectName(it, item.Val)
 			valLower := strings.ToLower(val)
-			if gq.IsGroupby && (!isAggregator(val) && val != "count" && count != seen) {
-				// Only aggregator or count allowed inside the groupby block.
+			if gq.IsGroupby && (!isAggregator(val) && val != "count" && val != uid &&
+				count != seen) && peekIt[0].Typ != itemColon {
+				// Only aggregator, count or the uids of the members allowed inside the
+				// groupby block. They can have an alias.
 				return x.Errorf("Only aggregator/count functions allowed inside @groupby. Got: %v", val)
 			}
 
@@ -2368,39 +2649,9 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					goto Fall
 				}
 				it.Next()
-				if gq.IsGroupby {
-					item = it.Item()
-					attr := collectName(it, item.Val)
-					// Get language list, if present
-					items, err := it.Peek(1)
-					if err == nil && items[0].Typ == itemAt {
-						it.Next() // consume '@'
-						it.Next() // move forward
-						if child.Langs, err = parseLanguageList(it); err != nil {
-							return err
-						}
-					}
-					child.Attr = attr
-					child.IsInternal = false
-				} else {
-					if it.Item().Val != value {
-						return x.Errorf("Only variables allowed in aggregate functions. Got: %v",
-							it.Item().Val)
-					}
-					count, err := parseVarList(it, child)
-					if err != nil {
-						return err
-					}
-					if count != 1 {
-						x.Errorf("Expected one variable inside val() of aggregator but got %v", count)
-					}
-					child.NeedsVar[len(child.NeedsVar)-1].Typ = VALUE_VAR
-				}
-				child.Func = &Function{
-					Name:     valLower,
-					NeedsVar: child.NeedsVar,
+				if err := parseAggregator(it, gq, child, valLower); err != nil {
+					return err
 				}
-				it.Next() // Skip the closing ')'
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
@@ -2428,6 +2679,27 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
+			} else if isAnalyticCall(it, valLower) {
+				if varName == "" && alias == "" {
+					return x.Errorf("Function %s should be used with a variable or have an alias",
+						valLower)
+				}
+				analytic, err := parseAnalytic(it, valLower)
+				if err != nil {
+					return err
+				}
+				child := &GraphQuery{
+					Attr:       valLower,
+					Alias:      alias,
+					Args:       make(map[string]string),
+					Var:        varName,
+					Analytic:   analytic,
+					IsInternal: true,
+				}
+				varName, alias = "", ""
+				gq.Children = append(gq.Children, child)
+				curp = nil
+				continue
 			} else if isExpandFunc(valLower) {
 				if varName != "" {
 					return x.Errorf("expand() cannot be used with a variable", val)
@@ -2483,14 +2755,33 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				if err != nil {
 					return err
 				}
+				if peekIt[0].Val == "distinct" && peekIt[1].Typ == itemName {
+					// count(distinct pred) is aggregated like the other aggregators.
+					child := &GraphQuery{
+						Attr:       value,
+						Args:       make(map[string]string),
+						Var:        varName,
+						IsInternal: true,
+						Alias:      alias,
+					}
+					varName, alias = "", ""
+					count = notSeen
+					it.Next() // Skip distinct
+					it.Next()
+					if err := parseAggregator(it, gq, child, "distinct"); err != nil {
+						return err
+					}
+					gq.Children = append(gq.Children, child)
+					curp = nil
+					continue
+				}
 				if peekIt[0].Typ == itemRightRound {
 					return x.Errorf("Cannot use count(), please use count(uid)")
 				} else if peekIt[0].Val == uid && peekIt[1].Typ == itemRightRound {
 					if gq.IsGroupby {
 						// count(uid) case which occurs inside @groupby
 						val = uid
-						// Skip uid)
-						it.Next()
+						// Skip uid, the ')' ends the count.
 						it.Next()
 						goto Fall
 					}
@@ -2541,7 +2832,7 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
-			} else if valLower == uid {
+			} else if valLower == uid && !gq.IsGroupby {
 				if count == seen {
 					return x.Errorf("count of a variable is not allowed")
 				}
@@ -2622,8 +2913,7 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					if order[p.Val] {
 						return x.Errorf("Sorting by an attribute: [%s] can only be done once", p.Val)
 					}
-					attr, langs := attrAndLang(p.Val)
-					curp.Order = append(curp.Order, &protos.Order{attr, p.Key == "orderdesc", langs})
+					curp.Order = append(curp.Order, sortOrder(p.Key, p.Val))
 					order[p.Val] = true
 					continue
 				}
@@ -2646,8 +2936,79 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+// isAggregator returns true for the aggregators, distinct is the aggregator of
+// count(distinct ...).
 func isAggregator(fname string) bool {
-	return fname == "min" || fname == "max" || fname == "sum" || fname == "avg"
+	switch fname {
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance", "distinct":
+		return true
+	}
+	return false
+}
+
+// parseAggregator parses the arguments of an aggregator, starting after its left round
+// bracket, into child. The values aggregated are those of a predicate inside @groupby and
+// of a value variable elsewhere. percentile takes the percentile to compute as a second
+// argument.
+func parseAggregator(it *lex.ItemIterator, gq *GraphQuery, child *GraphQuery, fname string) error {
+	// Inside @groupby the aggregators are over predicates, and so is count(distinct pred) in
+	// any block. It's then computed over the uids of the block.
+	if gq.IsGroupby || (fname == "distinct" && it.Item().Val != value) {
+		item := it.Item()
+		attr := collectName(it, item.Val)
+		if !gq.IsGroupby && child.Var != "" {
+			return x.Errorf("Cannot assign a variable to count(distinct %s)", attr)
+		}
+		// Get language list, if present
+		items, err := it.Peek(1)
+		if err == nil && items[0].Typ == itemAt {
+			it.Next() // consume '@'
+			it.Next() // move forward
+			if child.Langs, err = parseLanguageList(it); err != nil {
+				return err
+			}
+		}
+		child.Attr = attr
+		child.IsInternal = false
+	} else {
+		if it.Item().Val != value {
+			return x.Errorf("Only variables allowed in aggregate functions. Got: %v",
+				it.Item().Val)
+		}
+		count, err := parseVarList(it, child)
+		if err != nil {
+			return err
+		}
+		if count != 1 {
+			return x.Errorf("Expected one variable inside val() of aggregator but got %v", count)
+		}
+		child.NeedsVar[len(child.NeedsVar)-1].Typ = VALUE_VAR
+	}
+	child.Func = &Function{
+		Name:     fname,
+		NeedsVar: child.NeedsVar,
+	}
+
+	it.Next()
+	item := it.Item()
+	if fname == "percentile" {
+		if item.Typ != itemComma {
+			return x.Errorf("Expected the percentile to compute as the second argument of percentile")
+		}
+		it.Next()
+		item = it.Item()
+		p, err := strconv.ParseFloat(item.Val, 64)
+		if err != nil || p < 0 || p > 100 {
+			return x.Errorf("Expected a percentile between 0 and 100. Got: %v", item.Val)
+		}
+		child.Func.Args = append(child.Func.Args, Arg{Value: item.Val})
+		it.Next()
+		item = it.Item()
+	}
+	if item.Typ != itemRightRound {
+		return x.Errorf("Expected ) after the arguments of %s. Got: %v", fname, item.Val)
+	}
+	return nil
 }
 
 func isExpandFunc(name string) bool {
@@ -18,6 +18,9 @@
 package gql
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/x"
@@ -44,10 +47,89 @@ func ParseMutation(mutation string) (*protos.Mutation, error) {
 				return nil, err
 			}
 		}
+		if item.Typ == itemMutationCond {
+			if mu == nil {
+				return nil, x.Errorf("Mutation is nil.")
+			}
+			if len(mu.Cond) > 0 {
+				return nil, x.Errorf("Only one condition allowed in a mutation.")
+			}
+			mu.Cond = item.Val
+		}
 	}
 	return nil, x.Errorf("Invalid mutation.")
 }
 
+// ParseCond parses the condition of a conditional mutation, e.g. @if(eq(len(v), 0)).
+// The @if directive is optional, so gt(len(u), 1) is accepted as well. The condition
+// is returned as a filter tree along with the query variables it depends on.
+func ParseCond(cond string) (*FilterTree, []string, error) {
+	cond = strings.TrimSpace(cond)
+	if !strings.HasPrefix(cond, "@") {
+		cond = "@if(" + cond + ")"
+	}
+	lexer := lex.Lexer{Input: "{" + cond + "}"}
+	lexer.Run(lexTopLevel)
+	it := lexer.NewIterator()
+
+	var ft *FilterTree
+	for it.Next() {
+		item := it.Item()
+		switch item.Typ {
+		case lex.ItemError:
+			return nil, nil, x.Errorf("%s", item.Val)
+		case itemAt:
+			it.Next()
+			if item = it.Item(); item.Typ != itemName || item.Val != "if" {
+				return nil, nil, x.Errorf("Expected @if in mutation condition. Got: %s", item.Val)
+			}
+			if ft != nil {
+				return nil, nil, x.Errorf("Only one @if allowed in mutation condition.")
+			}
+			var err error
+			if ft, err = parseFilter(it); err != nil {
+				return nil, nil, err
+			}
+		case itemName:
+			return nil, nil, x.Errorf("Unexpected %s in mutation condition.", item.Val)
+		}
+	}
+	if ft == nil {
+		return nil, nil, x.Errorf("Empty mutation condition.")
+	}
+	if err := checkCond(ft); err != nil {
+		return nil, nil, err
+	}
+
+	var v Vars
+	ft.collectVars(&v)
+	return ft, x.RemoveDuplicates(v.Needs), nil
+}
+
+// checkCond checks that every function in the condition compares len() of a variable
+// with an integer.
+func checkCond(ft *FilterTree) error {
+	if ft.Func != nil {
+		if !ft.Func.IsLenVar {
+			return x.Errorf("Only len() of variables can be used in mutation condition. Got: %s",
+				ft.Func.Name)
+		}
+		if len(ft.Func.Args) != 1 {
+			return x.Errorf("Expected one argument to compare len(%s) with.", ft.Func.Attr)
+		}
+		if _, err := strconv.ParseInt(ft.Func.Args[0].Value, 0, 64); err != nil {
+			return x.Errorf("Expected an integer to compare len(%s) with. Got: %s",
+				ft.Func.Attr, ft.Func.Args[0].Value)
+		}
+	}
+	for _, ch := range ft.Child {
+		if err := checkCond(ch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // parseMutationOp parses and stores set or delete operation string in Mutation.
 func parseMutationOp(it *lex.ItemIterator, op string, mu *protos.Mutation) error {
 	if mu == nil {
@@ -70,7 +152,9 @@ func parseMutationOp(it *lex.ItemIterator, op string, mu *protos.Mutation) error
 			if !parse {
 				return x.Errorf("Mutation syntax invalid.")
 			}
-			if op == "set" {
+			if op == "query" {
+				mu.Query = "{" + item.Val + "}"
+			} else if op == "set" {
 				mu.SetNquads = []byte(item.Val)
 			} else if op == "delete" {
 				mu.DelNquads = []byte(item.Val)
@@ -995,6 +995,111 @@ func TestParseShortestPath(t *testing.T) {
 	require.Equal(t, "3", res.Query[0].Args["numpaths"])
 }
 
+func TestParseShortestPathAlgo(t *testing.T) {
+	query := `
+	{
+		shortest(from: 0x0a, to: 0x0b, algo: astar, heuristic: loc) {
+			road @facets(length)
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, "astar", res.Query[0].Args["algo"])
+	require.Equal(t, "loc", res.Query[0].Args["heuristic"])
+}
+
+func TestParseShortestPathConstraints(t *testing.T) {
+	query := `
+	{
+		var(func: eq(kind, "checkpoint")) {
+			c as uid
+		}
+		shortest(from: 0x0a, to: 0x0b, minweight: 0.5, maxweight: 10) @avoid(eq(hub, true)) @through(uid(c)) {
+			road @facets(weight)
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	sp := res.Query[1]
+	require.Equal(t, "0.5", sp.Args["minweight"])
+	require.Equal(t, "10", sp.Args["maxweight"])
+	require.Equal(t, "eq", sp.Avoid.Func.Name)
+	require.Equal(t, "uid", sp.Through.Func.Name)
+	require.Equal(t, []string{"c"}, res.QueryVars[1].Needs)
+
+	query = `
+	{
+		shortest(from: 0x0a, to: 0x0b) @avoid(eq(hub, true)) @avoid(eq(hub, false)) {
+			road
+		}
+	}
+`
+	_, err = Parse(Request{Str: query, Http: true})
+	require.Error(t, err)
+}
+
+func TestParseRecurseArgs(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x0a)) @recurse(depth: 5, loop: false, annotate: true, flat: true) {
+			friends(maxdepth: 2) @filter(eq(flagged, false))
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	me := res.Query[0]
+	require.True(t, me.Recurse)
+	require.Equal(t, "5", me.Args["depth"])
+	require.Equal(t, RecurseArgs{NoLoop: true, Annotate: true, Flat: true}, me.RecurseArgs)
+	require.Equal(t, "2", me.Children[0].Args["maxdepth"])
+
+	query = `
+	{
+		me(func: uid(0x0a), depth: 2) @recurse {
+			friends
+		}
+	}
+`
+	res, err = Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, "2", res.Query[0].Args["depth"])
+	require.Equal(t, RecurseArgs{}, res.Query[0].RecurseArgs)
+
+	for _, directive := range []string{
+		"@recurse(loop: maybe)",
+		"@recurse(width: 2)",
+		"@recurse(depth: 2) @recurse",
+	} {
+		query = `{ me(func: uid(0x0a), depth: 2) ` + directive + ` { friends } }`
+		_, err = Parse(Request{Str: query, Http: true})
+		require.Error(t, err, directive)
+	}
+}
+
+func TestParseAllPaths(t *testing.T) {
+	query := `
+	{
+		p as paths(from: 0x0a, to: 0x0b, maxhops: 4, numpaths: 100) {
+			friends @filter(eq(flagged, false)) @facets(since)
+		}
+		me(func: uid(p)) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, "paths", res.Query[0].Alias)
+	require.Equal(t, "p", res.Query[0].Var)
+	require.Equal(t, "4", res.Query[0].Args["maxhops"])
+	require.Equal(t, "100", res.Query[0].Args["numpaths"])
+	require.NotNil(t, res.Query[0].Children[0].Filter)
+}
+
 func TestParseMultipleQueries(t *testing.T) {
 	query := `
 	{
@@ -1211,6 +1316,125 @@ func TestParseAfter(t *testing.T) {
 	require.Equal(t, res.Query[0].Children[1].Args["after"], "3")
 }
 
+func TestParseCursor(t *testing.T) {
+	query := `
+	query {
+		user(func: anyofterms(name, "alice"), orderasc: name, cursor: 0a0c12056b8f3e) {
+			friends (orderdesc: age, first: 10, cursor: fe1001) {
+				name
+			}
+		}
+	}`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.NotNil(t, res.Query[0])
+	require.Equal(t, "0a0c12056b8f3e", res.Query[0].Args["cursor"])
+	require.Equal(t, "fe1001", res.Query[0].Children[0].Args["cursor"])
+}
+
+func TestParseWithCount(t *testing.T) {
+	query := `
+	query {
+		user(func: uid(0x1), first: 10) @withcount {
+			friends (first: 10) @withcount {
+				name
+			}
+			name@en
+		}
+	}`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.True(t, res.Query[0].WithCount)
+	require.True(t, res.Query[0].Children[0].WithCount)
+	require.Equal(t, []string{"en"}, res.Query[0].Children[1].Langs)
+	require.False(t, res.Query[0].Children[1].WithCount)
+}
+
+func TestParseAnalytic(t *testing.T) {
+	query := `
+	{
+		var(func: has(follows)) {
+			pr as pagerank(follows, iterations: 20, damping: 0.85)
+			central: betweenness(~follows)
+		}
+		me(func: uid(pr), orderdesc: val(pr)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	children := res.Query[0].Children
+	require.Len(t, children, 2)
+	require.Equal(t, "pr", children[0].Var)
+	require.True(t, children[0].IsInternal)
+	require.Equal(t, &Analytic{
+		Name:  "pagerank",
+		Attrs: []string{"follows"},
+		Args:  map[string]string{"iterations": "20", "damping": "0.85"},
+	}, children[0].Analytic)
+	require.Equal(t, "central", children[1].Alias)
+	require.Equal(t, []string{"~follows"}, children[1].Analytic.Attrs)
+	require.Equal(t, []string{"pr"}, res.QueryVars[0].Defines)
+}
+
+func TestParseAnalyticError(t *testing.T) {
+	for _, q := range []string{
+		`{ me(func: uid(1)) { pagerank(follows) } }`,
+		`{ me(func: uid(1)) { pr as pagerank() } }`,
+		`{ me(func: uid(1)) { pr as pagerank(follows, damping) } }`,
+		`{ me(func: uid(1)) { pr as pagerank(follows, damping: 0.8, damping: 0.9) } }`,
+		`{ me(func: uid(1)) { c as components(maxnodes: 10) } }`,
+		`{ me(func: uid(1)) { c as components(follows, maxnodes: 10, ~follows) } }`,
+	} {
+		_, err := Parse(Request{Str: q, Http: true})
+		require.Error(t, err, q)
+	}
+}
+
+func TestParseAnalyticNameAsPredicate(t *testing.T) {
+	query := `
+	{
+	  me(func: uid(0x1)) {
+	    pagerank
+	    degree
+	    distance
+	    score
+	  }
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "pagerank", res.Query[0].Children[0].Attr)
+	require.Nil(t, res.Query[0].Children[0].Analytic)
+	require.Equal(t, "degree", res.Query[0].Children[1].Attr)
+	require.Equal(t, "distance", res.Query[0].Children[2].Attr)
+	require.Nil(t, res.Query[0].Children[2].Analytic)
+	require.Equal(t, "score", res.Query[0].Children[3].Attr)
+	require.Nil(t, res.Query[0].Children[3].Analytic)
+}
+
+func TestParseComponents(t *testing.T) {
+	query := `
+	{
+		var(func: uid(0x1)) {
+			c as components(follows, ~follows, maxnodes: 1000)
+		}
+		clusters(func: uid(c)) @groupby(val(c)) {
+			count(uid)
+		}
+	}`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, &Analytic{
+		Name:  "components",
+		Attrs: []string{"follows", "~follows"},
+		Args:  map[string]string{"maxnodes": "1000"},
+	}, res.Query[0].Children[0].Analytic)
+	require.True(t, res.Query[1].IsGroupby)
+	require.Equal(t, []AttrLang{{Var: "c"}}, res.Query[1].GroupbyAttrs)
+	require.Contains(t, res.Query[1].NeedsVar, VarContext{Name: "c", Typ: VALUE_VAR})
+}
+
 func TestParseOffset(t *testing.T) {
 	query := `
 	query {
@@ -2956,6 +3180,47 @@ func TestParseGroupby(t *testing.T) {
 	require.Equal(t, "en", res.Query[0].Children[0].GroupbyAttrs[0].Langs[0])
 }
 
+func TestParseGroupbyOrder(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(school, orderdesc: total, first: 2) {
+				total: sum(age)
+				count(uid)
+				top as uid(first: 3, orderdesc: age)
+			}
+		}
+		top(func: uid(top)) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	friends := res.Query[0].Children[0]
+	require.Equal(t, []AttrLang{{Attr: "school"}}, friends.GroupbyAttrs)
+	require.Equal(t, map[string]string{"orderdesc": "total", "first": "2"}, friends.GroupbyArgs)
+	require.Equal(t, 3, len(friends.Children))
+	require.Equal(t, "total", friends.Children[0].Alias)
+	members := friends.Children[2]
+	require.Equal(t, "uid", members.Attr)
+	require.Equal(t, "top", members.Var)
+	require.Equal(t, "3", members.Args["first"])
+	require.Equal(t, "age", members.Order[0].Attr)
+	require.True(t, members.Order[0].Desc)
+
+	for _, directive := range []string{
+		"@groupby(school, sortby: total)",
+		"@groupby(school, first: 1, first: 2)",
+		"@groupby(school, orderasc: count, orderdesc: count)",
+		"@groupby(first: 2)",
+	} {
+		query = `{ me(func: uid(0x1)) { friends ` + directive + ` { count(uid) } } }`
+		_, err = Parse(Request{Str: query, Http: true})
+		require.Error(t, err, directive)
+	}
+}
+
 func TestParseGroupbyError(t *testing.T) {
 	// predicates not allowed inside groupby.
 	query := `
@@ -2975,6 +3240,99 @@ func TestParseGroupbyError(t *testing.T) {
 	require.Contains(t, err.Error(), "Only aggregator/count functions allowed inside @groupby")
 }
 
+func TestParseGroupbyStatistics(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(school) {
+				median(age)
+				percentile(age@en, 95)
+				count(distinct name)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	children := res.Query[0].Children[0].Children
+	require.Equal(t, 3, len(children))
+	require.Equal(t, "median", children[0].Func.Name)
+	require.Equal(t, "age", children[0].Attr)
+	require.Equal(t, "percentile", children[1].Func.Name)
+	require.Equal(t, []Arg{{Value: "95"}}, children[1].Func.Args)
+	require.Equal(t, []string{"en"}, children[1].Langs)
+	require.Equal(t, "distinct", children[2].Func.Name)
+	require.Equal(t, "name", children[2].Attr)
+	require.True(t, children[2].Func.IsAggregator())
+}
+
+func TestParseStatisticsOfVariables(t *testing.T) {
+	query := `
+	{
+		var(func: uid(0x1)) {
+			a as age
+		}
+		me() {
+			stddev(val(a))
+			pct: percentile(val(a), 99.5)
+			count(distinct val(a))
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	children := res.Query[1].Children
+	require.Equal(t, 3, len(children))
+	require.Equal(t, "stddev", children[0].Func.Name)
+	require.Equal(t, "pct", children[1].Alias)
+	require.Equal(t, []Arg{{Value: "99.5"}}, children[1].Func.Args)
+	require.Equal(t, "distinct", children[2].Func.Name)
+	require.Equal(t, "a", children[2].NeedsVar[0].Name)
+
+	for _, agg := range []string{
+		"percentile(val(a))",
+		"percentile(val(a), 101)",
+		"median(val(a), 50)",
+	} {
+		query = `{ var(func: uid(0x1)) { a as age } me() { ` + agg + ` } }`
+		_, err = Parse(Request{Str: query, Http: true})
+		require.Error(t, err, agg)
+	}
+
+	query = `{ var(func: uid(0x1)) { a as age b as count(friend) } me() { min(val(a, b)) } }`
+	_, err = Parse(Request{Str: query, Http: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected one variable inside val() of aggregator")
+}
+
+func TestParseCountDistinct(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x1)) {
+			friend {
+				ages: count(distinct age)
+				count(distinct name@en)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	children := res.Query[0].Children[0].Children
+	require.Equal(t, 2, len(children))
+	require.Equal(t, "age", children[0].Attr)
+	require.Equal(t, "ages", children[0].Alias)
+	require.Equal(t, "distinct", children[0].Func.Name)
+	require.False(t, children[0].IsInternal)
+	require.Equal(t, "name", children[1].Attr)
+	require.Equal(t, []string{"en"}, children[1].Langs)
+
+	query = `{ me(func: uid(0x1)) { d as count(distinct age) } }`
+	_, err = Parse(Request{Str: query, Http: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Cannot assign a variable to count(distinct age)")
+}
+
 func TestParseFacetsError1(t *testing.T) {
 	query := `
 	query {
@@ -3499,6 +3857,143 @@ func TestParseQueryWithAttrLang2(t *testing.T) {
 	require.Equal(t, []string{"en"}, res.Query[0].Order[0].Langs)
 }
 
+func TestParseOrderByCount(t *testing.T) {
+	query := `
+	{
+		me(func: has(friend), orderdesc: count(friend), orderasc: name@en, first: 10) {
+			name
+			friend(orderasc: count(~friend), orderdesc: friend) {
+				name
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, []*protos.Order{
+		{Attr: "friend", Desc: true, Count: true},
+		{Attr: "name", Langs: []string{"en"}},
+	}, res.Query[0].Order)
+	require.Equal(t, []*protos.Order{
+		{Attr: "~friend", Count: true},
+		{Attr: "friend", Desc: true},
+	}, res.Query[0].Children[1].Order)
+}
+
+func TestParseOrderNulls(t *testing.T) {
+	query := `
+	{
+		me(func: has(name), orderasc: name, nulls: last) {
+			friend(orderdesc: age, nulls: exclude) {
+				name
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, "last", res.Query[0].Args["nulls"])
+	require.Equal(t, "exclude", res.Query[0].Children[0].Args["nulls"])
+}
+
+func TestParseOrderByCountError(t *testing.T) {
+	query := `
+	{
+		me(func: has(friend), orderdesc: count(friend, name)) {
+			name
+		}
+	}
+`
+	_, err := Parse(Request{Str: query, Http: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Only one predicate allowed inside count() with order")
+
+	query = `
+	{
+		me(func: uid(1)) {
+			friend(orderasc: count(friend), orderdesc: count(friend)) {
+				name
+			}
+		}
+	}
+`
+	_, err = Parse(Request{Str: query, Http: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Sorting by an attribute: [count(friend)] can only be done once")
+}
+
+func TestParsePrefix(t *testing.T) {
+	query := `
+	{
+	  me(func: prefix(name, "joh")) {
+	    name
+	    friend @filter(prefix(name@en, "ri gr")) {
+	      name
+	    }
+	  }
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "prefix", res.Query[0].Func.Name)
+	require.Equal(t, "joh", res.Query[0].Func.Args[0].Value)
+	require.Equal(t, "prefix", res.Query[0].Children[1].Filter.Func.Name)
+	require.Equal(t, "en", res.Query[0].Children[1].Filter.Func.Lang)
+}
+
+func TestParseMatch(t *testing.T) {
+	query := `
+	{
+	  me(func: match(name, "jonh", 2)) {
+	    d as distance(name)
+	  }
+	  you(func: uid(d), orderasc: val(d)) {
+	    name
+	  }
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "match", res.Query[0].Func.Name)
+	require.Equal(t, []Arg{{Value: "jonh"}, {Value: "2"}}, res.Query[0].Func.Args)
+	require.Equal(t, &Analytic{Name: "distance", Attrs: []string{"name"},
+		Args: map[string]string{}}, res.Query[0].Children[0].Analytic)
+}
+
+func TestParseScore(t *testing.T) {
+	query := `
+	{
+	  me(func: alloftext(description@en, "graph database")) {
+	    s as score(description)
+	  }
+	  you(func: uid(s), orderdesc: val(s)) {
+	    description
+	  }
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "alloftext", res.Query[0].Func.Name)
+	require.Equal(t, &Analytic{Name: "score", Attrs: []string{"description"},
+		Args: map[string]string{}}, res.Query[0].Children[0].Analytic)
+}
+
+func TestParseHighlight(t *testing.T) {
+	query := `
+	{
+	  me(func: anyoftext(description, "graph")) {
+	    h: highlight(description, pre: "<b>", post: "</b>", snippet: 10)
+	  }
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "h", res.Query[0].Children[0].Alias)
+	require.Equal(t, &Analytic{Name: "highlight", Attrs: []string{"description"},
+		Args: map[string]string{"pre": `"<b>"`, "post": `"</b>"`, "snippet": "10"}},
+		res.Query[0].Children[0].Analytic)
+}
+
 func TestParseRegexp1(t *testing.T) {
 	query := `
 	{
@@ -4272,3 +4767,258 @@ func TestParseMutation(t *testing.T) {
 		dels[0])
 
 }
+
+func TestParseMutationWithQuery(t *testing.T) {
+	m := `
+		{
+			query {
+				me(func: eq(email, "alice@dgraph.io")) {
+					v as uid
+					n as name
+				}
+			}
+			set {
+				uid(v) <email> "alice@dgraph.io" .
+				uid(v) <nick> val(n) .
+			}
+		}
+	`
+	mu, err := ParseMutation(m)
+	require.NoError(t, err)
+	require.NotNil(t, mu)
+	sets, err := rdf.ConvertToNQuads(string(mu.SetNquads))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sets))
+	require.Equal(t, "v", sets[0].SubjectVar)
+	require.Equal(t, "n", sets[1].ValueVar)
+
+	res, err := ParseWithNeedVars(Request{Str: mu.Query}, []string{"n", "v"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Query))
+	require.Equal(t, "me", res.Query[0].Alias)
+
+	// Without the needed vars, the vars defined in the query are unused.
+	_, err = Parse(Request{Str: mu.Query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Some variables are defined but not used")
+}
+
+func TestParseMutationWithQueryNeedVarsUndefined(t *testing.T) {
+	q := `{
+		me(func: eq(email, "alice@dgraph.io")) {
+			v as uid
+		}
+	}`
+	_, err := ParseWithNeedVars(Request{Str: q}, []string{"u", "v"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Some variables are used but not defined")
+}
+
+func TestParseMutationWithQueryBraces(t *testing.T) {
+	m := `
+		{
+			query {
+				me(func: eq(name, "{not a block}")) {
+					v as uid
+				}
+			}
+			delete {
+				uid(v) <name> * .
+			}
+		}
+	`
+	mu, err := ParseMutation(m)
+	require.NoError(t, err)
+	require.Contains(t, mu.Query, `"{not a block}"`)
+	require.Equal(t, []byte(`
+				uid(v) <name> * .
+			`), mu.DelNquads)
+}
+
+func TestParseMutationWithQueryUnclosed(t *testing.T) {
+	m := `
+		{
+			query {
+				me(func: eq(name, "alice")) {
+					v as uid
+			}
+			set {
+				uid(v) <name> "bob" .
+			}
+		}
+	`
+	_, err := ParseMutation(m)
+	require.Error(t, err)
+}
+
+func TestParseMutationWithCond(t *testing.T) {
+	m := `
+		{
+			query {
+				me(func: eq(email, "alice@dgraph.io")) {
+					v as uid
+				}
+			}
+			@if(eq(len(v), 0))
+			set {
+				_:alice <email> "alice@dgraph.io" .
+			}
+		}
+	`
+	mu, err := ParseMutation(m)
+	require.NoError(t, err)
+	require.Equal(t, "@if(eq(len(v), 0))", mu.Cond)
+
+	ft, vars, err := ParseCond(mu.Cond)
+	require.NoError(t, err)
+	require.Equal(t, []string{"v"}, vars)
+	require.Equal(t, `(eq v "0")`, ft.debugString())
+}
+
+func TestParseMutationWithTwoConds(t *testing.T) {
+	m := `
+		{
+			@if(eq(len(v), 0))
+			@if(eq(len(u), 0))
+			set {
+				_:alice <email> "alice@dgraph.io" .
+			}
+		}
+	`
+	_, err := ParseMutation(m)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Only one condition allowed")
+}
+
+func TestParseCond(t *testing.T) {
+	ft, vars, err := ParseCond(`gt(len(u), 1) AND (eq(len(v), 0) OR NOT lt(len(w), 3))`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"u", "v", "w"}, vars)
+	require.Equal(t, `(AND (gt u "1") (OR (eq v "0") (NOT (lt w "3"))))`, ft.debugString())
+}
+
+func TestParseCondInvalid(t *testing.T) {
+	tests := []struct {
+		cond string
+		err  string
+	}{
+		{`@if(eq(name, "alice"))`, "Only len() of variables"},
+		{`@if(eq(len(v), "a"))`, "Expected an integer"},
+		{`@filter(eq(len(v), 0))`, "Expected @if"},
+		{`@if(eq(len(v), 0)) @if(eq(len(u), 0))`, "Only one @if"},
+		{`@if(has(len(v)))`, "len() can only be used within inequality functions"},
+	}
+	for _, tc := range tests {
+		_, _, err := ParseCond(tc.cond)
+		require.Error(t, err, tc.cond)
+		require.Contains(t, err.Error(), tc.err, tc.cond)
+	}
+}
+
+func TestParseLenOutsideCond(t *testing.T) {
+	q := `{
+		me(func: uid(1)) {
+			v as friend
+		}
+		you(func: uid(2)) @filter(eq(len(v), 0)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: q})
+	require.NoError(t, err)
+	require.True(t, res.Query[1].Filter.Func.IsLenVar)
+}
+
+func TestParseTypeFunction(t *testing.T) {
+	query := `{
+		me(func: type(Person)) @filter(type(Actor, Director)) {
+			expand(_all_)
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := res.Query[0].Func
+	require.Equal(t, "eq", fn.Name)
+	require.Equal(t, "_type_", fn.Attr)
+	require.Equal(t, []Arg{{Value: "Person"}}, fn.Args)
+	require.Equal(t, `(eq _type_ "Actor" "Director")`, res.Query[0].Filter.debugString())
+}
+
+func TestParseTypeFunctionError(t *testing.T) {
+	query := `{
+		me(func: type(val(a))) {
+			name
+		}
+	}`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type() only accepts names of types")
+}
+
+func TestParseSchemaTypes(t *testing.T) {
+	query := `
+		schema (pred: [name, age], type: Person) {
+			type
+		}
+	`
+	res, err := Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"name", "age"}, res.Schema.Predicates)
+	require.Equal(t, []string{"Person"}, res.Schema.Types)
+
+	query = `
+		schema (type: [Person, Animal]) {}
+	`
+	res, err = Parse(Request{Str: query, Http: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(res.Schema.Predicates))
+	require.Equal(t, []string{"Person", "Animal"}, res.Schema.Types)
+
+	query = `
+		schema (pred: name type: Person) {}
+	`
+	_, err = Parse(Request{Str: query, Http: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid schema block")
+}
+
+func TestParseSetFunctions(t *testing.T) {
+	query := `{
+		var(func: uid(1)) {
+			a as friend
+			b as follower
+			c as colleague
+		}
+		me(func: difference(a, b)) @filter(intersect(a, c) OR union(b, c)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := res.Query[1].Func
+	require.Equal(t, "difference", fn.Name)
+	require.Equal(t, "", fn.Attr)
+	require.Equal(t, []Arg{{Value: "a"}, {Value: "b"}}, fn.Args)
+	require.Equal(t, []VarContext{{Name: "a", Typ: UID_VAR}, {Name: "b", Typ: UID_VAR}},
+		fn.NeedsVar)
+	ft := res.Query[1].Filter
+	require.Equal(t, "or", ft.Op)
+	require.Equal(t, "intersect", ft.Child[0].Func.Name)
+	require.Equal(t, []Arg{{Value: "a"}, {Value: "c"}}, ft.Child[0].Func.Args)
+	require.Equal(t, "union", ft.Child[1].Func.Name)
+	require.Equal(t, []Arg{{Value: "b"}, {Value: "c"}}, ft.Child[1].Func.Args)
+}
+
+func TestParseSetFunctionOneVar(t *testing.T) {
+	query := `{
+		var(func: uid(1)) {
+			a as friend
+		}
+		me(func: intersect(a)) {
+			name
+		}
+	}`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected at least two variables in intersect()")
+}
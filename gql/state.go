@@ -64,6 +64,7 @@ const (
 	itemRightSquare
 	itemComma
 	itemMathOp
+	itemMutationCond // mutation condition
 )
 
 func lexInsideMutation(l *lex.Lexer) lex.StateFn {
@@ -86,6 +87,8 @@ func lexInsideMutation(l *lex.Lexer) lex.StateFn {
 			l.Ignore()
 		case isNameBegin(r):
 			return lexNameMutation
+		case r == at:
+			return lexCondMutation
 		case r == '#':
 			return lexComment
 		case r == lex.EOF:
@@ -391,7 +394,7 @@ func lexComment(l *lex.Lexer) lex.StateFn {
 	return l.Mode
 }
 
-// lexNameMutation lexes the itemMutationOp, which could be set or delete.
+// lexNameMutation lexes the itemMutationOp, which could be set, delete or query.
 func lexNameMutation(l *lex.Lexer) lex.StateFn {
 	for {
 		// The caller already checked isNameBegin, and absorbed one rune.
@@ -400,12 +403,75 @@ func lexNameMutation(l *lex.Lexer) lex.StateFn {
 			continue
 		}
 		l.Backup()
-		l.Emit(itemMutationOp)
 		break
 	}
+	op := l.Input[l.Start:l.Pos]
+	l.Emit(itemMutationOp)
+	if op == "query" {
+		return lexQueryMutation
+	}
 	return l.Mode
 }
 
+// lexQueryMutation lexes the query block of an upsert mutation. The block is
+// absorbed as is, keeping balanced curly braces, so that it can later be handed
+// over to Parse.
+func lexQueryMutation(l *lex.Lexer) lex.StateFn {
+	l.IgnoreRun(func(r rune) bool { return isSpace(r) || isEndOfLine(r) })
+	if r := l.Next(); r != leftCurl {
+		return l.Errorf("Expected '{' after query inside mutation, found: %#U", r)
+	}
+	l.Depth++
+	l.Emit(itemLeftCurl)
+
+	depth := 1
+	for {
+		switch r := l.Next(); {
+		case r == lex.EOF:
+			return l.Errorf("Unclosed query block inside mutation")
+		case r == quote:
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf(err.Error())
+			}
+		case r == leftCurl:
+			depth++
+		case r == rightCurl:
+			depth--
+			if depth == 0 {
+				l.Backup()
+				l.Emit(itemMutationContent)
+				return lexInsideMutation
+			}
+		}
+	}
+}
+
+// lexCondMutation lexes the condition of a conditional mutation, e.g. @if(eq(len(v), 0)).
+// The caller already absorbed the '@'.
+func lexCondMutation(l *lex.Lexer) lex.StateFn {
+	depth := 0
+	for {
+		switch r := l.Next(); {
+		case r == lex.EOF:
+			return l.Errorf("Unclosed condition inside mutation")
+		case r == quote:
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf(err.Error())
+			}
+		case r == leftRound:
+			depth++
+		case r == rightRound:
+			depth--
+			if depth == 0 {
+				l.Emit(itemMutationCond)
+				return lexInsideMutation
+			}
+		case depth == 0 && !isNameSuffix(r):
+			return l.Errorf("Unexpected character in mutation condition: %#U", r)
+		}
+	}
+}
+
 // lexTextMutation lexes and absorbs the text inside a mutation operation block.
 func lexTextMutation(l *lex.Lexer) lex.StateFn {
 	for {
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -100,14 +102,143 @@ func (txn *Txn) addIndexMutations(ctx context.Context, t *protos.DirectedEdge, p
 		Op:      op,
 	}
 
+	freqs, length, err := fullTextFrequencies(attr, t.GetLang(), p)
+	if err != nil {
+		return err
+	}
 	for _, token := range tokens {
-		if err := txn.addIndexMutation(ctx, edge, token); err != nil {
+		e := edge
+		if freq, ok := freqs[token]; ok && op == protos.DirectedEdge_SET {
+			e = &protos.DirectedEdge{
+				ValueId: uid,
+				Attr:    attr,
+				Op:      op,
+				Facets:  []*protos.Facet{intFacet(TermFrequencyFacet, freq)},
+			}
+		}
+		if err := txn.addIndexMutation(ctx, e, token); err != nil {
 			return err
 		}
 	}
+	if length > 0 {
+		return txn.addDocLength(ctx, attr, uid, length, op)
+	}
 	return nil
 }
 
+const (
+	// TermFrequencyFacet is the facet on the full-text index postings which keeps the number
+	// of times the token occurs in the value of the uid.
+	TermFrequencyFacet = "tf"
+	// DocLengthFacet is the facet on the posting under tok.DocLengthToken() which keeps the
+	// number of terms in the values of the uid. On the postings under tok.DocStatsToken(),
+	// it keeps their total for the uids of the shard.
+	DocLengthFacet = "dl"
+	// NumDocsFacet is the facet on the postings under tok.DocStatsToken() which keeps the
+	// number of uids of the shard with full-text values.
+	NumDocsFacet = "nd"
+	// DocStatsShards is the number of shards the totals of a predicate are kept in. Writers
+	// of uids in different shards don't conflict on them.
+	DocStatsShards = 32
+)
+
+// fullTextFrequencies returns the term frequencies and the number of terms of the value, if
+// the attribute has a full-text index. With an analyzer defined in the schema, the value is
+// analyzed by it whatever its language.
+func fullTextFrequencies(attr, lang string, src types.Val) (map[string]int, int, error) {
+	for _, it := range schema.State().Tokenizer(attr) {
+		ft := tok.FullTextTokenizer{Lang: lang}
+		if analyzer, ok := tok.AnalyzerOf(it.Name()); ok {
+			ft = tok.FullTextTokenizer{Analyzer: analyzer}
+		} else if it.Name() != tok.FtsTokenizerName("") {
+			continue
+		}
+		sv, err := types.Convert(src, types.StringID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return ft.TermFrequencies(sv.Value)
+	}
+	return nil, 0, nil
+}
+
+// addDocLength adds the number of terms in a full-text value to those kept for the uid, or
+// subtracts them when the value is deleted. The uid is left out once it has none. The
+// totals of the predicate are updated in the shard of the uid.
+func (txn *Txn) addDocLength(ctx context.Context, attr string, uid uint64, length int,
+	op protos.DirectedEdge_Op) error {
+	if op != protos.DirectedEdge_SET {
+		length = -length
+	}
+	before, err := txn.addIntFacets(ctx, x.IndexKey(attr, tok.DocLengthToken(uid)), attr, uid,
+		[]string{DocLengthFacet}, []int{length})
+	if err != nil {
+		return err
+	}
+	var docs int
+	if after := before[0] + length; before[0] <= 0 && after > 0 {
+		docs = 1
+	} else if before[0] > 0 && after <= 0 {
+		docs = -1
+	}
+	key := x.IndexKey(attr, tok.DocStatsToken(int(uid%DocStatsShards)))
+	_, err = txn.addIntFacets(ctx, key, attr, math.MaxUint64,
+		[]string{DocLengthFacet, NumDocsFacet}, []int{length, docs})
+	return err
+}
+
+// addIntFacets adds the deltas to the int facets with the given keys, which are sorted, of
+// the posting of the uid in the list of the key. It returns the values they had before.
+// The posting is deleted once they are all zero.
+func (txn *Txn) addIntFacets(ctx context.Context, key []byte, attr string, uid uint64,
+	keys []string, deltas []int) ([]int, error) {
+	plist := Get(key)
+	plist.Lock()
+	defer plist.Unlock()
+	_, p, err := plist.findPosting(txn.StartTs, uid)
+	if err != nil {
+		return nil, err
+	}
+	before := make([]int, len(keys))
+	edge := &protos.DirectedEdge{
+		ValueId: uid,
+		Attr:    attr,
+		Op:      protos.DirectedEdge_DEL,
+	}
+	for i, k := range keys {
+		before[i] = FacetInt(p, k)
+		if v := before[i] + deltas[i]; v > 0 {
+			edge.Op = protos.DirectedEdge_SET
+			edge.Facets = append(edge.Facets, intFacet(k, v))
+		}
+	}
+	_, err = plist.addMutation(ctx, txn, edge)
+	return before, err
+}
+
+func intFacet(key string, val int) *protos.Facet {
+	f, err := facets.FacetFor(key, strconv.Itoa(val))
+	x.Check(err)
+	return f
+}
+
+// FacetInt returns the value of the int facet with the given key on the posting, or 0 if
+// there is none.
+func FacetInt(p *protos.Posting, key string) int {
+	if p == nil {
+		return 0
+	}
+	for _, f := range p.Facets {
+		if f.Key != key {
+			continue
+		}
+		if v, ok := facets.ValFor(f).Value.(int64); ok {
+			return int(v)
+		}
+	}
+	return 0
+}
+
 func (txn *Txn) addIndexMutation(ctx context.Context, edge *protos.DirectedEdge,
 	token string) error {
 	key := x.IndexKey(edge.Attr, token)
@@ -29,6 +29,7 @@ import (
 
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
@@ -110,6 +111,47 @@ func TestIndexingInvalidLang(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestIndexingFullTextFrequencies(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("text:string @index(fulltext) ."), 1))
+	postingOf := func(token string, uid, readTs uint64) *protos.Posting {
+		pl := Get(x.IndexKey("text", token))
+		pl.RLock()
+		defer pl.RUnlock()
+		_, p, err := pl.findPosting(readTs, uid)
+		require.NoError(t, err)
+		return p
+	}
+	posting := func(token string, readTs uint64) *protos.Posting {
+		return postingOf(token, 1, readTs)
+	}
+	stats := func(readTs uint64) *protos.Posting {
+		return postingOf(tok.DocStatsToken(1), math.MaxUint64, readTs)
+	}
+
+	l := Get(x.DataKey("text", 1))
+	edge := &protos.DirectedEdge{Value: []byte("Cats chase cats"), Attr: "text", Entity: 1}
+	addMutation(t, l, edge, Set, 1, 2, true)
+	require.Equal(t, 2, FacetInt(posting("\x08cat", 3), TermFrequencyFacet))
+	require.Equal(t, 1, FacetInt(posting("\x08chase", 3), TermFrequencyFacet))
+	require.Equal(t, 3, FacetInt(posting(tok.DocLengthToken(1), 3), DocLengthFacet))
+	require.Equal(t, 3, FacetInt(stats(3), DocLengthFacet))
+	require.Equal(t, 1, FacetInt(stats(3), NumDocsFacet))
+
+	// The statistics of the old value are replaced.
+	edge = &protos.DirectedEdge{Value: []byte("A cat"), Attr: "text", Entity: 1}
+	addMutation(t, l, edge, Set, 3, 4, true)
+	require.Equal(t, 1, FacetInt(posting("\x08cat", 5), TermFrequencyFacet))
+	require.Nil(t, posting("\x08chase", 5))
+	require.Equal(t, 1, FacetInt(posting(tok.DocLengthToken(1), 5), DocLengthFacet))
+	require.Equal(t, 1, FacetInt(stats(5), DocLengthFacet))
+	require.Equal(t, 1, FacetInt(stats(5), NumDocsFacet))
+
+	edge = &protos.DirectedEdge{Value: []byte("A cat"), Attr: "text", Entity: 1}
+	addMutation(t, l, edge, Del, 5, 6, true)
+	require.Nil(t, posting(tok.DocLengthToken(1), 7))
+	require.Nil(t, stats(7))
+}
+
 func addMutation(t *testing.T, l *List, edge *protos.DirectedEdge, op uint32,
 	startTs uint64, commitTs uint64, index bool) {
 	if op == Del {
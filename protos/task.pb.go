@@ -63,6 +63,12 @@
 		Response
 		Check
 		Version
+		TypeUpdate
+		Property
+		Node
+		Cursor
+		BlockCursor
+		AnalyzerUpdate
 */
 package protos
 
@@ -366,6 +372,8 @@ type Query struct {
 	FacetsFilter *FilterTree  `protobuf:"bytes,9,opt,name=facets_filter,json=facetsFilter" json:"facets_filter,omitempty"`
 	ReadTs       uint64       `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
 	LinRead      *LinRead     `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	Budget       uint64       `protobuf:"varint,15,opt,name=budget,proto3" json:"budget,omitempty"`
+	ReadKeys     bool         `protobuf:"varint,16,opt,name=read_keys,json=readKeys,proto3" json:"read_keys,omitempty"`
 }
 
 func (m *Query) Reset()                    { *m = Query{} }
@@ -450,6 +458,20 @@ func (m *Query) GetLinRead() *LinRead {
 	return nil
 }
 
+func (m *Query) GetBudget() uint64 {
+	if m != nil {
+		return m.Budget
+	}
+	return 0
+}
+
+func (m *Query) GetReadKeys() bool {
+	if m != nil {
+		return m.ReadKeys
+	}
+	return false
+}
+
 type ValueList struct {
 	Values []*TaskValue `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
 }
@@ -473,6 +495,11 @@ type Result struct {
 	IntersectDest bool          `protobuf:"varint,4,opt,name=intersect_dest,json=intersectDest,proto3" json:"intersect_dest,omitempty"`
 	FacetMatrix   []*FacetsList `protobuf:"bytes,5,rep,name=facet_matrix,json=facetMatrix" json:"facet_matrix,omitempty"`
 	LinRead       *LinRead      `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	GroupId       uint32        `protobuf:"varint,15,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	NodeId        uint64        `protobuf:"varint,16,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Access        string        `protobuf:"bytes,17,opt,name=access,proto3" json:"access,omitempty"`
+	// Filled in if read_keys was set in the query.
+	Keys []string `protobuf:"bytes,18,rep,name=keys" json:"keys,omitempty"`
 }
 
 func (m *Result) Reset()                    { *m = Result{} }
@@ -522,10 +549,39 @@ func (m *Result) GetLinRead() *LinRead {
 	return nil
 }
 
+func (m *Result) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *Result) GetNodeId() uint64 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *Result) GetAccess() string {
+	if m != nil {
+		return m.Access
+	}
+	return ""
+}
+
+func (m *Result) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 type Order struct {
 	Attr  string   `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
 	Desc  bool     `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
 	Langs []string `protobuf:"bytes,3,rep,name=langs" json:"langs,omitempty"`
+	Count bool     `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (m *Order) Reset()                    { *m = Order{} }
@@ -554,13 +610,23 @@ func (m *Order) GetLangs() []string {
 	return nil
 }
 
+func (m *Order) GetCount() bool {
+	if m != nil {
+		return m.Count
+	}
+	return false
+}
+
 type SortMessage struct {
-	Order     []*Order `protobuf:"bytes,1,rep,name=order" json:"order,omitempty"`
-	UidMatrix []*List  `protobuf:"bytes,2,rep,name=uid_matrix,json=uidMatrix" json:"uid_matrix,omitempty"`
-	Count     int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Offset    int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	ReadTs    uint64   `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
-	LinRead   *LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	Order         []*Order `protobuf:"bytes,1,rep,name=order" json:"order,omitempty"`
+	UidMatrix     []*List  `protobuf:"bytes,2,rep,name=uid_matrix,json=uidMatrix" json:"uid_matrix,omitempty"`
+	Count         int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Offset        int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ReadTs        uint64   `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	LinRead       *LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	Cursor        *Cursor  `protobuf:"bytes,15,opt,name=cursor" json:"cursor,omitempty"`
+	ReturnCursors bool     `protobuf:"varint,16,opt,name=return_cursors,json=returnCursors,proto3" json:"return_cursors,omitempty"`
+	Nulls         string   `protobuf:"bytes,5,opt,name=nulls,proto3" json:"nulls,omitempty"`
 }
 
 func (m *SortMessage) Reset()                    { *m = SortMessage{} }
@@ -610,9 +676,31 @@ func (m *SortMessage) GetLinRead() *LinRead {
 	return nil
 }
 
+func (m *SortMessage) GetCursor() *Cursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *SortMessage) GetReturnCursors() bool {
+	if m != nil {
+		return m.ReturnCursors
+	}
+	return false
+}
+
+func (m *SortMessage) GetNulls() string {
+	if m != nil {
+		return m.Nulls
+	}
+	return ""
+}
+
 type SortResult struct {
-	UidMatrix []*List  `protobuf:"bytes,1,rep,name=uid_matrix,json=uidMatrix" json:"uid_matrix,omitempty"`
-	LinRead   *LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	UidMatrix []*List   `protobuf:"bytes,1,rep,name=uid_matrix,json=uidMatrix" json:"uid_matrix,omitempty"`
+	LinRead   *LinRead  `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	Cursors   []*Cursor `protobuf:"bytes,15,rep,name=cursors" json:"cursors,omitempty"`
 }
 
 func (m *SortResult) Reset()                    { *m = SortResult{} }
@@ -634,6 +722,13 @@ func (m *SortResult) GetLinRead() *LinRead {
 	return nil
 }
 
+func (m *SortResult) GetCursors() []*Cursor {
+	if m != nil {
+		return m.Cursors
+	}
+	return nil
+}
+
 type RaftContext struct {
 	Id    uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
 	Group uint32 `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
@@ -1041,11 +1136,13 @@ func (m *DirectedEdge) GetFacets() []*Facet {
 }
 
 type Mutations struct {
-	GroupId uint32          `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	StartTs uint64          `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	Edges   []*DirectedEdge `protobuf:"bytes,3,rep,name=edges" json:"edges,omitempty"`
-	Schema  []*SchemaUpdate `protobuf:"bytes,4,rep,name=schema" json:"schema,omitempty"`
-	DropAll bool            `protobuf:"varint,5,opt,name=DropAll,proto3" json:"DropAll,omitempty"`
+	GroupId   uint32            `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StartTs   uint64            `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	Edges     []*DirectedEdge   `protobuf:"bytes,3,rep,name=edges" json:"edges,omitempty"`
+	Schema    []*SchemaUpdate   `protobuf:"bytes,4,rep,name=schema" json:"schema,omitempty"`
+	DropAll   bool              `protobuf:"varint,5,opt,name=DropAll,proto3" json:"DropAll,omitempty"`
+	Types     []*TypeUpdate     `protobuf:"bytes,6,rep,name=types" json:"types,omitempty"`
+	Analyzers []*AnalyzerUpdate `protobuf:"bytes,7,rep,name=analyzers" json:"analyzers,omitempty"`
 }
 
 func (m *Mutations) Reset()                    { *m = Mutations{} }
@@ -1088,6 +1185,20 @@ func (m *Mutations) GetDropAll() bool {
 	return false
 }
 
+func (m *Mutations) GetTypes() []*TypeUpdate {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *Mutations) GetAnalyzers() []*AnalyzerUpdate {
+	if m != nil {
+		return m.Analyzers
+	}
+	return nil
+}
+
 type KeyValues struct {
 	Kv []*KV `protobuf:"bytes,1,rep,name=kv" json:"kv,omitempty"`
 }
@@ -1556,6 +1667,7 @@ type SchemaRequest struct {
 	Predicates []string `protobuf:"bytes,2,rep,name=predicates" json:"predicates,omitempty"`
 	// fields can be on of type, index, reverse or tokenizer
 	Fields []string `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
+	Types  []string `protobuf:"bytes,4,rep,name=types" json:"types,omitempty"`
 }
 
 func (m *SchemaRequest) Reset()                    { *m = SchemaRequest{} }
@@ -1584,6 +1696,13 @@ func (m *SchemaRequest) GetFields() []string {
 	return nil
 }
 
+func (m *SchemaRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
 type SchemaResult struct {
 	Schema []*SchemaNode `protobuf:"bytes,1,rep,name=schema" json:"schema,omitempty"`
 }
@@ -1959,9 +2078,10 @@ func (m *TxnTimestamps) GetTs() []uint64 {
 }
 
 type Assigned struct {
-	Uids    map[string]string `protobuf:"bytes,1,rep,name=uids" json:"uids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Context *TxnContext       `protobuf:"bytes,2,opt,name=context" json:"context,omitempty"`
-	Error   string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Uids       map[string]string `protobuf:"bytes,1,rep,name=uids" json:"uids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Context    *TxnContext       `protobuf:"bytes,2,opt,name=context" json:"context,omitempty"`
+	Error      string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	CondPassed bool              `protobuf:"varint,4,opt,name=cond_passed,json=condPassed,proto3" json:"cond_passed,omitempty"`
 }
 
 func (m *Assigned) Reset()                    { *m = Assigned{} }
@@ -1990,6 +2110,13 @@ func (m *Assigned) GetError() string {
 	return ""
 }
 
+func (m *Assigned) GetCondPassed() bool {
+	if m != nil {
+		return m.CondPassed
+	}
+	return false
+}
+
 type Num struct {
 	Val uint64 `protobuf:"varint,1,opt,name=val,proto3" json:"val,omitempty"`
 }
@@ -2040,6 +2167,7 @@ type NQuad struct {
 	Facets      []*Facet `protobuf:"bytes,7,rep,name=facets" json:"facets,omitempty"`
 	SubjectVar  string   `protobuf:"bytes,8,opt,name=subject_var,json=subjectVar,proto3" json:"subject_var,omitempty"`
 	ObjectVar   string   `protobuf:"bytes,9,opt,name=object_var,json=objectVar,proto3" json:"object_var,omitempty"`
+	ValueVar    string   `protobuf:"bytes,10,opt,name=value_var,json=valueVar,proto3" json:"value_var,omitempty"`
 }
 
 func (m *NQuad) Reset()                    { *m = NQuad{} }
@@ -2110,6 +2238,13 @@ func (m *NQuad) GetObjectVar() string {
 	return ""
 }
 
+func (m *NQuad) GetValueVar() string {
+	if m != nil {
+		return m.ValueVar
+	}
+	return ""
+}
+
 type Value struct {
 	// Types that are valid to be assigned to Val:
 	//	*Value_DefaultVal
@@ -2477,6 +2612,8 @@ type Mutation struct {
 	Del        []*NQuad `protobuf:"bytes,11,rep,name=del" json:"del,omitempty"`
 	StartTs    uint64   `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
 	CommitNow  bool     `protobuf:"varint,14,opt,name=commit_now,json=commitNow,proto3" json:"commit_now,omitempty"`
+	Query      string   `protobuf:"bytes,15,opt,name=query,proto3" json:"query,omitempty"`
+	Cond       string   `protobuf:"bytes,16,opt,name=cond,proto3" json:"cond,omitempty"`
 }
 
 func (m *Mutation) Reset()                    { *m = Mutation{} }
@@ -2540,6 +2677,20 @@ func (m *Mutation) GetCommitNow() bool {
 	return false
 }
 
+func (m *Mutation) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *Mutation) GetCond() string {
+	if m != nil {
+		return m.Cond
+	}
+	return ""
+}
+
 type Operation struct {
 	Schema   string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
 	DropAttr string `protobuf:"bytes,2,opt,name=drop_attr,json=dropAttr,proto3" json:"drop_attr,omitempty"`
@@ -2581,10 +2732,14 @@ func (m *Operation) GetStartTs() uint64 {
 }
 
 type Request struct {
-	Query   string            `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Vars    map[string]string `protobuf:"bytes,2,rep,name=vars" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	StartTs uint64            `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	LinRead *LinRead          `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	Query        string            `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Vars         map[string]string `protobuf:"bytes,2,rep,name=vars" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StartTs      uint64            `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	LinRead      *LinRead          `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	Explain      bool              `protobuf:"varint,15,opt,name=explain,proto3" json:"explain,omitempty"`
+	TimeoutMs    uint64            `protobuf:"varint,16,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	ResultBudget uint64            `protobuf:"varint,17,opt,name=result_budget,json=resultBudget,proto3" json:"result_budget,omitempty"`
+	Output       string            `protobuf:"bytes,18,opt,name=output,proto3" json:"output,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -2620,6 +2775,34 @@ func (m *Request) GetLinRead() *LinRead {
 	return nil
 }
 
+func (m *Request) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *Request) GetTimeoutMs() uint64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *Request) GetResultBudget() uint64 {
+	if m != nil {
+		return m.ResultBudget
+	}
+	return 0
+}
+
+func (m *Request) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
 type Latency struct {
 	ParsingNs    uint64 `protobuf:"varint,1,opt,name=parsing_ns,json=parsingNs,proto3" json:"parsing_ns,omitempty"`
 	ProcessingNs uint64 `protobuf:"varint,2,opt,name=processing_ns,json=processingNs,proto3" json:"processing_ns,omitempty"`
@@ -2653,10 +2836,16 @@ func (m *Latency) GetEncodingNs() uint64 {
 }
 
 type Response struct {
-	Json    []byte        `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
-	Schema  []*SchemaNode `protobuf:"bytes,2,rep,name=schema" json:"schema,omitempty"`
-	Txn     *TxnContext   `protobuf:"bytes,3,opt,name=txn" json:"txn,omitempty"`
-	Latency *Latency      `protobuf:"bytes,12,opt,name=latency" json:"latency,omitempty"`
+	Json    []byte         `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	Schema  []*SchemaNode  `protobuf:"bytes,2,rep,name=schema" json:"schema,omitempty"`
+	Txn     *TxnContext    `protobuf:"bytes,3,opt,name=txn" json:"txn,omitempty"`
+	Latency *Latency       `protobuf:"bytes,12,opt,name=latency" json:"latency,omitempty"`
+	Types   []*TypeUpdate  `protobuf:"bytes,13,rep,name=types" json:"types,omitempty"`
+	Plan    []byte         `protobuf:"bytes,14,opt,name=plan,proto3" json:"plan,omitempty"`
+	Nodes   []*Node        `protobuf:"bytes,15,rep,name=nodes" json:"nodes,omitempty"`
+	Rdf     []byte         `protobuf:"bytes,16,opt,name=rdf,proto3" json:"rdf,omitempty"`
+	Csv     []byte         `protobuf:"bytes,17,opt,name=csv,proto3" json:"csv,omitempty"`
+	Cursors []*BlockCursor `protobuf:"bytes,18,rep,name=cursors" json:"cursors,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
@@ -2692,6 +2881,48 @@ func (m *Response) GetLatency() *Latency {
 	return nil
 }
 
+func (m *Response) GetTypes() []*TypeUpdate {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *Response) GetPlan() []byte {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *Response) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *Response) GetRdf() []byte {
+	if m != nil {
+		return m.Rdf
+	}
+	return nil
+}
+
+func (m *Response) GetCsv() []byte {
+	if m != nil {
+		return m.Csv
+	}
+	return nil
+}
+
+func (m *Response) GetCursors() []*BlockCursor {
+	if m != nil {
+		return m.Cursors
+	}
+	return nil
+}
+
 type Check struct {
 }
 
@@ -2716,122 +2947,320 @@ func (m *Version) GetTag() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*List)(nil), "protos.List")
-	proto.RegisterType((*TaskValue)(nil), "protos.TaskValue")
-	proto.RegisterType((*SrcFunction)(nil), "protos.SrcFunction")
-	proto.RegisterType((*LinRead)(nil), "protos.LinRead")
-	proto.RegisterType((*Query)(nil), "protos.Query")
-	proto.RegisterType((*ValueList)(nil), "protos.ValueList")
-	proto.RegisterType((*Result)(nil), "protos.Result")
-	proto.RegisterType((*Order)(nil), "protos.Order")
-	proto.RegisterType((*SortMessage)(nil), "protos.SortMessage")
-	proto.RegisterType((*SortResult)(nil), "protos.SortResult")
-	proto.RegisterType((*RaftContext)(nil), "protos.RaftContext")
-	proto.RegisterType((*Member)(nil), "protos.Member")
-	proto.RegisterType((*Group)(nil), "protos.Group")
-	proto.RegisterType((*ZeroProposal)(nil), "protos.ZeroProposal")
-	proto.RegisterType((*MembershipState)(nil), "protos.MembershipState")
-	proto.RegisterType((*ConnectionState)(nil), "protos.ConnectionState")
-	proto.RegisterType((*Tablet)(nil), "protos.Tablet")
-	proto.RegisterType((*DirectedEdge)(nil), "protos.DirectedEdge")
-	proto.RegisterType((*Mutations)(nil), "protos.Mutations")
-	proto.RegisterType((*KeyValues)(nil), "protos.KeyValues")
-	proto.RegisterType((*Proposal)(nil), "protos.Proposal")
-	proto.RegisterType((*KV)(nil), "protos.KV")
-	proto.RegisterType((*KC)(nil), "protos.KC")
-	proto.RegisterType((*GroupKeys)(nil), "protos.GroupKeys")
-	proto.RegisterType((*Posting)(nil), "protos.Posting")
-	proto.RegisterType((*PostingList)(nil), "protos.PostingList")
-	proto.RegisterType((*Facet)(nil), "protos.Facet")
-	proto.RegisterType((*Param)(nil), "protos.Param")
-	proto.RegisterType((*Facets)(nil), "protos.Facets")
-	proto.RegisterType((*FacetsList)(nil), "protos.FacetsList")
-	proto.RegisterType((*Function)(nil), "protos.Function")
-	proto.RegisterType((*FilterTree)(nil), "protos.FilterTree")
-	proto.RegisterType((*SchemaRequest)(nil), "protos.SchemaRequest")
-	proto.RegisterType((*SchemaResult)(nil), "protos.SchemaResult")
-	proto.RegisterType((*SchemaNode)(nil), "protos.SchemaNode")
-	proto.RegisterType((*SchemaUpdate)(nil), "protos.SchemaUpdate")
-	proto.RegisterType((*MapEntry)(nil), "protos.MapEntry")
-	proto.RegisterType((*Payload)(nil), "protos.Payload")
-	proto.RegisterType((*MovePredicatePayload)(nil), "protos.MovePredicatePayload")
-	proto.RegisterType((*ExportPayload)(nil), "protos.ExportPayload")
-	proto.RegisterType((*TxnContext)(nil), "protos.TxnContext")
-	proto.RegisterType((*OracleDelta)(nil), "protos.OracleDelta")
-	proto.RegisterType((*TxnTimestamps)(nil), "protos.TxnTimestamps")
-	proto.RegisterType((*Assigned)(nil), "protos.Assigned")
-	proto.RegisterType((*Num)(nil), "protos.Num")
-	proto.RegisterType((*AssignedIds)(nil), "protos.AssignedIds")
-	proto.RegisterType((*NQuad)(nil), "protos.NQuad")
-	proto.RegisterType((*Value)(nil), "protos.Value")
-	proto.RegisterType((*Mutation)(nil), "protos.Mutation")
-	proto.RegisterType((*Operation)(nil), "protos.Operation")
-	proto.RegisterType((*Request)(nil), "protos.Request")
-	proto.RegisterType((*Latency)(nil), "protos.Latency")
-	proto.RegisterType((*Response)(nil), "protos.Response")
-	proto.RegisterType((*Check)(nil), "protos.Check")
-	proto.RegisterType((*Version)(nil), "protos.Version")
-	proto.RegisterEnum("protos.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
-	proto.RegisterEnum("protos.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
-	proto.RegisterEnum("protos.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
-	proto.RegisterEnum("protos.Facet_ValType", Facet_ValType_name, Facet_ValType_value)
-	proto.RegisterEnum("protos.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
-	proto.RegisterEnum("protos.ExportPayload_Status", ExportPayload_Status_name, ExportPayload_Status_value)
+type TypeUpdate struct {
+	TypeName string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Fields   []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for Raft service
+func (m *TypeUpdate) Reset()                    { *m = TypeUpdate{} }
+func (m *TypeUpdate) String() string            { return proto.CompactTextString(m) }
+func (*TypeUpdate) ProtoMessage()               {}
+func (*TypeUpdate) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{55} }
 
-type RaftClient interface {
-	Echo(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error)
-	RaftMessage(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error)
-	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*Payload, error)
+func (m *TypeUpdate) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
 }
 
-type raftClient struct {
-	cc *grpc.ClientConn
+func (m *TypeUpdate) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
 }
 
-func NewRaftClient(cc *grpc.ClientConn) RaftClient {
-	return &raftClient{cc}
+type Property struct {
+	Prop  string `protobuf:"bytes,1,opt,name=prop,proto3" json:"prop,omitempty"`
+	Value *Value `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
 }
 
-func (c *raftClient) Echo(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error) {
-	out := new(Payload)
-	err := grpc.Invoke(ctx, "/protos.Raft/Echo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Property) Reset()                    { *m = Property{} }
+func (m *Property) String() string            { return proto.CompactTextString(m) }
+func (*Property) ProtoMessage()               {}
+func (*Property) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{56} }
+
+func (m *Property) GetProp() string {
+	if m != nil {
+		return m.Prop
 	}
-	return out, nil
+	return ""
 }
 
-func (c *raftClient) RaftMessage(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error) {
-	out := new(Payload)
-	err := grpc.Invoke(ctx, "/protos.Raft/RaftMessage", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *Property) GetValue() *Value {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return nil
 }
 
-func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*Payload, error) {
-	out := new(Payload)
-	err := grpc.Invoke(ctx, "/protos.Raft/JoinCluster", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Node struct {
+	Attribute  string      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Properties []*Property `protobuf:"bytes,2,rep,name=properties" json:"properties,omitempty"`
+	Children   []*Node     `protobuf:"bytes,3,rep,name=children" json:"children,omitempty"`
 }
 
-// Server API for Raft service
+func (m *Node) Reset()                    { *m = Node{} }
+func (m *Node) String() string            { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()               {}
+func (*Node) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{57} }
+
+func (m *Node) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *Node) GetProperties() []*Property {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *Node) GetChildren() []*Node {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type Cursor struct {
+	Values []*TaskValue `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+	Uid    uint64       `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (m *Cursor) Reset()                    { *m = Cursor{} }
+func (m *Cursor) String() string            { return proto.CompactTextString(m) }
+func (*Cursor) ProtoMessage()               {}
+func (*Cursor) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{58} }
+
+func (m *Cursor) GetValues() []*TaskValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *Cursor) GetUid() uint64 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+type BlockCursor struct {
+	Alias  string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *BlockCursor) Reset()                    { *m = BlockCursor{} }
+func (m *BlockCursor) String() string            { return proto.CompactTextString(m) }
+func (*BlockCursor) ProtoMessage()               {}
+func (*BlockCursor) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{59} }
+
+func (m *BlockCursor) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *BlockCursor) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type AnalyzerUpdate struct {
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tokenizer    string   `protobuf:"bytes,2,opt,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	CharFilters  []string `protobuf:"bytes,3,rep,name=char_filters,json=charFilters" json:"char_filters,omitempty"`
+	TokenFilters []string `protobuf:"bytes,4,rep,name=token_filters,json=tokenFilters" json:"token_filters,omitempty"`
+	StopWords    []string `protobuf:"bytes,5,rep,name=stop_words,json=stopWords" json:"stop_words,omitempty"`
+	Synonyms     []string `protobuf:"bytes,6,rep,name=synonyms" json:"synonyms,omitempty"`
+	Mappings     []string `protobuf:"bytes,7,rep,name=mappings" json:"mappings,omitempty"`
+}
+
+func (m *AnalyzerUpdate) Reset()                    { *m = AnalyzerUpdate{} }
+func (m *AnalyzerUpdate) String() string            { return proto.CompactTextString(m) }
+func (*AnalyzerUpdate) ProtoMessage()               {}
+func (*AnalyzerUpdate) Descriptor() ([]byte, []int) { return fileDescriptorTask, []int{60} }
+
+func (m *AnalyzerUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AnalyzerUpdate) GetTokenizer() string {
+	if m != nil {
+		return m.Tokenizer
+	}
+	return ""
+}
+
+func (m *AnalyzerUpdate) GetCharFilters() []string {
+	if m != nil {
+		return m.CharFilters
+	}
+	return nil
+}
+
+func (m *AnalyzerUpdate) GetTokenFilters() []string {
+	if m != nil {
+		return m.TokenFilters
+	}
+	return nil
+}
+
+func (m *AnalyzerUpdate) GetStopWords() []string {
+	if m != nil {
+		return m.StopWords
+	}
+	return nil
+}
+
+func (m *AnalyzerUpdate) GetSynonyms() []string {
+	if m != nil {
+		return m.Synonyms
+	}
+	return nil
+}
+
+func (m *AnalyzerUpdate) GetMappings() []string {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*List)(nil), "protos.List")
+	proto.RegisterType((*TaskValue)(nil), "protos.TaskValue")
+	proto.RegisterType((*SrcFunction)(nil), "protos.SrcFunction")
+	proto.RegisterType((*LinRead)(nil), "protos.LinRead")
+	proto.RegisterType((*Query)(nil), "protos.Query")
+	proto.RegisterType((*ValueList)(nil), "protos.ValueList")
+	proto.RegisterType((*Result)(nil), "protos.Result")
+	proto.RegisterType((*Order)(nil), "protos.Order")
+	proto.RegisterType((*SortMessage)(nil), "protos.SortMessage")
+	proto.RegisterType((*SortResult)(nil), "protos.SortResult")
+	proto.RegisterType((*RaftContext)(nil), "protos.RaftContext")
+	proto.RegisterType((*Member)(nil), "protos.Member")
+	proto.RegisterType((*Group)(nil), "protos.Group")
+	proto.RegisterType((*ZeroProposal)(nil), "protos.ZeroProposal")
+	proto.RegisterType((*MembershipState)(nil), "protos.MembershipState")
+	proto.RegisterType((*ConnectionState)(nil), "protos.ConnectionState")
+	proto.RegisterType((*Tablet)(nil), "protos.Tablet")
+	proto.RegisterType((*DirectedEdge)(nil), "protos.DirectedEdge")
+	proto.RegisterType((*Mutations)(nil), "protos.Mutations")
+	proto.RegisterType((*KeyValues)(nil), "protos.KeyValues")
+	proto.RegisterType((*Proposal)(nil), "protos.Proposal")
+	proto.RegisterType((*KV)(nil), "protos.KV")
+	proto.RegisterType((*KC)(nil), "protos.KC")
+	proto.RegisterType((*GroupKeys)(nil), "protos.GroupKeys")
+	proto.RegisterType((*Posting)(nil), "protos.Posting")
+	proto.RegisterType((*PostingList)(nil), "protos.PostingList")
+	proto.RegisterType((*Facet)(nil), "protos.Facet")
+	proto.RegisterType((*Param)(nil), "protos.Param")
+	proto.RegisterType((*Facets)(nil), "protos.Facets")
+	proto.RegisterType((*FacetsList)(nil), "protos.FacetsList")
+	proto.RegisterType((*Function)(nil), "protos.Function")
+	proto.RegisterType((*FilterTree)(nil), "protos.FilterTree")
+	proto.RegisterType((*SchemaRequest)(nil), "protos.SchemaRequest")
+	proto.RegisterType((*SchemaResult)(nil), "protos.SchemaResult")
+	proto.RegisterType((*SchemaNode)(nil), "protos.SchemaNode")
+	proto.RegisterType((*SchemaUpdate)(nil), "protos.SchemaUpdate")
+	proto.RegisterType((*MapEntry)(nil), "protos.MapEntry")
+	proto.RegisterType((*Payload)(nil), "protos.Payload")
+	proto.RegisterType((*MovePredicatePayload)(nil), "protos.MovePredicatePayload")
+	proto.RegisterType((*ExportPayload)(nil), "protos.ExportPayload")
+	proto.RegisterType((*TxnContext)(nil), "protos.TxnContext")
+	proto.RegisterType((*OracleDelta)(nil), "protos.OracleDelta")
+	proto.RegisterType((*TxnTimestamps)(nil), "protos.TxnTimestamps")
+	proto.RegisterType((*Assigned)(nil), "protos.Assigned")
+	proto.RegisterType((*Num)(nil), "protos.Num")
+	proto.RegisterType((*AssignedIds)(nil), "protos.AssignedIds")
+	proto.RegisterType((*NQuad)(nil), "protos.NQuad")
+	proto.RegisterType((*Value)(nil), "protos.Value")
+	proto.RegisterType((*Mutation)(nil), "protos.Mutation")
+	proto.RegisterType((*Operation)(nil), "protos.Operation")
+	proto.RegisterType((*Request)(nil), "protos.Request")
+	proto.RegisterType((*Latency)(nil), "protos.Latency")
+	proto.RegisterType((*Response)(nil), "protos.Response")
+	proto.RegisterType((*Check)(nil), "protos.Check")
+	proto.RegisterType((*Version)(nil), "protos.Version")
+	proto.RegisterType((*TypeUpdate)(nil), "protos.TypeUpdate")
+	proto.RegisterType((*Property)(nil), "protos.Property")
+	proto.RegisterType((*Node)(nil), "protos.Node")
+	proto.RegisterType((*Cursor)(nil), "protos.Cursor")
+	proto.RegisterType((*BlockCursor)(nil), "protos.BlockCursor")
+	proto.RegisterType((*AnalyzerUpdate)(nil), "protos.AnalyzerUpdate")
+	proto.RegisterEnum("protos.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
+	proto.RegisterEnum("protos.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
+	proto.RegisterEnum("protos.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
+	proto.RegisterEnum("protos.Facet_ValType", Facet_ValType_name, Facet_ValType_value)
+	proto.RegisterEnum("protos.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
+	proto.RegisterEnum("protos.ExportPayload_Status", ExportPayload_Status_name, ExportPayload_Status_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Raft service
+
+type RaftClient interface {
+	Echo(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error)
+	RaftMessage(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error)
+	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*Payload, error)
+}
+
+type raftClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRaftClient(cc *grpc.ClientConn) RaftClient {
+	return &raftClient{cc}
+}
+
+func (c *raftClient) Echo(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := grpc.Invoke(ctx, "/protos.Raft/Echo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftClient) RaftMessage(ctx context.Context, in *Payload, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := grpc.Invoke(ctx, "/protos.Raft/RaftMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := grpc.Invoke(ctx, "/protos.Raft/JoinCluster", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Raft service
 
 type RaftServer interface {
 	Echo(context.Context, *Payload) (*Payload, error)
@@ -3711,6 +4140,7 @@ type DgraphClient interface {
 	Alter(ctx context.Context, in *Operation, opts ...grpc.CallOption) (*Payload, error)
 	CommitOrAbort(ctx context.Context, in *TxnContext, opts ...grpc.CallOption) (*TxnContext, error)
 	CheckVersion(ctx context.Context, in *Check, opts ...grpc.CallOption) (*Version, error)
+	QueryStream(ctx context.Context, in *Request, opts ...grpc.CallOption) (Dgraph_QueryStreamClient, error)
 }
 
 type dgraphClient struct {
@@ -3766,6 +4196,38 @@ func (c *dgraphClient) CheckVersion(ctx context.Context, in *Check, opts ...grpc
 	return out, nil
 }
 
+func (c *dgraphClient) QueryStream(ctx context.Context, in *Request, opts ...grpc.CallOption) (Dgraph_QueryStreamClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Dgraph_serviceDesc.Streams[0], c.cc, "/protos.Dgraph/QueryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dgraphQueryStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dgraph_QueryStreamClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type dgraphQueryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *dgraphQueryStreamClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Dgraph service
 
 type DgraphServer interface {
@@ -3774,6 +4236,7 @@ type DgraphServer interface {
 	Alter(context.Context, *Operation) (*Payload, error)
 	CommitOrAbort(context.Context, *TxnContext) (*TxnContext, error)
 	CheckVersion(context.Context, *Check) (*Version, error)
+	QueryStream(*Request, Dgraph_QueryStreamServer) error
 }
 
 func RegisterDgraphServer(s *grpc.Server, srv DgraphServer) {
@@ -3870,6 +4333,27 @@ func _Dgraph_CheckVersion_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dgraph_QueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DgraphServer).QueryStream(m, &dgraphQueryStreamServer{stream})
+}
+
+type Dgraph_QueryStreamServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type dgraphQueryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *dgraphQueryStreamServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Dgraph_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.Dgraph",
 	HandlerType: (*DgraphServer)(nil),
@@ -3895,7 +4379,13 @@ var _Dgraph_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Dgraph_CheckVersion_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryStream",
+			Handler:       _Dgraph_QueryStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "task.proto",
 }
 
@@ -4167,6 +4657,23 @@ func (m *Query) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n5
 	}
+	if m.Budget != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.Budget))
+	}
+	if m.ReadKeys {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.ReadKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -4288,6 +4795,43 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n8
 	}
+	if m.GroupId != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.GroupId))
+	}
+	if m.NodeId != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.NodeId))
+	}
+	if len(m.Access) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Access)))
+		i += copy(dAtA[i:], m.Access)
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -4337,6 +4881,16 @@ func (m *Order) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.Count {
+		dAtA[i] = 0x20
+		i++
+		if m.Count {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -4404,6 +4958,34 @@ func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n9
 	}
+	if m.Cursor != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.Cursor.Size()))
+		n38, err := m.Cursor.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if m.ReturnCursors {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.ReturnCursors {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Nulls) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Nulls)))
+		i += copy(dAtA[i:], m.Nulls)
+	}
 	return i, nil
 }
 
@@ -4444,6 +5026,18 @@ func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n10
 	}
+	if len(m.Cursors) > 0 {
+		for _, msg := range m.Cursors {
+			dAtA[i] = 0x7a
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -5020,6 +5614,30 @@ func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Types) > 0 {
+		for _, msg := range m.Types {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Analyzers) > 0 {
+		for _, msg := range m.Analyzers {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -5654,6 +6272,21 @@ func (m *SchemaRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -6197,6 +6830,16 @@ func (m *Assigned) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTask(dAtA, i, uint64(len(m.Error)))
 		i += copy(dAtA[i:], m.Error)
 	}
+	if m.CondPassed {
+		dAtA[i] = 0x20
+		i++
+		if m.CondPassed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -6330,6 +6973,12 @@ func (m *NQuad) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTask(dAtA, i, uint64(len(m.ObjectVar)))
 		i += copy(dAtA[i:], m.ObjectVar)
 	}
+	if len(m.ValueVar) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.ValueVar)))
+		i += copy(dAtA[i:], m.ValueVar)
+	}
 	return i, nil
 }
 
@@ -6533,6 +7182,20 @@ func (m *Mutation) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Query) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	if len(m.Cond) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Cond)))
+		i += copy(dAtA[i:], m.Cond)
+	}
 	return i, nil
 }
 
@@ -6634,6 +7297,38 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n34
 	}
+	if m.Explain {
+		dAtA[i] = 0x78
+		i++
+		if m.Explain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TimeoutMs != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.TimeoutMs))
+	}
+	if m.ResultBudget != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.ResultBudget))
+	}
+	if len(m.Output) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Output)))
+		i += copy(dAtA[i:], m.Output)
+	}
 	return i, nil
 }
 
@@ -6723,6 +7418,66 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n36
 	}
+	if len(m.Types) > 0 {
+		for _, msg := range m.Types {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Plan) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Plan)))
+		i += copy(dAtA[i:], m.Plan)
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x7a
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Rdf) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Rdf)))
+		i += copy(dAtA[i:], m.Rdf)
+	}
+	if len(m.Csv) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Csv)))
+		i += copy(dAtA[i:], m.Csv)
+	}
+	if len(m.Cursors) > 0 {
+		for _, msg := range m.Cursors {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -6768,72 +7523,363 @@ func (m *Version) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Task(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Task(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *List) Size() (n int) {
+
+func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		n += 1 + sovTask(uint64(len(m.Uids)*8)) + len(m.Uids)*8
+	if len(m.TypeName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TypeName)))
+		i += copy(dAtA[i:], m.TypeName)
 	}
-	return n
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
 }
 
-func (m *TaskValue) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovTask(uint64(m.ValType))
+func (m *Property) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SrcFunction) Size() (n int) {
+func (m *Property) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTask(uint64(l))
+	if len(m.Prop) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Prop)))
+		i += copy(dAtA[i:], m.Prop)
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovTask(uint64(l))
+	if m.Value != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.Value.Size()))
+		n37, err := m.Value.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n37
 	}
-	if m.IsCount {
-		n += 2
-	}
-	return n
+	return i, nil
+}
+
+func (m *Node) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Node) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attribute) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Attribute)))
+		i += copy(dAtA[i:], m.Attribute)
+	}
+	if len(m.Properties) > 0 {
+		for _, msg := range m.Properties {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Children) > 0 {
+		for _, msg := range m.Children {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Cursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cursor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTask(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Uid != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(m.Uid))
+	}
+	return i, nil
+}
+
+func (m *BlockCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockCursor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Alias) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Alias)))
+		i += copy(dAtA[i:], m.Alias)
+	}
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
+	return i, nil
+}
+
+func (m *AnalyzerUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzerUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Tokenizer) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Tokenizer)))
+		i += copy(dAtA[i:], m.Tokenizer)
+	}
+	if len(m.CharFilters) > 0 {
+		for _, s := range m.CharFilters {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.TokenFilters) > 0 {
+		for _, s := range m.TokenFilters {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.StopWords) > 0 {
+		for _, s := range m.StopWords {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Synonyms) > 0 {
+		for _, s := range m.Synonyms {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Mappings) > 0 {
+		for _, s := range m.Mappings {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func encodeFixed64Task(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Task(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *List) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		n += 1 + sovTask(uint64(len(m.Uids)*8)) + len(m.Uids)*8
+	}
+	return n
+}
+
+func (m *TaskValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Val)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.ValType != 0 {
+		n += 1 + sovTask(uint64(m.ValType))
+	}
+	return n
+}
+
+func (m *SrcFunction) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.IsCount {
+		n += 2
+	}
+	return n
 }
 
 func (m *LinRead) Size() (n int) {
@@ -6895,6 +7941,12 @@ func (m *Query) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if m.Budget != 0 {
+		n += 1 + sovTask(uint64(m.Budget))
+	}
+	if m.ReadKeys {
+		n += 3
+	}
 	return n
 }
 
@@ -6945,7 +7997,23 @@ func (m *Result) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovTask(uint64(l))
 	}
-	return n
+	if m.GroupId != 0 {
+		n += 1 + sovTask(uint64(m.GroupId))
+	}
+	if m.NodeId != 0 {
+		n += 2 + sovTask(uint64(m.NodeId))
+	}
+	l = len(m.Access)
+	if l > 0 {
+		n += 2 + l + sovTask(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 2 + l + sovTask(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *Order) Size() (n int) {
@@ -6964,6 +8032,9 @@ func (m *Order) Size() (n int) {
 			n += 1 + l + sovTask(uint64(l))
 		}
 	}
+	if m.Count {
+		n += 2
+	}
 	return n
 }
 
@@ -6995,6 +8066,17 @@ func (m *SortMessage) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.ReturnCursors {
+		n += 3
+	}
+	l = len(m.Nulls)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -7011,6 +8093,12 @@ func (m *SortResult) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if len(m.Cursors) > 0 {
+		for _, e := range m.Cursors {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7270,6 +8358,18 @@ func (m *Mutations) Size() (n int) {
 	if m.DropAll {
 		n += 2
 	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.Analyzers) > 0 {
+		for _, e := range m.Analyzers {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7554,6 +8654,12 @@ func (m *SchemaRequest) Size() (n int) {
 			n += 1 + l + sovTask(uint64(l))
 		}
 	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7778,6 +8884,9 @@ func (m *Assigned) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if m.CondPassed {
+		n += 2
+	}
 	return n
 }
 
@@ -7843,6 +8952,10 @@ func (m *NQuad) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTask(uint64(l))
 	}
+	l = len(m.ValueVar)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -7973,6 +9086,14 @@ func (m *Mutation) Size() (n int) {
 	if m.CommitNow {
 		n += 2
 	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Cond)
+	if l > 0 {
+		n += 2 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -8018,6 +9139,19 @@ func (m *Request) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if m.Explain {
+		n += 2
+	}
+	if m.TimeoutMs != 0 {
+		n += 2 + sovTask(uint64(m.TimeoutMs))
+	}
+	if m.ResultBudget != 0 {
+		n += 2 + sovTask(uint64(m.ResultBudget))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 2 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -8057,6 +9191,36 @@ func (m *Response) Size() (n int) {
 		l = m.Latency.Size()
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	l = len(m.Rdf)
+	if l > 0 {
+		n += 2 + l + sovTask(uint64(l))
+	}
+	l = len(m.Csv)
+	if l > 0 {
+		n += 2 + l + sovTask(uint64(l))
+	}
+	if len(m.Cursors) > 0 {
+		for _, e := range m.Cursors {
+			l = e.Size()
+			n += 2 + l + sovTask(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8076,6 +9240,131 @@ func (m *Version) Size() (n int) {
 	return n
 }
 
+func (m *TypeUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Property) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prop)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *Node) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Cursor) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.Uid != 0 {
+		n += 1 + sovTask(uint64(m.Uid))
+	}
+	return n
+}
+
+func (m *BlockCursor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *AnalyzerUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Tokenizer)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.CharFilters) > 0 {
+		for _, s := range m.CharFilters {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.TokenFilters) > 0 {
+		for _, s := range m.TokenFilters {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.StopWords) > 0 {
+		for _, s := range m.StopWords {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.Synonyms) > 0 {
+		for _, s := range m.Synonyms {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if len(m.Mappings) > 0 {
+		for _, s := range m.Mappings {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTask(x uint64) (n int) {
 	for {
 		n++
@@ -8124,113 +9413,1610 @@ func (m *List) Unmarshal(dAtA []byte) error {
 				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				iNdEx += 8
-				v = uint64(dAtA[iNdEx-8])
-				v |= uint64(dAtA[iNdEx-7]) << 8
-				v |= uint64(dAtA[iNdEx-6]) << 16
-				v |= uint64(dAtA[iNdEx-5]) << 24
-				v |= uint64(dAtA[iNdEx-4]) << 32
-				v |= uint64(dAtA[iNdEx-3]) << 40
-				v |= uint64(dAtA[iNdEx-2]) << 48
-				v |= uint64(dAtA[iNdEx-1]) << 56
-				m.Uids = append(m.Uids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				iNdEx += 8
+				v = uint64(dAtA[iNdEx-8])
+				v |= uint64(dAtA[iNdEx-7]) << 8
+				v |= uint64(dAtA[iNdEx-6]) << 16
+				v |= uint64(dAtA[iNdEx-5]) << 24
+				v |= uint64(dAtA[iNdEx-4]) << 32
+				v |= uint64(dAtA[iNdEx-3]) << 40
+				v |= uint64(dAtA[iNdEx-2]) << 48
+				v |= uint64(dAtA[iNdEx-1]) << 56
+				m.Uids = append(m.Uids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(dAtA[iNdEx-8])
+					v |= uint64(dAtA[iNdEx-7]) << 8
+					v |= uint64(dAtA[iNdEx-6]) << 16
+					v |= uint64(dAtA[iNdEx-5]) << 24
+					v |= uint64(dAtA[iNdEx-4]) << 32
+					v |= uint64(dAtA[iNdEx-3]) << 40
+					v |= uint64(dAtA[iNdEx-2]) << 48
+					v |= uint64(dAtA[iNdEx-1]) << 56
+					m.Uids = append(m.Uids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCount = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinRead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinRead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinRead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Ids == nil {
+				m.Ids = make(map[uint32]uint64)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids[mapkey] = mapvalue
+			} else {
+				var mapvalue uint64
+				m.Ids[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			}
+			m.AfterUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.AfterUid = uint64(dAtA[iNdEx-8])
+			m.AfterUid |= uint64(dAtA[iNdEx-7]) << 8
+			m.AfterUid |= uint64(dAtA[iNdEx-6]) << 16
+			m.AfterUid |= uint64(dAtA[iNdEx-5]) << 24
+			m.AfterUid |= uint64(dAtA[iNdEx-4]) << 32
+			m.AfterUid |= uint64(dAtA[iNdEx-3]) << 40
+			m.AfterUid |= uint64(dAtA[iNdEx-2]) << 48
+			m.AfterUid |= uint64(dAtA[iNdEx-1]) << 56
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoCount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UidList == nil {
+				m.UidList = &List{}
+			}
+			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SrcFunc == nil {
+				m.SrcFunc = &SrcFunction{}
+			}
+			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetParam == nil {
+				m.FacetParam = &Param{}
+			}
+			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetsFilter == nil {
+				m.FacetsFilter = &FilterTree{}
+			}
+			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			m.Budget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Budget |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadKeys", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadKeys = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
+			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntersectDest = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
+			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Access = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Count = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = append(m.Order, &Order{})
+			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTask
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = uint64(dAtA[iNdEx-8])
-					v |= uint64(dAtA[iNdEx-7]) << 8
-					v |= uint64(dAtA[iNdEx-6]) << 16
-					v |= uint64(dAtA[iNdEx-5]) << 24
-					v |= uint64(dAtA[iNdEx-4]) << 32
-					v |= uint64(dAtA[iNdEx-3]) << 40
-					v |= uint64(dAtA[iNdEx-2]) << 48
-					v |= uint64(dAtA[iNdEx-1]) << 56
-					m.Uids = append(m.Uids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.LinRead == nil {
+				m.LinRead = &LinRead{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8240,28 +11026,30 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
+			if m.Cursor == nil {
+				m.Cursor = &Cursor{}
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCursors", wireType)
 			}
-			m.ValType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8271,11 +11059,41 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReturnCursors = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nulls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nulls = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -8297,7 +11115,7 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+func (m *SortResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8320,17 +11138,17 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8340,26 +11158,28 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8369,26 +11189,30 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if m.LinRead == nil {
+				m.LinRead = &LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursors", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8398,12 +11222,23 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsCount = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursors = append(m.Cursors, &Cursor{})
+			if err := m.Cursors[len(m.Cursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -8425,7 +11260,7 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinRead) Unmarshal(dAtA []byte) error {
+func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8448,39 +11283,34 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinRead: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinRead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			m.Id = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			iNdEx += 8
+			m.Id = uint64(dAtA[iNdEx-8])
+			m.Id |= uint64(dAtA[iNdEx-7]) << 8
+			m.Id |= uint64(dAtA[iNdEx-6]) << 16
+			m.Id |= uint64(dAtA[iNdEx-5]) << 24
+			m.Id |= uint64(dAtA[iNdEx-4]) << 32
+			m.Id |= uint64(dAtA[iNdEx-3]) << 40
+			m.Id |= uint64(dAtA[iNdEx-2]) << 48
+			m.Id |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8490,12 +11320,16 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.Group |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint32
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8505,50 +11339,20 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Ids == nil {
-				m.Ids = make(map[uint32]uint64)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids[mapkey] = mapvalue
-			} else {
-				var mapvalue uint64
-				m.Ids[mapkey] = mapvalue
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8571,7 +11375,7 @@ func (m *LinRead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8594,17 +11398,34 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Id = uint64(dAtA[iNdEx-8])
+			m.Id |= uint64(dAtA[iNdEx-7]) << 8
+			m.Id |= uint64(dAtA[iNdEx-6]) << 16
+			m.Id |= uint64(dAtA[iNdEx-5]) << 24
+			m.Id |= uint64(dAtA[iNdEx-4]) << 32
+			m.Id |= uint64(dAtA[iNdEx-3]) << 40
+			m.Id |= uint64(dAtA[iNdEx-2]) << 48
+			m.Id |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8614,24 +11435,14 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8656,28 +11467,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
-			}
-			m.AfterUid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.AfterUid = uint64(dAtA[iNdEx-8])
-			m.AfterUid |= uint64(dAtA[iNdEx-7]) << 8
-			m.AfterUid |= uint64(dAtA[iNdEx-6]) << 16
-			m.AfterUid |= uint64(dAtA[iNdEx-5]) << 24
-			m.AfterUid |= uint64(dAtA[iNdEx-4]) << 32
-			m.AfterUid |= uint64(dAtA[iNdEx-3]) << 40
-			m.AfterUid |= uint64(dAtA[iNdEx-2]) << 48
-			m.AfterUid |= uint64(dAtA[iNdEx-1]) << 56
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -8694,12 +11488,12 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DoCount = bool(v != 0)
+			m.Leader = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8709,28 +11503,104 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.AmDead = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CluterInfoOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CluterInfoOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UidList == nil {
-				m.UidList = &List{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Group) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
 			}
-			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8754,18 +11624,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SrcFunc == nil {
-				m.SrcFunc = &SrcFunction{}
-			}
-			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8775,17 +11634,12 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
-			}
-			var msglen int
+			var mapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8795,28 +11649,69 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				mapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FacetParam == nil {
-				m.FacetParam = &Param{}
+			if m.Members == nil {
+				m.Members = make(map[uint64]*Member)
 			}
-			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Member{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Members[mapkey] = mapvalue
+			} else {
+				var mapvalue *Member
+				m.Members[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8840,18 +11735,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetsFilter == nil {
-				m.FacetsFilter = &FilterTree{}
-			}
-			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
-			}
-			m.ReadTs = 0
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8861,16 +11745,12 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
-			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8880,23 +11760,74 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &LinRead{}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Tablets == nil {
+				m.Tablets = make(map[string]*Tablet)
 			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Tablet{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Tablets[mapkey] = mapvalue
+			} else {
+				var mapvalue *Tablet
+				m.Tablets[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
 		default:
@@ -8920,7 +11851,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueList) Unmarshal(dAtA []byte) error {
+func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8943,17 +11874,17 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -8963,76 +11894,14 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Id |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = append(m.Values, &TaskValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Result) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9056,14 +11925,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9087,78 +11958,37 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
-			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tablet == nil {
+				m.Tablet = &Tablet{}
+			}
+			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Counts = append(m.Counts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTask
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			}
+			m.MaxLeaseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTask
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Counts = append(m.Counts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			var v int
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9168,17 +11998,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IntersectDest = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			var msglen int
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9188,26 +12017,14 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
-			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9231,10 +12048,10 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &LinRead{}
+			if m.Txn == nil {
+				m.Txn = &TxnContext{}
 			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9259,7 +12076,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9282,17 +12099,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var stringLen uint64
+			m.Counter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9302,26 +12119,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Counter |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9331,17 +12138,19 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Desc = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTask
 			}
-			var stringLen uint64
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9351,76 +12160,12 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			var msglen int
+			var mapkey uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9430,26 +12175,69 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				mapkey |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*Group)
 			}
-			m.Order = append(m.Order, &Order{})
-			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Group{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Groups[mapkey] = mapvalue
+			} else {
+				var mapvalue *Group
+				m.Groups[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9473,16 +12261,7 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9492,16 +12271,12 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (int32(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
+			var mapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9511,16 +12286,71 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (int32(b) & 0x7F) << shift
+				mapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if m.Zeros == nil {
+				m.Zeros = make(map[uint64]*Member)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTask
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Member{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Zeros[mapkey] = mapvalue
+			} else {
+				var mapvalue *Member
+				m.Zeros[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
 			}
-			m.ReadTs = 0
+			m.MaxLeaseId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9530,16 +12360,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			var msglen int
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9549,80 +12379,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LinRead == nil {
-				m.LinRead = &LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			var msglen int
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9632,26 +12398,14 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9675,10 +12429,8 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Removed = append(m.Removed, &Member{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9703,7 +12455,7 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftContext) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9726,34 +12478,17 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Id = uint64(dAtA[iNdEx-8])
-			m.Id |= uint64(dAtA[iNdEx-7]) << 8
-			m.Id |= uint64(dAtA[iNdEx-6]) << 16
-			m.Id |= uint64(dAtA[iNdEx-5]) << 24
-			m.Id |= uint64(dAtA[iNdEx-4]) << 32
-			m.Id |= uint64(dAtA[iNdEx-3]) << 40
-			m.Id |= uint64(dAtA[iNdEx-2]) << 48
-			m.Id |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			m.Group = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9763,16 +12498,30 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9782,20 +12531,24 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9818,7 +12571,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *Tablet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9841,30 +12594,13 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Id = uint64(dAtA[iNdEx-8])
-			m.Id |= uint64(dAtA[iNdEx-7]) << 8
-			m.Id |= uint64(dAtA[iNdEx-6]) << 16
-			m.Id |= uint64(dAtA[iNdEx-5]) << 24
-			m.Id |= uint64(dAtA[iNdEx-4]) << 32
-			m.Id |= uint64(dAtA[iNdEx-3]) << 40
-			m.Id |= uint64(dAtA[iNdEx-2]) << 48
-			m.Id |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
@@ -9883,9 +12619,9 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9910,11 +12646,11 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9931,10 +12667,10 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Leader = bool(v != 0)
-		case 5:
+			m.Force = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9951,31 +12687,12 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AmDead = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
-			}
-			m.LastUpdate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdate |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
+			m.ReadOnly = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CluterInfoOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
 			}
-			var v int
+			m.Space = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -9985,12 +12702,11 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Space |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CluterInfoOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -10012,7 +12728,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Group) Unmarshal(dAtA []byte) error {
+func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10035,17 +12751,34 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+			}
+			m.Entity = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Entity = uint64(dAtA[iNdEx-8])
+			m.Entity |= uint64(dAtA[iNdEx-7]) << 8
+			m.Entity |= uint64(dAtA[iNdEx-6]) << 16
+			m.Entity |= uint64(dAtA[iNdEx-5]) << 24
+			m.Entity |= uint64(dAtA[iNdEx-4]) << 32
+			m.Entity |= uint64(dAtA[iNdEx-3]) << 40
+			m.Entity |= uint64(dAtA[iNdEx-2]) << 48
+			m.Entity |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10055,19 +12788,26 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10077,12 +12817,28 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint64
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10092,71 +12848,62 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
+				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Members == nil {
-				m.Members = make(map[uint64]*Member)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
+			m.ValueId = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.ValueId = uint64(dAtA[iNdEx-8])
+			m.ValueId |= uint64(dAtA[iNdEx-7]) << 8
+			m.ValueId |= uint64(dAtA[iNdEx-6]) << 16
+			m.ValueId |= uint64(dAtA[iNdEx-5]) << 24
+			m.ValueId |= uint64(dAtA[iNdEx-4]) << 32
+			m.ValueId |= uint64(dAtA[iNdEx-3]) << 40
+			m.ValueId |= uint64(dAtA[iNdEx-2]) << 48
+			m.ValueId |= uint64(dAtA[iNdEx-1]) << 56
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
 				}
-				if postmsgIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := &Member{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postmsgIndex
-				m.Members[mapkey] = mapvalue
-			} else {
-				var mapvalue *Member
-				m.Members[mapkey] = mapvalue
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10166,19 +12913,26 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10188,12 +12942,16 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.Op |= (DirectedEdge_Op(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10203,74 +12961,21 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Tablets == nil {
-				m.Tablets = make(map[string]*Tablet)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Tablet{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Tablets[mapkey] = mapvalue
-			} else {
-				var mapvalue *Tablet
-				m.Tablets[mapkey] = mapvalue
+			m.Facets = append(m.Facets, &Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10294,7 +12999,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+func (m *Mutations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10317,17 +13022,17 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Id = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10337,14 +13042,33 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10368,16 +13092,14 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edges = append(m.Edges, &DirectedEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10401,18 +13123,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablet == nil {
-				m.Tablet = &Tablet{}
-			}
-			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schema = append(m.Schema, &SchemaUpdate{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
 			}
-			m.MaxLeaseId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10422,16 +13142,17 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			m.DropAll = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			m.MaxTxnTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10441,16 +13162,28 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.MaxRaftId = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10460,14 +13193,76 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyzers = append(m.Analyzers, &AnalyzerUpdate{})
+			if err := m.Analyzers[len(m.Analyzers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10491,10 +13286,8 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Txn == nil {
-				m.Txn = &TxnContext{}
-			}
-			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kv = append(m.Kv, &KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10519,7 +13312,7 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MembershipState) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10542,17 +13335,17 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Counter = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10562,14 +13355,14 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Counter |= (uint64(b) & 0x7F) << shift
+				m.Id |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10593,94 +13386,16 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var mapkey uint32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				mapkey |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if m.Groups == nil {
-				m.Groups = make(map[uint32]*Group)
+			if m.Mutations == nil {
+				m.Mutations = &Mutations{}
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Group{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Groups[mapkey] = mapvalue
-			} else {
-				var mapvalue *Group
-				m.Groups[mapkey] = mapvalue
+			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10704,134 +13419,18 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var mapkey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if m.Zeros == nil {
-				m.Zeros = make(map[uint64]*Member)
+			if m.TxnContext == nil {
+				m.TxnContext = &TxnContext{}
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthTask
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Member{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Zeros[mapkey] = mapvalue
-			} else {
-				var mapvalue *Member
-				m.Zeros[mapkey] = mapvalue
+			if err := m.TxnContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
-			}
-			m.MaxLeaseId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
-			}
-			m.MaxTxnTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
-			m.MaxRaftId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10841,14 +13440,26 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kv = append(m.Kv, &KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10872,11 +13483,42 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removed = append(m.Removed, &Member{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -10898,7 +13540,7 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *KV) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10921,17 +13563,17 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: KV: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KV: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10941,30 +13583,28 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -10974,25 +13614,73 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserMeta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserMeta = append(m.UserMeta[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserMeta == nil {
+				m.UserMeta = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -11014,7 +13702,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tablet) Unmarshal(dAtA []byte) error {
+func (m *KC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11037,36 +13725,17 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+			return fmt.Errorf("proto: KC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11076,26 +13745,28 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11105,17 +13776,66 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Timestamp |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var v int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11125,17 +13845,16 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
-			}
-			m.Space = 0
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11145,11 +13864,23 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Space |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &KC{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -11171,7 +13902,7 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
+func (m *Posting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11194,34 +13925,34 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
+			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			m.Entity = 0
+			m.Uid = 0
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += 8
-			m.Entity = uint64(dAtA[iNdEx-8])
-			m.Entity |= uint64(dAtA[iNdEx-7]) << 8
-			m.Entity |= uint64(dAtA[iNdEx-6]) << 16
-			m.Entity |= uint64(dAtA[iNdEx-5]) << 24
-			m.Entity |= uint64(dAtA[iNdEx-4]) << 32
-			m.Entity |= uint64(dAtA[iNdEx-3]) << 40
-			m.Entity |= uint64(dAtA[iNdEx-2]) << 48
-			m.Entity |= uint64(dAtA[iNdEx-1]) << 56
+			m.Uid = uint64(dAtA[iNdEx-8])
+			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
+			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
+			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
+			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
+			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
+			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
+			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11231,26 +13962,28 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
 			}
-			var byteLen int
+			m.ValType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11260,28 +13993,16 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
 			}
-			m.ValueType = 0
+			m.PostingType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11291,33 +14012,16 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
+				m.PostingType |= (Posting_PostingType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
-			}
-			m.ValueId = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.ValueId = uint64(dAtA[iNdEx-8])
-			m.ValueId |= uint64(dAtA[iNdEx-7]) << 8
-			m.ValueId |= uint64(dAtA[iNdEx-6]) << 16
-			m.ValueId |= uint64(dAtA[iNdEx-5]) << 24
-			m.ValueId |= uint64(dAtA[iNdEx-4]) << 32
-			m.ValueId |= uint64(dAtA[iNdEx-3]) << 40
-			m.ValueId |= uint64(dAtA[iNdEx-2]) << 48
-			m.ValueId |= uint64(dAtA[iNdEx-1]) << 56
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11327,24 +14031,26 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11369,27 +14075,8 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= (DirectedEdge_Op(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
@@ -11421,61 +14108,11 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Mutations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.GroupId = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11485,12 +14122,12 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				m.Op |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 13:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
@@ -11509,73 +14146,11 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Edges = append(m.Edges, &DirectedEdge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = append(m.Schema, &SchemaUpdate{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var v int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11585,12 +14160,11 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DropAll = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -11612,7 +14186,7 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyValues) Unmarshal(dAtA []byte) error {
+func (m *PostingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11635,15 +14209,15 @@ func (m *KeyValues) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValues: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValues: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11667,11 +14241,92 @@ func (m *KeyValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uids = append(m.Uids[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uids == nil {
+				m.Uids = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -11693,7 +14348,7 @@ func (m *KeyValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *Facet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11716,17 +14371,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Facet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Facet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11736,16 +14391,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11755,30 +14420,47 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutations == nil {
-				m.Mutations = &Mutations{}
-			}
-			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= (Facet_ValType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxnContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11788,30 +14470,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxnContext == nil {
-				m.TxnContext = &TxnContext{}
-			}
-			if err := m.TxnContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11821,28 +14499,76 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Val = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Param) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Param: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Param: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11852,28 +14578,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.AllKeys = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11898,7 +14611,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11921,7 +14634,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KV) Unmarshal(dAtA []byte) error {
+func (m *Facets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11944,17 +14657,17 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KV: wiretype end group for non-group")
+			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KV: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -11964,59 +14677,78 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.Facets = append(m.Facets, &Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12026,42 +14758,23 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserMeta = append(m.UserMeta[:0], dAtA[iNdEx:postIndex]...)
-			if m.UserMeta == nil {
-				m.UserMeta = []byte{}
+			m.FacetsList = append(m.FacetsList, &Facets{})
+			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -12083,7 +14796,7 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KC) Unmarshal(dAtA []byte) error {
+func (m *Function) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12106,17 +14819,17 @@ func (m *KC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KC: wiretype end group for non-group")
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12126,28 +14839,26 @@ func (m *KC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12157,11 +14868,50 @@ func (m *KC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -12183,7 +14933,7 @@ func (m *KC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupKeys) Unmarshal(dAtA []byte) error {
+func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12206,17 +14956,46 @@ func (m *GroupKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.GroupId = 0
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12226,14 +15005,26 @@ func (m *GroupKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &FilterTree{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12257,8 +15048,10 @@ func (m *GroupKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, &KC{})
-			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Func == nil {
+				m.Func = &Function{}
+			}
+			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12283,7 +15076,7 @@ func (m *GroupKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Posting) Unmarshal(dAtA []byte) error {
+func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12306,34 +15099,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Uid = uint64(dAtA[iNdEx-8])
-			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
-			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
-			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
-			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
-			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
-			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
-			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var byteLen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12343,28 +15119,16 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
-			m.ValType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12374,35 +15138,26 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.PostingType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PostingType |= (Posting_PostingType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12412,26 +15167,24 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12456,11 +15209,61 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12484,68 +15287,11 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schema = append(m.Schema, &SchemaNode{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
-			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
-			}
-			m.CommitTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommitTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -12567,7 +15313,7 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostingList) Unmarshal(dAtA []byte) error {
+func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12590,17 +15336,17 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12610,28 +15356,26 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12641,28 +15385,26 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
-			if m.Checksum == nil {
-				m.Checksum = []byte{}
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Commit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12672,16 +15414,17 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Commit |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Index = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12691,23 +15434,81 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uids = append(m.Uids[:0], dAtA[iNdEx:postIndex]...)
-			if m.Uids == nil {
-				m.Uids = []byte{}
-			}
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Count = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.List = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -12729,7 +15530,7 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Facet) Unmarshal(dAtA []byte) error {
+func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12752,15 +15553,15 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Facet: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12785,13 +15586,13 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
-			var byteLen int
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12801,28 +15602,16 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
 			}
-			m.ValType = 0
+			m.Directive = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12832,14 +15621,14 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= (Facet_ValType(b) & 0x7F) << shift
+				m.Directive |= (SchemaUpdate_Directive(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12864,13 +15653,13 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12880,74 +15669,15 @@ func (m *Facet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Val = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Param) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Param: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Param: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Count = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12964,12 +15694,12 @@ func (m *Param) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllKeys = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			m.List = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explicit", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -12979,21 +15709,12 @@ func (m *Param) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			}
+			m.Explicit = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -13015,7 +15736,7 @@ func (m *Param) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Facets) Unmarshal(dAtA []byte) error {
+func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13038,15 +15759,63 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Uid = uint64(dAtA[iNdEx-8])
+			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
+			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
+			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
+			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
+			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
+			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
+			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13070,8 +15839,10 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Posting == nil {
+				m.Posting = &Posting{}
+			}
+			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13096,7 +15867,7 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FacetsList) Unmarshal(dAtA []byte) error {
+func (m *Payload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13119,17 +15890,17 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13139,21 +15910,21 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetsList = append(m.FacetsList, &Facets{})
-			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13177,7 +15948,7 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Function) Unmarshal(dAtA []byte) error {
+func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13200,15 +15971,15 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13233,13 +16004,13 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGroupId", wireType)
 			}
-			var stringLen uint64
+			m.SourceGroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13249,26 +16020,35 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SourceGroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGroupId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DestGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestGroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13278,20 +16058,24 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13314,7 +16098,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilterTree) Unmarshal(dAtA []byte) error {
+func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13337,17 +16121,17 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
-			var stringLen uint64
+			m.ReqId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13357,26 +16141,16 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ReqId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Op = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13386,28 +16160,16 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Children = append(m.Children, &FilterTree{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13417,25 +16179,30 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Status |= (ExportPayload_Status(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Func == nil {
-				m.Func = &Function{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -13457,7 +16224,7 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *TxnContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13480,17 +16247,36 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			m.GroupId = 0
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13500,16 +16286,16 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13519,24 +16305,15 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			m.Aborted = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13561,61 +16338,11 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SchemaResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13639,8 +16366,10 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = append(m.Schema, &SchemaNode{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LinRead == nil {
+				m.LinRead = &LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13665,7 +16394,7 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaNode) Unmarshal(dAtA []byte) error {
+func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13688,17 +16417,17 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13708,26 +16437,19 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13737,26 +16459,12 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			var v int
+			var mapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13766,86 +16474,118 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				mapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Index = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
+			if m.Commits == nil {
+				m.Commits = make(map[uint64]uint64)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				var mapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
+				m.Commits[mapkey] = mapvalue
+			} else {
+				var mapvalue uint64
+				m.Commits[mapkey] = mapvalue
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				m.Aborts = append(m.Aborts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			m.Reverse = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
+				if packedLen < 0 {
+					return ErrInvalidLengthTask
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Aborts = append(m.Aborts, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborts", wireType)
 			}
-			m.Count = bool(v != 0)
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
 			}
-			var v int
+			m.MaxPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13855,12 +16595,11 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MaxPending |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -13882,7 +16621,7 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
+func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13905,46 +16644,129 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Ts = append(m.Ts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTask
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ts = append(m.Ts, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Assigned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
 			}
-			m.ValueType = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Assigned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Assigned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13954,16 +16776,19 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.Directive = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13973,16 +16798,12 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Directive |= (SchemaUpdate_Directive(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
-			}
-			var stringLen uint64
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -13992,26 +16813,76 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Uids == nil {
+				m.Uids = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthTask
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Uids[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Uids[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14021,17 +16892,30 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Count = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTask
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &TxnContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14041,15 +16925,24 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Explicit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CondPassed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14066,7 +16959,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Explicit = bool(v != 0)
+			m.CondPassed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -14088,7 +16981,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapEntry) Unmarshal(dAtA []byte) error {
+func (m *Num) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14111,65 +17004,17 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Num: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Uid = uint64(dAtA[iNdEx-8])
-			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
-			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
-			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
-			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
-			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
-			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
-			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var msglen int
+			m.Val = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14179,25 +17024,11 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Val |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Posting == nil {
-				m.Posting = &Posting{}
-			}
-			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -14219,7 +17050,7 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Payload) Unmarshal(dAtA []byte) error {
+func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14242,17 +17073,17 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
 			}
-			var byteLen int
+			m.StartId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14262,23 +17093,30 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.StartId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.EndId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -14300,7 +17138,7 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
+func (m *NQuad) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14323,17 +17161,104 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: NQuad: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NQuad: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectValue", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14343,26 +17268,30 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			if m.ObjectValue == nil {
+				m.ObjectValue = &Value{}
+			}
+			if err := m.ObjectValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceGroupId", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			m.SourceGroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14372,16 +17301,26 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceGroupId |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestGroupId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.DestGroupId = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14391,14 +17330,24 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestGroupId |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14422,68 +17371,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExportPayload) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExportPayload: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportPayload: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectVar", wireType)
 			}
-			m.ReqId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14493,35 +17390,26 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReqId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			m.SubjectVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectVar", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14531,16 +17419,26 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (ExportPayload_Status(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.ReadTs = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueVar", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14550,11 +17448,21 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -14576,7 +17484,7 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnContext) Unmarshal(dAtA []byte) error {
+func (m *Value) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14599,17 +17507,17 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: Value: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultVal", wireType)
 			}
-			m.StartTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14619,16 +17527,26 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = &Value_DefaultVal{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesVal", wireType)
 			}
-			m.CommitTs = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14638,14 +17556,45 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_BytesVal{v}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntVal", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Val = &Value_IntVal{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14662,10 +17611,11 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Aborted = bool(v != 0)
-		case 4:
+			b := bool(v != 0)
+			m.Val = &Value_BoolVal{b}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14690,13 +17640,31 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.Val = &Value_StrVal{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 13:
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleVal", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Val = &Value_DoubleVal{float64(math.Float64frombits(v))}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoVal", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14706,80 +17674,27 @@ func (m *TxnContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_GeoVal{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OracleDelta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DateVal", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14789,19 +17704,27 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_DateVal{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatetimeVal", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14811,12 +17734,27 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint64
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Val = &Value_DatetimeVal{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordVal", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14826,118 +17764,26 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Commits == nil {
-				m.Commits = make(map[uint64]uint64)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Commits[mapkey] = mapvalue
-			} else {
-				var mapvalue uint64
-				m.Commits[mapkey] = mapvalue
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Val = &Value_PasswordVal{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Aborts = append(m.Aborts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTask
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTask
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Aborts = append(m.Aborts, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aborts", wireType)
-			}
-		case 3:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidVal", wireType)
 			}
-			m.MaxPending = 0
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -14947,11 +17793,12 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPending |= (uint64(b) & 0x7F) << shift
+				v |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Val = &Value_UidVal{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -14973,7 +17820,7 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
+func (m *Mutation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14996,129 +17843,48 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ts = append(m.Ts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTask
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetJson", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTask
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ts = append(m.Ts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
 			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Assigned) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SetJson = append(m.SetJson[:0], dAtA[iNdEx:postIndex]...)
+			if m.SetJson == nil {
+				m.SetJson = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Assigned: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Assigned: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteJson", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15128,19 +17894,28 @@ func (m *Assigned) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.DeleteJson = append(m.DeleteJson[:0], dAtA[iNdEx:postIndex]...)
+			if m.DeleteJson == nil {
+				m.DeleteJson = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetNquads", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15150,12 +17925,28 @@ func (m *Assigned) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			if byteLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetNquads = append(m.SetNquads[:0], dAtA[iNdEx:postIndex]...)
+			if m.SetNquads == nil {
+				m.SetNquads = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelNquads", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15165,74 +17956,57 @@ func (m *Assigned) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Uids == nil {
-				m.Uids = make(map[string]string)
+			m.DelNquads = append(m.DelNquads[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelNquads == nil {
+				m.DelNquads = []byte{}
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthTask
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
 				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Uids[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Uids[mapkey] = mapvalue
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Set = append(m.Set, &NQuad{})
+			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Del", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15256,16 +18030,53 @@ func (m *Assigned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &TxnContext{}
-			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Del = append(m.Del, &NQuad{})
+			if err := m.Del[len(m.Del)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitNow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitNow = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15290,7 +18101,36 @@ func (m *Assigned) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cond = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15313,7 +18153,7 @@ func (m *Assigned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Num) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15336,17 +18176,17 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Num: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.Val = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15356,66 +18196,55 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Val |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AssignedIds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropAttr", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DropAttr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
 			}
-			m.StartId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15425,16 +18254,17 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartId |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.DropAll = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			m.EndId = 0
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15444,7 +18274,7 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndId |= (uint64(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15470,7 +18300,7 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NQuad) Unmarshal(dAtA []byte) error {
+func (m *Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15493,73 +18323,15 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NQuad: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NQuad: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15584,11 +18356,11 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15612,18 +18384,22 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectValue == nil {
-				m.ObjectValue = &Value{}
-			}
-			if err := m.ObjectValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15633,26 +18409,76 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Vars == nil {
+				m.Vars = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthTask
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Vars[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Vars[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var stringLen uint64
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15662,24 +18488,14 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15703,16 +18519,18 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LinRead == nil {
+				m.LinRead = &LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectVar", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15722,26 +18540,17 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubjectVar = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectVar", wireType)
+			m.Explain = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMs", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15751,76 +18560,16 @@ func (m *NQuad) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.TimeoutMs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectVar = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Value) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Value: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Value: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultVal", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultBudget", wireType)
 			}
-			var stringLen uint64
+			m.ResultBudget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15830,26 +18579,16 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ResultBudget |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Val = &Value_DefaultVal{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15859,27 +18598,76 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_BytesVal{v}
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Latency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Latency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Latency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParsingNs", wireType)
 			}
-			var v int64
+			m.ParsingNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15889,17 +18677,16 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int64(b) & 0x7F) << shift
+				m.ParsingNs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Val = &Value_IntVal{v}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoolVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingNs", wireType)
 			}
-			var v int
+			m.ProcessingNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15909,18 +18696,16 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.ProcessingNs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Val = &Value_BoolVal{b}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrVal", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodingNs", wireType)
 			}
-			var stringLen uint64
+			m.EncodingNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -15930,42 +18715,64 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.EncodingNs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = &Value_StrVal{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoubleVal", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Val = &Value_DoubleVal{float64(math.Float64frombits(v))}
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeoVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -15989,15 +18796,16 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_GeoVal{v}
+			m.Json = append(m.Json[:0], dAtA[iNdEx:postIndex]...)
+			if m.Json == nil {
+				m.Json = []byte{}
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16007,27 +18815,28 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_DateVal{v}
+			m.Schema = append(m.Schema, &SchemaNode{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatetimeVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16037,27 +18846,30 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Val = &Value_DatetimeVal{v}
+			if m.Txn == nil {
+				m.Txn = &TxnContext{}
+			}
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordVal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16067,94 +18879,59 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = &Value_PasswordVal{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidVal", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Latency == nil {
+				m.Latency = &Latency{}
 			}
-			m.Val = &Value_UidVal{v}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Latency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Mutation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mutation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -16178,16 +18955,16 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SetJson = append(m.SetJson[:0], dAtA[iNdEx:postIndex]...)
-			if m.SetJson == nil {
-				m.SetJson = []byte{}
+			m.Plan = append(m.Plan[:0], dAtA[iNdEx:postIndex]...)
+			if m.Plan == nil {
+				m.Plan = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16197,26 +18974,26 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeleteJson = append(m.DeleteJson[:0], dAtA[iNdEx:postIndex]...)
-			if m.DeleteJson == nil {
-				m.DeleteJson = []byte{}
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetNquads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rdf", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -16240,14 +19017,14 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SetNquads = append(m.SetNquads[:0], dAtA[iNdEx:postIndex]...)
-			if m.SetNquads == nil {
-				m.SetNquads = []byte{}
+			m.Rdf = append(m.Rdf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Rdf == nil {
+				m.Rdf = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelNquads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Csv", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -16271,14 +19048,14 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelNquads = append(m.DelNquads[:0], dAtA[iNdEx:postIndex]...)
-			if m.DelNquads == nil {
-				m.DelNquads = []byte{}
+			m.Csv = append(m.Csv[:0], dAtA[iNdEx:postIndex]...)
+			if m.Csv == nil {
+				m.Csv = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16302,16 +19079,116 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Set = append(m.Set, &NQuad{})
-			if err := m.Set[len(m.Set)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cursors = append(m.Cursors, &BlockCursor{})
+			if err := m.Cursors[len(m.Cursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Check) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Check: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Del", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16321,28 +19198,76 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Del = append(m.Del, &NQuad{})
-			if err := m.Del[len(m.Del)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.StartTs = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16352,16 +19277,26 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitNow", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16371,12 +19306,21 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CommitNow = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -16398,7 +19342,7 @@ func (m *Mutation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *Property) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16421,15 +19365,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Property: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Property: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prop", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16454,13 +19398,13 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.Prop = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropAttr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16470,60 +19414,25 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropAttr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DropAll = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if m.Value == nil {
+				m.Value = &Value{}
 			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -16545,7 +19454,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *Node) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16568,15 +19477,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16601,11 +19510,11 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Attribute = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16629,22 +19538,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Properties = append(m.Properties, &Property{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLenmapkey uint64
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16654,93 +19557,76 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Vars == nil {
-				m.Vars = make(map[string]string)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTask
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthTask
-				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Vars[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Vars[mapkey] = mapvalue
+			m.Children = append(m.Children, &Node{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthTask
 			}
-		case 14:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16764,13 +19650,30 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -16792,7 +19695,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Latency) Unmarshal(dAtA []byte) error {
+func (m *BlockCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16815,17 +19718,17 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Latency: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Latency: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParsingNs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			m.ParsingNs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16835,16 +19738,26 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParsingNs |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingNs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			m.ProcessingNs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16854,30 +19767,21 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProcessingNs |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncodingNs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			m.EncodingNs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EncodingNs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -16899,7 +19803,7 @@ func (m *Latency) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *AnalyzerUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16922,17 +19826,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: AnalyzerUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AnalyzerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16942,28 +19846,26 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Json = append(m.Json[:0], dAtA[iNdEx:postIndex]...)
-			if m.Json == nil {
-				m.Json = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -16973,28 +19875,26 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = append(m.Schema, &SchemaNode{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tokenizer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CharFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -17004,30 +19904,26 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Txn == nil {
-				m.Txn = &TxnContext{}
-			}
-			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CharFilters = append(m.CharFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -17037,128 +19933,82 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Latency == nil {
-				m.Latency = &Latency{}
-			}
-			if err := m.Latency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenFilters = append(m.TokenFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTask
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopWords", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Check) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StopWords = append(m.StopWords, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synonyms", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Check: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Check: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Version) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Synonyms = append(m.Synonyms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17183,7 +20033,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Mappings = append(m.Mappings, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17314,239 +20164,270 @@ var (
 func init() { proto.RegisterFile("task.proto", fileDescriptorTask) }
 
 var fileDescriptorTask = []byte{
-	// 3734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x93, 0x1b, 0x49,
-	0x56, 0xaa, 0x92, 0x54, 0xaa, 0x7a, 0x92, 0xda, 0x22, 0xd7, 0xeb, 0x91, 0xe5, 0xf1, 0x07, 0xe5,
-	0xd9, 0x9d, 0xde, 0xd9, 0xd9, 0x1e, 0x4f, 0x8f, 0xd7, 0x33, 0x6b, 0x18, 0x82, 0x76, 0x4b, 0xb6,
-	0x35, 0xee, 0xaf, 0xcd, 0x96, 0x7b, 0xd9, 0x3d, 0xa0, 0xa8, 0x56, 0x65, 0xb7, 0x6b, 0xbb, 0x54,
-	0x25, 0x57, 0x56, 0xf5, 0x74, 0xcf, 0x89, 0xd8, 0xe3, 0x12, 0xdc, 0x89, 0x80, 0x80, 0x33, 0x27,
-	0x2e, 0x2c, 0x44, 0x70, 0x20, 0x82, 0xe0, 0xc2, 0x81, 0x20, 0x08, 0x7e, 0x01, 0x0c, 0x77, 0x4e,
-	0x70, 0x27, 0xf2, 0x65, 0x66, 0x55, 0xa9, 0x5b, 0x2d, 0x7b, 0x58, 0x38, 0x29, 0xdf, 0xcb, 0xf7,
-	0xf2, 0xe3, 0xbd, 0x97, 0xef, 0xab, 0x04, 0x90, 0x7a, 0xfc, 0x64, 0x6d, 0x96, 0xc4, 0x69, 0x4c,
-	0x2c, 0xfc, 0xe1, 0x6e, 0x0f, 0x6a, 0x5b, 0x01, 0x4f, 0x09, 0x81, 0x5a, 0x16, 0xf8, 0xbc, 0x6b,
-	0xdc, 0xab, 0xae, 0x5a, 0x14, 0xc7, 0xee, 0x67, 0xe0, 0x8c, 0x3c, 0x7e, 0x72, 0xe0, 0x85, 0x19,
-	0x23, 0x1d, 0xa8, 0x9e, 0x7a, 0x61, 0xd7, 0xb8, 0x67, 0xac, 0xb6, 0xa8, 0x18, 0x92, 0x9b, 0x60,
-	0x9f, 0x7a, 0xe1, 0x38, 0x3d, 0x9f, 0xb1, 0xae, 0x79, 0xcf, 0x58, 0xad, 0xd3, 0xc6, 0xa9, 0x17,
-	0x8e, 0xce, 0x67, 0xcc, 0xdd, 0x85, 0xe6, 0x7e, 0x32, 0x79, 0x9a, 0x45, 0x93, 0x34, 0x88, 0x23,
-	0xb1, 0x78, 0xe4, 0x4d, 0x19, 0x32, 0x3b, 0x14, 0xc7, 0x02, 0xe7, 0x25, 0xc7, 0xbc, 0x5b, 0xbd,
-	0x57, 0x15, 0x38, 0x31, 0x26, 0x5d, 0x68, 0x04, 0x7c, 0x33, 0xce, 0xa2, 0xb4, 0x5b, 0xbb, 0x67,
-	0xac, 0xda, 0x54, 0x83, 0xee, 0x14, 0x1a, 0x5b, 0x41, 0x44, 0x99, 0xe7, 0x93, 0x0f, 0xa0, 0xaa,
-	0x0f, 0xda, 0x5c, 0xef, 0xca, 0xeb, 0xf0, 0x35, 0x35, 0xbb, 0x36, 0xf4, 0xf9, 0x20, 0x4a, 0x93,
-	0x73, 0x2a, 0x88, 0x7a, 0x8f, 0xc0, 0xd6, 0x08, 0x71, 0x81, 0x13, 0x76, 0x8e, 0x67, 0x68, 0x53,
-	0x31, 0x24, 0xd7, 0xa1, 0x7e, 0x2a, 0xee, 0x86, 0xa7, 0xaf, 0x51, 0x09, 0x3c, 0x36, 0x3f, 0x33,
-	0xdc, 0x5f, 0x56, 0xa1, 0xfe, 0xe3, 0x8c, 0x25, 0xe7, 0x78, 0xcc, 0x34, 0x4d, 0xf4, 0xd1, 0xc5,
-	0x58, 0xf0, 0x85, 0x5e, 0x74, 0xcc, 0xbb, 0x26, 0x9e, 0x5d, 0x02, 0xe4, 0x16, 0x38, 0xde, 0x51,
-	0xca, 0x92, 0x71, 0x16, 0xf8, 0xdd, 0xea, 0x3d, 0x63, 0xd5, 0xa2, 0x36, 0x22, 0x5e, 0x06, 0xbe,
-	0x90, 0x95, 0x1f, 0x8f, 0x27, 0xe5, 0xab, 0xf9, 0x31, 0x5e, 0x8d, 0xbc, 0x0f, 0x76, 0x16, 0xf8,
-	0xe3, 0x30, 0xe0, 0x69, 0xb7, 0x7e, 0xcf, 0x58, 0x6d, 0xae, 0xb7, 0x8a, 0x4b, 0xf1, 0x94, 0x36,
-	0xb2, 0xc0, 0x47, 0x15, 0xad, 0x81, 0xcd, 0x93, 0xc9, 0xf8, 0x28, 0x8b, 0x26, 0x5d, 0x0b, 0x09,
-	0xbf, 0xa5, 0x09, 0x4b, 0xc2, 0xa6, 0x0d, 0x2e, 0x01, 0x21, 0xcd, 0x84, 0x9d, 0xb2, 0x84, 0xb3,
-	0x6e, 0x43, 0x6e, 0xa9, 0x40, 0xb2, 0x06, 0xcd, 0x23, 0x6f, 0xc2, 0xd2, 0xf1, 0xcc, 0x4b, 0xbc,
-	0x69, 0xd7, 0xc6, 0xc5, 0xda, 0x7a, 0xb1, 0x3d, 0x81, 0xa4, 0x80, 0x14, 0x38, 0x26, 0x9f, 0x42,
-	0x1b, 0x21, 0x3e, 0x3e, 0x0a, 0xc2, 0x94, 0x25, 0x5d, 0x07, 0x39, 0x88, 0xe6, 0x78, 0x8a, 0xd8,
-	0x51, 0xc2, 0x18, 0x6d, 0x49, 0x42, 0x89, 0x21, 0xef, 0x88, 0x23, 0x78, 0xfe, 0x38, 0xe5, 0xdd,
-	0x36, 0xca, 0xd8, 0x12, 0xe0, 0x88, 0x93, 0x0f, 0xc0, 0x0e, 0x83, 0x68, 0x2c, 0xa0, 0xee, 0x0a,
-	0x2e, 0x76, 0xed, 0x82, 0x26, 0x69, 0x23, 0x94, 0x03, 0xf7, 0x11, 0x38, 0x68, 0x82, 0x28, 0x84,
-	0xef, 0x81, 0x85, 0x6a, 0xd2, 0x06, 0xf0, 0x1b, 0x9a, 0x2d, 0xb7, 0x54, 0xaa, 0x08, 0xdc, 0x3f,
-	0x32, 0xc1, 0xa2, 0x8c, 0x67, 0x61, 0x4a, 0xbe, 0x0f, 0x20, 0x64, 0x3c, 0xf5, 0xd2, 0x24, 0x38,
-	0x53, 0x9c, 0xf3, 0x52, 0x76, 0xb2, 0xc0, 0xdf, 0xc6, 0x69, 0xf2, 0x10, 0x5a, 0xb8, 0x82, 0x26,
-	0x37, 0xe7, 0x37, 0xca, 0xcf, 0x42, 0x9b, 0x48, 0xa6, 0xb8, 0x6e, 0x80, 0x85, 0xea, 0x95, 0x16,
-	0xdd, 0xa6, 0x0a, 0x22, 0xdf, 0x81, 0x95, 0x20, 0x4a, 0x85, 0xd8, 0x27, 0xe9, 0xd8, 0x67, 0x5c,
-	0xeb, 0xbf, 0x9d, 0x63, 0xfb, 0x8c, 0xa7, 0xe4, 0x87, 0x20, 0x25, 0xa7, 0x37, 0xad, 0xe3, 0xa6,
-	0x85, 0x84, 0x51, 0xaa, 0x72, 0x57, 0xa4, 0x53, 0xbb, 0x7e, 0x13, 0x39, 0x0e, 0xa0, 0xbe, 0x9b,
-	0xf8, 0x2c, 0x59, 0x68, 0xd3, 0x04, 0x6a, 0x3e, 0xe3, 0x13, 0x7c, 0x0a, 0x36, 0xc5, 0x71, 0x61,
-	0xe7, 0xd5, 0x92, 0x9d, 0xbb, 0xff, 0x6a, 0x40, 0x73, 0x3f, 0x4e, 0xd2, 0x6d, 0xc6, 0xb9, 0x77,
-	0xcc, 0xc8, 0x7d, 0xa8, 0xc7, 0x62, 0x59, 0x25, 0xd6, 0xdc, 0x8c, 0x70, 0x2f, 0x2a, 0xe7, 0x2e,
-	0x28, 0xc0, 0x5c, 0xae, 0x80, 0xeb, 0x50, 0x97, 0x2f, 0xa5, 0x8a, 0x5e, 0x45, 0x02, 0x42, 0xc0,
-	0xf1, 0xd1, 0x11, 0x67, 0x52, 0x80, 0x75, 0xaa, 0xa0, 0xff, 0x1b, 0x1b, 0x63, 0x00, 0xe2, 0x4e,
-	0xff, 0x1b, 0x73, 0xf9, 0x26, 0xdb, 0x3c, 0x83, 0x26, 0xf5, 0x8e, 0xd2, 0xcd, 0x38, 0x4a, 0xd9,
-	0x59, 0x4a, 0x56, 0xc0, 0x0c, 0x7c, 0x54, 0x83, 0x45, 0xcd, 0xc0, 0x17, 0x17, 0x3f, 0x4e, 0xe2,
-	0x6c, 0x86, 0x5a, 0x68, 0x53, 0x09, 0xa0, 0xba, 0x7c, 0x3f, 0x41, 0x69, 0x08, 0x75, 0xf9, 0x7e,
-	0xe2, 0xfe, 0x83, 0x01, 0xd6, 0x36, 0x9b, 0x1e, 0xb2, 0xe4, 0xd2, 0x22, 0x37, 0xc1, 0x46, 0xbe,
-	0x71, 0xe0, 0xab, 0x75, 0x1a, 0x08, 0x0f, 0xfd, 0x45, 0x2b, 0x09, 0xb1, 0x86, 0xcc, 0x13, 0xfa,
-	0x93, 0x76, 0xa9, 0x20, 0x21, 0x56, 0x6f, 0x3a, 0xf6, 0xc5, 0xad, 0xea, 0x72, 0xc2, 0x9b, 0xf6,
-	0x85, 0xff, 0xbd, 0x0b, 0xcd, 0xd0, 0xe3, 0xe9, 0x38, 0x9b, 0xf9, 0x5e, 0xca, 0xd0, 0x13, 0xd5,
-	0x28, 0x08, 0xd4, 0x4b, 0xc4, 0x90, 0x55, 0xe8, 0x4c, 0xc2, 0x4c, 0x78, 0xc2, 0x20, 0x3a, 0x8a,
-	0xc7, 0x71, 0x14, 0x9e, 0xa3, 0x66, 0x6c, 0xba, 0x22, 0xf1, 0xc3, 0xe8, 0x28, 0xde, 0x8d, 0xc2,
-	0x73, 0xf7, 0x0f, 0x4d, 0xa8, 0x3f, 0xc3, 0x3b, 0x3e, 0x84, 0xc6, 0x14, 0xaf, 0xa3, 0xdf, 0x75,
-	0x4f, 0xcb, 0x10, 0xe7, 0xd7, 0xe4, 0x5d, 0x95, 0x6b, 0xd7, 0xa4, 0x82, 0x2b, 0xf5, 0x0e, 0x43,
-	0x96, 0x72, 0x65, 0x52, 0x17, 0xb8, 0x46, 0x72, 0x52, 0x71, 0x29, 0xd2, 0xde, 0x17, 0xd0, 0x2a,
-	0x2f, 0x57, 0x0e, 0x0c, 0x35, 0x19, 0x18, 0xde, 0x2b, 0x07, 0x86, 0xe6, 0xfa, 0x8a, 0x5e, 0x55,
-	0xb2, 0x95, 0x02, 0x85, 0x58, 0xab, 0xbc, 0x49, 0x79, 0x2d, 0x67, 0xf9, 0x5a, 0x92, 0xad, 0x1c,
-	0x74, 0xfe, 0xd3, 0x80, 0xd6, 0xcf, 0x58, 0x12, 0xef, 0x25, 0xf1, 0x2c, 0xe6, 0x5e, 0x58, 0xd2,
-	0x6c, 0x1b, 0x35, 0xfb, 0x5d, 0xb0, 0xe4, 0xcd, 0xaf, 0x38, 0x97, 0x9a, 0x15, 0x74, 0xf2, 0xae,
-	0xa8, 0xe8, 0xcb, 0x7b, 0xaa, 0x59, 0x72, 0x07, 0x60, 0xea, 0x9d, 0x6d, 0x31, 0x8f, 0xb3, 0xa1,
-	0x8f, 0xea, 0xaf, 0xd1, 0x12, 0x86, 0xf4, 0xc0, 0x9e, 0x7a, 0x67, 0xa3, 0xb3, 0x68, 0xc4, 0xd1,
-	0x06, 0x6a, 0x34, 0x87, 0xc9, 0xbb, 0xe0, 0x4c, 0xbd, 0x33, 0x61, 0xcc, 0x43, 0x5f, 0xd9, 0x40,
-	0x81, 0x20, 0xef, 0x41, 0x35, 0x3d, 0x8b, 0x30, 0xec, 0x94, 0x9c, 0xd8, 0xe8, 0x2c, 0x52, 0x96,
-	0x4f, 0xc5, 0xb4, 0xfb, 0xb7, 0x55, 0xb8, 0xa6, 0x34, 0xf1, 0x2a, 0x98, 0xed, 0xa7, 0xc2, 0x78,
-	0xba, 0xd0, 0xc0, 0xe7, 0xce, 0x12, 0xa5, 0x10, 0x0d, 0x92, 0xdf, 0x02, 0x0b, 0xed, 0x58, 0xeb,
-	0xfa, 0xfe, 0xfc, 0xed, 0xf3, 0x25, 0xa4, 0xee, 0x95, 0xd2, 0x15, 0x0b, 0xf9, 0x0c, 0xea, 0x5f,
-	0xb1, 0x24, 0x96, 0xae, 0xac, 0xb9, 0xee, 0x5e, 0xc5, 0x2b, 0xe4, 0xaf, 0x58, 0x25, 0xc3, 0xff,
-	0xa3, 0x90, 0x56, 0x85, 0xe3, 0x9a, 0xc6, 0xa7, 0xcc, 0xef, 0x36, 0xf0, 0x54, 0x17, 0xf5, 0xa9,
-	0xa7, 0x7b, 0xcf, 0xa1, 0x59, 0xba, 0xd4, 0x82, 0x4c, 0xe6, 0xfe, 0xbc, 0x91, 0xb5, 0xe7, 0x9e,
-	0x41, 0xd9, 0x5e, 0x9f, 0x03, 0x14, 0x57, 0xfc, 0x75, 0x2c, 0xdf, 0x7d, 0x05, 0xd7, 0x36, 0xe3,
-	0x28, 0x62, 0x98, 0x74, 0x48, 0xdd, 0x15, 0xf6, 0x69, 0x2c, 0xb5, 0xcf, 0x1f, 0x40, 0x9d, 0x0b,
-	0x06, 0xb5, 0xc9, 0x3b, 0x57, 0x28, 0x83, 0x4a, 0x2a, 0xf7, 0x97, 0x06, 0x58, 0xd2, 0x72, 0xe7,
-	0x7c, 0x9b, 0x31, 0xef, 0xdb, 0xde, 0x05, 0x67, 0x96, 0x30, 0x3f, 0x98, 0xe8, 0x85, 0x1d, 0x5a,
-	0x20, 0x84, 0x67, 0x3d, 0x8a, 0x93, 0x09, 0xc3, 0x17, 0x61, 0x53, 0x09, 0x88, 0x94, 0x0d, 0x43,
-	0x07, 0xba, 0x28, 0xe9, 0xfe, 0x6c, 0x81, 0x10, 0xce, 0x49, 0xb0, 0xf0, 0x99, 0x37, 0x91, 0xc9,
-	0x53, 0x95, 0x4a, 0xc0, 0xfd, 0x95, 0x09, 0xad, 0x7e, 0x90, 0xb0, 0x49, 0xca, 0xfc, 0x81, 0x7f,
-	0xcc, 0x84, 0xff, 0x64, 0x51, 0x1a, 0xa4, 0xe7, 0xca, 0x05, 0x2b, 0x28, 0x0f, 0xb2, 0xe6, 0x7c,
-	0xe2, 0x28, 0xa5, 0x5b, 0xc5, 0x2c, 0x5a, 0x02, 0xe4, 0x11, 0x80, 0xcc, 0x37, 0x30, 0x93, 0x16,
-	0xc7, 0x58, 0x29, 0x64, 0xb2, 0x17, 0xf3, 0x34, 0x88, 0x8e, 0x45, 0xd6, 0x21, 0x32, 0x6b, 0xea,
-	0x20, 0xa9, 0x18, 0xaa, 0xfc, 0x3b, 0x63, 0x42, 0x18, 0x75, 0xdc, 0xbb, 0x81, 0xf0, 0xd0, 0x97,
-	0x91, 0xfb, 0x90, 0x85, 0x68, 0x74, 0x18, 0xb9, 0x0f, 0x59, 0x28, 0x8e, 0x24, 0x42, 0x38, 0x5e,
-	0xc8, 0xa1, 0x38, 0x26, 0xef, 0x83, 0x19, 0xcf, 0x30, 0x03, 0x2c, 0x6d, 0x5a, 0xbe, 0xe0, 0xda,
-	0xee, 0x8c, 0x9a, 0xf1, 0x8c, 0x7c, 0x07, 0x2c, 0x99, 0xda, 0x75, 0x9d, 0xf9, 0x38, 0x8f, 0xa9,
-	0x09, 0x55, 0x93, 0xee, 0x0d, 0x30, 0x77, 0x67, 0xa4, 0x01, 0xd5, 0xfd, 0xc1, 0xa8, 0x53, 0x11,
-	0x83, 0xfe, 0x60, 0xab, 0x63, 0xb8, 0xbf, 0x32, 0xc0, 0xd9, 0xce, 0x52, 0x4f, 0x58, 0x0b, 0x5f,
-	0xa6, 0xc7, 0x9b, 0x60, 0xf3, 0xd4, 0x4b, 0xd2, 0x31, 0x3a, 0x75, 0xf4, 0x00, 0x08, 0x63, 0x40,
-	0xaf, 0x33, 0xff, 0x98, 0xe9, 0x47, 0x7c, 0x7d, 0xd1, 0x71, 0xa9, 0x24, 0x21, 0x1f, 0x82, 0xc5,
-	0x27, 0xaf, 0xd8, 0xd4, 0xeb, 0xd6, 0xe6, 0x89, 0xf7, 0x11, 0x2b, 0x43, 0x15, 0x55, 0x34, 0xc2,
-	0xeb, 0xf4, 0x93, 0x78, 0xb6, 0x11, 0x86, 0x2a, 0xd8, 0x69, 0xd0, 0x7d, 0x1f, 0x9c, 0x17, 0xec,
-	0x1c, 0x73, 0x3e, 0x4e, 0x7a, 0x60, 0x9e, 0x9c, 0xaa, 0x00, 0x05, 0x7a, 0xc1, 0x17, 0x07, 0xd4,
-	0x3c, 0x39, 0x75, 0xff, 0xcb, 0x00, 0xfb, 0x4a, 0xcf, 0xfd, 0x11, 0x38, 0x53, 0x7d, 0x79, 0x65,
-	0xf5, 0x79, 0x3e, 0x99, 0x4b, 0x85, 0x16, 0x34, 0xe4, 0x13, 0x68, 0xa6, 0x67, 0xd1, 0x78, 0x22,
-	0xdd, 0xa5, 0xf2, 0xe3, 0x8b, 0x1c, 0x29, 0xa4, 0xf9, 0x58, 0x1d, 0xaf, 0xb6, 0xe8, 0x78, 0xc5,
-	0x9b, 0xab, 0xbf, 0xcd, 0x9b, 0x23, 0xef, 0xc3, 0xb5, 0x49, 0xc8, 0xbc, 0x68, 0x5c, 0xbc, 0x29,
-	0x69, 0x4a, 0x2b, 0x88, 0xde, 0xd3, 0x58, 0xf7, 0xf7, 0xc1, 0x7c, 0x71, 0x50, 0x76, 0x24, 0x2d,
-	0xe9, 0x48, 0x54, 0xb9, 0x68, 0x16, 0xe5, 0x62, 0x0f, 0xec, 0x8c, 0xb3, 0x64, 0x9b, 0xa5, 0x9e,
-	0xb2, 0xff, 0x1c, 0x16, 0xf2, 0x17, 0x95, 0x49, 0x10, 0x47, 0xca, 0xc3, 0x6a, 0xd0, 0x7d, 0x08,
-	0xe6, 0x8b, 0xcd, 0x05, 0xeb, 0xbf, 0x0b, 0x4e, 0x1a, 0x4c, 0x19, 0x4f, 0xbd, 0xe9, 0x4c, 0xd9,
-	0x49, 0x81, 0x70, 0x9f, 0x82, 0x83, 0xae, 0xef, 0x05, 0x3b, 0x5f, 0x6a, 0x6c, 0x77, 0xa0, 0x76,
-	0xc2, 0xce, 0x75, 0x44, 0x29, 0x64, 0xb6, 0x49, 0x11, 0xef, 0xfe, 0x77, 0x15, 0x1a, 0xea, 0x05,
-	0x8a, 0x33, 0x64, 0x79, 0xa2, 0x25, 0x86, 0xf3, 0xf5, 0x63, 0xfe, 0x9c, 0xd7, 0x4b, 0x65, 0x71,
-	0x75, 0xf9, 0x63, 0xd6, 0xf5, 0x32, 0xf9, 0x1d, 0x68, 0xcd, 0xe4, 0x5c, 0xd9, 0x09, 0xdc, 0xba,
-	0xc8, 0xa7, 0x7e, 0x91, 0xb7, 0x39, 0x2b, 0x00, 0x0c, 0x42, 0x2c, 0xf5, 0x7c, 0x2f, 0xf5, 0x50,
-	0xc1, 0x2d, 0x9a, 0xc3, 0x57, 0xf8, 0x82, 0xb7, 0x7b, 0xce, 0xc2, 0x90, 0xe3, 0x59, 0xb7, 0x25,
-	0x0d, 0x39, 0x9e, 0xcd, 0xbd, 0xce, 0xf6, 0xfc, 0xeb, 0xbc, 0x05, 0xce, 0x24, 0x9e, 0x4e, 0x03,
-	0x9c, 0x5b, 0x91, 0x91, 0x50, 0x22, 0x46, 0xdc, 0xfd, 0x0a, 0x1a, 0xea, 0xd2, 0xa4, 0x09, 0x8d,
-	0xfe, 0xe0, 0xe9, 0xc6, 0xcb, 0x2d, 0xe1, 0x1f, 0x00, 0xac, 0x27, 0xc3, 0x9d, 0x0d, 0xfa, 0xd3,
-	0x8e, 0x21, 0x7c, 0xc5, 0x70, 0x67, 0xd4, 0x31, 0x89, 0x03, 0xf5, 0xa7, 0x5b, 0xbb, 0x1b, 0xa3,
-	0x4e, 0x95, 0xd8, 0x50, 0x7b, 0xb2, 0xbb, 0xbb, 0xd5, 0xa9, 0x91, 0x16, 0xd8, 0xfd, 0x8d, 0xd1,
-	0x60, 0x34, 0xdc, 0x1e, 0x74, 0xea, 0x82, 0xf6, 0xd9, 0x60, 0xb7, 0x63, 0x89, 0xc1, 0xcb, 0x61,
-	0xbf, 0xd3, 0x10, 0xf3, 0x7b, 0x1b, 0xfb, 0xfb, 0x3f, 0xd9, 0xa5, 0xfd, 0x8e, 0x2d, 0xd6, 0xdd,
-	0x1f, 0xd1, 0xe1, 0xce, 0xb3, 0x8e, 0xe3, 0x7e, 0x0c, 0xcd, 0x92, 0xe0, 0x04, 0x07, 0x1d, 0x3c,
-	0xed, 0x54, 0xc4, 0x36, 0x07, 0x1b, 0x5b, 0x2f, 0x07, 0x1d, 0x83, 0xac, 0x00, 0xe0, 0x70, 0xbc,
-	0xb5, 0xb1, 0xf3, 0xac, 0x63, 0xba, 0xbf, 0x30, 0x72, 0x1e, 0xac, 0x3a, 0xbf, 0x0f, 0xb6, 0x12,
-	0xb7, 0xce, 0x4f, 0xaf, 0x5d, 0xd0, 0x0d, 0xcd, 0x09, 0x84, 0x32, 0x26, 0xaf, 0xd8, 0xe4, 0x84,
-	0x67, 0x53, 0x65, 0x19, 0x39, 0x2c, 0xab, 0x44, 0x21, 0x13, 0x34, 0x8d, 0x1a, 0x55, 0x50, 0xde,
-	0x7e, 0xa9, 0x21, 0xbd, 0x6c, 0xbf, 0xfc, 0xb3, 0x01, 0x75, 0xd4, 0xc6, 0x82, 0xac, 0x72, 0xb1,
-	0xe9, 0x3d, 0xb8, 0x64, 0x7a, 0xdf, 0x9e, 0x53, 0xeb, 0x65, 0xc3, 0xbb, 0x01, 0x56, 0x1a, 0x9f,
-	0xb0, 0x88, 0xa3, 0xdb, 0x70, 0xa8, 0x82, 0xf4, 0xf3, 0xad, 0xcb, 0x1d, 0x4f, 0xbd, 0xd0, 0xdd,
-	0x28, 0x34, 0x58, 0x08, 0xb7, 0xa2, 0x95, 0x66, 0x14, 0x4a, 0x33, 0x73, 0xa5, 0x55, 0xe7, 0x94,
-	0x56, 0x73, 0x1f, 0x41, 0x5d, 0xf6, 0x13, 0x6e, 0x82, 0xed, 0x85, 0xe1, 0x18, 0x9f, 0x9e, 0x21,
-	0xfd, 0xad, 0x17, 0x86, 0xf8, 0x58, 0x49, 0xe9, 0x45, 0x3a, 0xea, 0x15, 0x7e, 0x04, 0x96, 0xac,
-	0x7f, 0x4b, 0x56, 0x6b, 0x2c, 0x0b, 0x42, 0x9f, 0x03, 0x14, 0x05, 0x33, 0xf9, 0x48, 0x75, 0x3b,
-	0xb8, 0xec, 0xb1, 0x18, 0xf3, 0xb9, 0x96, 0x24, 0x54, 0xed, 0x0e, 0x64, 0x70, 0xfb, 0x60, 0x2f,
-	0x6d, 0x5d, 0x29, 0x75, 0x98, 0x85, 0x3a, 0x16, 0x34, 0xb3, 0xdc, 0x04, 0xa0, 0xe8, 0x8b, 0xa8,
-	0x87, 0x24, 0x57, 0x11, 0x0f, 0x69, 0x4d, 0x18, 0x49, 0x10, 0xfa, 0x09, 0x8b, 0x94, 0xf7, 0x59,
-	0xd4, 0x4d, 0xc9, 0x69, 0xc8, 0x7b, 0x50, 0xc3, 0xc6, 0x8f, 0x8c, 0x04, 0x9d, 0x9c, 0x56, 0x77,
-	0x7d, 0x70, 0xd6, 0x3d, 0x84, 0xb6, 0x8c, 0x6f, 0x94, 0xbd, 0xce, 0x18, 0x4f, 0x97, 0xfb, 0x3e,
-	0xc8, 0x9d, 0xbb, 0x96, 0x77, 0x09, 0x23, 0x4c, 0xe3, 0x28, 0x60, 0xa1, 0xaf, 0x6f, 0xa5, 0x20,
-	0xf7, 0x31, 0xb4, 0xf4, 0x1e, 0x58, 0x2c, 0x7f, 0x90, 0x47, 0x5a, 0x63, 0xfe, 0x1e, 0x92, 0x6a,
-	0x27, 0xf6, 0xf3, 0x38, 0xeb, 0xfe, 0xb5, 0x01, 0x50, 0xa0, 0xe7, 0x73, 0x36, 0xe3, 0x62, 0xce,
-	0x46, 0xa0, 0x96, 0xf7, 0x16, 0x1d, 0x8a, 0x63, 0x61, 0xf7, 0x41, 0xe4, 0xb3, 0x33, 0x9d, 0xc7,
-	0x21, 0x80, 0xc1, 0x40, 0xd8, 0x6d, 0xf0, 0x15, 0x96, 0xb1, 0xe2, 0xb4, 0x05, 0xa2, 0xdc, 0x07,
-	0xab, 0xcf, 0xf7, 0xc1, 0xf2, 0x46, 0x83, 0x25, 0x57, 0x93, 0x8d, 0x06, 0x91, 0x26, 0x09, 0x43,
-	0x91, 0x4d, 0x33, 0x1c, 0xbb, 0x7f, 0x6f, 0xea, 0x5b, 0xab, 0x22, 0x77, 0xf9, 0xd1, 0xe7, 0x53,
-	0x3a, 0xf3, 0xad, 0x53, 0xba, 0xdf, 0x06, 0xc7, 0xc7, 0x64, 0x26, 0x38, 0xd5, 0x2f, 0xf8, 0xce,
-	0xa2, 0xc4, 0x45, 0xa5, 0x3c, 0xc1, 0x29, 0xa3, 0x05, 0xc3, 0x1b, 0xc4, 0x90, 0x5f, 0xb6, 0xbe,
-	0xe8, 0xb2, 0x56, 0x71, 0x59, 0xe1, 0xc0, 0xd8, 0xd9, 0x2c, 0x0c, 0x26, 0x81, 0x16, 0x42, 0x0e,
-	0xbb, 0x3f, 0x02, 0x27, 0xdf, 0x5b, 0x3c, 0xf4, 0x9d, 0xdd, 0x9d, 0x81, 0xf4, 0xa5, 0xc3, 0x9d,
-	0xfe, 0xe0, 0xf7, 0x3a, 0x86, 0xf0, 0xef, 0x74, 0x70, 0x30, 0xa0, 0xfb, 0x83, 0x8e, 0x29, 0x5c,
-	0x45, 0x7f, 0xb0, 0x35, 0x18, 0x0d, 0x3a, 0x55, 0xf7, 0xa7, 0x60, 0x6f, 0x7b, 0xb3, 0x4b, 0x95,
-	0x47, 0x91, 0x30, 0x64, 0xaa, 0x63, 0xa1, 0xc2, 0xeb, 0xf7, 0xa0, 0xa1, 0x7c, 0xaa, 0xb2, 0xfa,
-	0x4b, 0x3e, 0x57, 0xcf, 0xbb, 0xb7, 0xa1, 0xb1, 0xe7, 0x9d, 0x87, 0xb1, 0x87, 0x3d, 0x8e, 0xbe,
-	0x08, 0x83, 0x72, 0x69, 0x1c, 0xbb, 0x7f, 0x69, 0xc0, 0xf5, 0xed, 0xf8, 0x94, 0xe5, 0x69, 0x8b,
-	0x26, 0x5e, 0xae, 0xc5, 0xef, 0xc2, 0x35, 0x1e, 0x67, 0xc9, 0x84, 0x8d, 0x2f, 0x34, 0x54, 0xda,
-	0x12, 0xfd, 0x4c, 0xbd, 0x24, 0x17, 0xda, 0x3e, 0xe3, 0x69, 0x41, 0x55, 0x45, 0xaa, 0xa6, 0x40,
-	0x6a, 0x9a, 0x3c, 0xff, 0xaa, 0xbd, 0x55, 0xcd, 0xf3, 0x4f, 0x06, 0xb4, 0x07, 0x67, 0xb3, 0x38,
-	0x49, 0xf5, 0x51, 0xbf, 0x0d, 0x56, 0xc2, 0x5e, 0xeb, 0x77, 0x5c, 0xa3, 0xf5, 0x84, 0xbd, 0x1e,
-	0x2e, 0xed, 0xf6, 0x3c, 0x04, 0x4b, 0x2c, 0x96, 0x71, 0x65, 0x49, 0xef, 0xea, 0x3d, 0xe7, 0x16,
-	0x5e, 0xdb, 0x47, 0x1a, 0xaa, 0x68, 0xcb, 0xed, 0xb4, 0x5a, 0xb9, 0x9d, 0xe6, 0x3e, 0x06, 0x4b,
-	0x92, 0x96, 0xd4, 0xde, 0x84, 0xc6, 0xfe, 0xcb, 0xcd, 0xcd, 0xc1, 0xfe, 0x7e, 0xc7, 0x20, 0x6d,
-	0x70, 0xfa, 0x2f, 0xf7, 0xb6, 0x86, 0x9b, 0x1b, 0x23, 0xa5, 0xfa, 0xa7, 0x1b, 0xc3, 0xad, 0x41,
-	0xbf, 0x53, 0x75, 0xff, 0xcc, 0x00, 0x28, 0x92, 0xd6, 0xb9, 0x2c, 0xc2, 0x58, 0x92, 0x45, 0x98,
-	0xf3, 0x59, 0x84, 0x78, 0xc9, 0xde, 0x61, 0x9c, 0xa4, 0xcc, 0x57, 0xef, 0x5f, 0x83, 0x79, 0xd8,
-	0xa8, 0x15, 0x61, 0x63, 0xae, 0x31, 0xd7, 0x7e, 0x43, 0x63, 0xee, 0xef, 0x0c, 0x68, 0xee, 0x26,
-	0xde, 0x24, 0x64, 0x7d, 0x16, 0xa6, 0x1e, 0x79, 0x0c, 0x0d, 0xb9, 0xab, 0x8e, 0x34, 0xf7, 0x8a,
-	0xb6, 0x66, 0x4e, 0xb5, 0xb6, 0x29, 0x49, 0x54, 0x7f, 0x49, 0x31, 0x08, 0xc7, 0x89, 0xc7, 0x92,
-	0x4e, 0xb5, 0x46, 0x15, 0x44, 0xee, 0x42, 0x73, 0xea, 0x9d, 0x8d, 0x67, 0x2c, 0xf2, 0xb5, 0x4d,
-	0xcb, 0x56, 0xc2, 0x9e, 0xc4, 0xf4, 0x1e, 0x43, 0xab, 0xbc, 0xe2, 0x82, 0xf2, 0xfc, 0xea, 0x2f,
-	0x16, 0x77, 0xa1, 0x3d, 0x3a, 0x8b, 0x46, 0x3a, 0x03, 0xc6, 0xcc, 0x4d, 0x1d, 0xbe, 0x46, 0xcd,
-	0x94, 0xbb, 0x7f, 0x63, 0x80, 0xbd, 0xc1, 0x79, 0x70, 0x1c, 0x31, 0x9f, 0xac, 0x95, 0xbe, 0xf6,
-	0x94, 0xba, 0x66, 0x7a, 0x7e, 0xed, 0x65, 0xa0, 0x3f, 0xa3, 0x20, 0x1d, 0xf9, 0x50, 0x88, 0x43,
-	0x96, 0x22, 0xe6, 0x95, 0xa5, 0x88, 0x26, 0x11, 0xa7, 0x64, 0x49, 0x12, 0xeb, 0x3e, 0xa3, 0x04,
-	0x7a, 0x9f, 0x82, 0x93, 0x2f, 0xfb, 0xa6, 0x8c, 0xc6, 0x29, 0x5f, 0xed, 0x1d, 0xa8, 0xee, 0x64,
-	0xd3, 0xf2, 0x07, 0xa8, 0x9a, 0x4c, 0x49, 0x3e, 0x87, 0xa6, 0x3e, 0xf1, 0xd0, 0x47, 0xeb, 0x40,
-	0x2b, 0x1a, 0xfa, 0x73, 0x46, 0x25, 0xcb, 0x61, 0x16, 0xf9, 0x43, 0x5f, 0x8b, 0x0d, 0x01, 0xf7,
-	0xcf, 0x4d, 0xa8, 0xef, 0xfc, 0x38, 0xf3, 0x7c, 0xe4, 0xcc, 0x0e, 0x7f, 0xce, 0x26, 0xa9, 0x3a,
-	0x91, 0x06, 0xdf, 0xd0, 0x55, 0xb8, 0x05, 0x4e, 0x8c, 0x74, 0xfa, 0xd1, 0x3b, 0xd4, 0x96, 0x88,
-	0xa1, 0x4f, 0x1e, 0x40, 0x4b, 0x4d, 0xca, 0x7b, 0xd5, 0xe6, 0x5b, 0x33, 0xf2, 0x5b, 0x45, 0x53,
-	0x92, 0xc8, 0x4f, 0x6c, 0x79, 0xa6, 0x5e, 0x5f, 0x54, 0xb5, 0x5b, 0xa5, 0xaa, 0xbd, 0xc8, 0x83,
-	0x1a, 0xcb, 0xb2, 0xf7, 0xbb, 0xd0, 0x54, 0x17, 0x19, 0x9f, 0x7a, 0x09, 0x56, 0xf9, 0x0e, 0x05,
-	0x85, 0x3a, 0xf0, 0x12, 0x72, 0x1b, 0x20, 0x2e, 0xe6, 0x1d, 0x79, 0x3f, 0x7d, 0xa4, 0xc4, 0xfd,
-	0x77, 0x13, 0xea, 0xf2, 0x68, 0xbf, 0x09, 0x4d, 0x9f, 0x1d, 0x79, 0x59, 0x88, 0xb7, 0x91, 0x52,
-	0x7a, 0x5e, 0xa1, 0xa0, 0x90, 0x07, 0x5e, 0x48, 0x6e, 0x83, 0x73, 0x78, 0x9e, 0x32, 0x3e, 0xce,
-	0xeb, 0xbe, 0xe7, 0x15, 0x6a, 0x23, 0xea, 0x00, 0xbf, 0x16, 0x36, 0x82, 0x48, 0x72, 0x0b, 0x49,
-	0x55, 0x9f, 0x57, 0xa8, 0x15, 0x44, 0xc8, 0x79, 0x0b, 0xec, 0xc3, 0x38, 0x0e, 0x71, 0x0e, 0xbb,
-	0x30, 0xcf, 0x2b, 0xb4, 0x21, 0x30, 0x8a, 0x8f, 0xa7, 0xc9, 0x38, 0xcf, 0x46, 0x05, 0x1f, 0x4f,
-	0x13, 0x31, 0x75, 0x17, 0xc0, 0x8f, 0xb3, 0xc3, 0x90, 0xe1, 0xac, 0x90, 0x8f, 0xf1, 0xbc, 0x42,
-	0x1d, 0x89, 0x53, 0xbc, 0xc7, 0x2c, 0xc6, 0xd9, 0x86, 0x3a, 0x90, 0x75, 0xcc, 0x62, 0xb5, 0xa7,
-	0x08, 0xa4, 0x38, 0x67, 0xab, 0xb9, 0x86, 0xc0, 0x88, 0xc9, 0xfb, 0xd0, 0x12, 0x43, 0x51, 0x4f,
-	0x22, 0x81, 0xa3, 0x08, 0x9a, 0x1a, 0xab, 0x88, 0x66, 0x1e, 0xe7, 0x5f, 0xc6, 0x89, 0x8f, 0x44,
-	0xa0, 0x4e, 0xd7, 0xd4, 0x58, 0x75, 0x82, 0x2c, 0x90, 0xf3, 0x4d, 0x61, 0x7b, 0xe2, 0x04, 0x59,
-	0x20, 0xa6, 0x9e, 0xd4, 0xd1, 0x9e, 0xdd, 0x3f, 0x30, 0xc1, 0xd6, 0x2d, 0x00, 0x74, 0x8c, 0x2c,
-	0x1d, 0xff, 0x9c, 0xc7, 0x91, 0x0a, 0x60, 0x0d, 0xce, 0xd2, 0x2f, 0x78, 0x1c, 0x09, 0x5d, 0xfa,
-	0x2c, 0x64, 0x29, 0x93, 0xb3, 0x32, 0xef, 0x07, 0x89, 0x42, 0x82, 0xdb, 0x00, 0x82, 0x37, 0x7a,
-	0x9d, 0x79, 0x3e, 0x57, 0x15, 0xb6, 0xc3, 0x59, 0xba, 0x83, 0x08, 0x31, 0xed, 0xb3, 0x50, 0x4f,
-	0xcb, 0x3a, 0xc3, 0xf1, 0x59, 0xa8, 0xa6, 0xef, 0x42, 0x95, 0xb3, 0xb4, 0x0b, 0xf3, 0xe6, 0x84,
-	0xcf, 0x83, 0x8a, 0x19, 0x41, 0xe0, 0x33, 0x71, 0x8b, 0x45, 0x04, 0x3e, 0x0b, 0x97, 0x95, 0x86,
-	0xb7, 0x01, 0x94, 0x53, 0x8f, 0xe2, 0x2f, 0xb1, 0x36, 0xb4, 0xa9, 0x72, 0xf3, 0x3b, 0xf1, 0x97,
-	0x6e, 0x06, 0xce, 0xee, 0x8c, 0x25, 0x52, 0x04, 0x37, 0x4a, 0xe9, 0xa4, 0x30, 0x47, 0xdd, 0xa2,
-	0xb9, 0x05, 0x8e, 0x9f, 0xc4, 0xb3, 0x71, 0xa9, 0xa9, 0x66, 0x0b, 0xc4, 0x46, 0x9a, 0x26, 0xf8,
-	0x79, 0x15, 0x27, 0xc3, 0x50, 0x47, 0x06, 0x5f, 0x36, 0x70, 0x72, 0xaf, 0x30, 0xd2, 0xf1, 0x4c,
-	0x83, 0xa2, 0xbe, 0x6a, 0xe8, 0x3c, 0xf9, 0x3a, 0xd4, 0x5f, 0x67, 0x2c, 0xd1, 0x1e, 0x49, 0x02,
-	0xe4, 0x07, 0x50, 0x3b, 0xf5, 0x12, 0xdd, 0x1e, 0xb8, 0xa9, 0x2f, 0xad, 0x98, 0xd6, 0x0e, 0x3c,
-	0xfd, 0x45, 0x02, 0xc9, 0x96, 0x49, 0xe0, 0x1b, 0x7c, 0x24, 0x12, 0x8e, 0x32, 0x5f, 0xf9, 0x1b,
-	0x39, 0xca, 0x08, 0x1a, 0x5b, 0x5e, 0xca, 0xa2, 0xc9, 0xb9, 0x90, 0xf8, 0xcc, 0x4b, 0x78, 0x10,
-	0x1d, 0x8f, 0x23, 0x1d, 0x63, 0x1d, 0x85, 0xd9, 0xe1, 0xe4, 0x3e, 0xb4, 0x67, 0x49, 0x3c, 0x61,
-	0x5c, 0x53, 0x48, 0xc7, 0xd8, 0x2a, 0x90, 0x3b, 0xe8, 0x3d, 0x58, 0x34, 0x89, 0x7d, 0x45, 0xa2,
-	0xe2, 0x95, 0x46, 0xed, 0x70, 0xf7, 0x4f, 0x0c, 0xb0, 0x29, 0xe3, 0xb3, 0x38, 0xe2, 0x98, 0xad,
-	0x97, 0xcc, 0x16, 0xc7, 0xa5, 0xd2, 0xc0, 0x7c, 0x53, 0x69, 0xa0, 0x3f, 0x19, 0x54, 0x97, 0x7e,
-	0x32, 0x10, 0x39, 0x61, 0x28, 0xaf, 0x88, 0x4d, 0x89, 0xb2, 0x18, 0x25, 0x9a, 0xea, 0x79, 0xb7,
-	0x01, 0xf5, 0x4d, 0x51, 0x76, 0xbb, 0xb7, 0xa0, 0x71, 0x20, 0xbb, 0x49, 0x42, 0x9a, 0xa9, 0x77,
-	0xac, 0xa5, 0x99, 0x7a, 0xc7, 0xeb, 0x7f, 0x6a, 0x40, 0x8d, 0x7a, 0x47, 0xa2, 0x8c, 0xa9, 0x0d,
-	0x26, 0xaf, 0x62, 0x52, 0x24, 0x99, 0x32, 0x3f, 0xea, 0x5d, 0x44, 0xb8, 0x15, 0xf2, 0xb1, 0xfc,
-	0x8c, 0xa7, 0xbf, 0x80, 0xbe, 0x0d, 0xcb, 0x0f, 0xa1, 0xf9, 0x45, 0x1c, 0x44, 0x9b, 0x61, 0xc6,
-	0x53, 0x96, 0x90, 0xfc, 0xcb, 0x7d, 0xe9, 0x73, 0xe0, 0x02, 0xb6, 0xf5, 0xbf, 0xaa, 0x42, 0xed,
-	0x67, 0x2c, 0x89, 0xc9, 0x43, 0x68, 0xa8, 0x76, 0x3b, 0xb9, 0xd0, 0x56, 0xef, 0xe5, 0xb9, 0xe4,
-	0x85, 0x7e, 0xbc, 0x5b, 0x21, 0x8f, 0xc0, 0x52, 0xf5, 0xca, 0xfc, 0x27, 0x81, 0xde, 0x55, 0xf9,
-	0xa7, 0x5b, 0x59, 0x35, 0x1e, 0x18, 0x64, 0x1d, 0x2c, 0x99, 0xe7, 0x5c, 0xbe, 0xdb, 0xb7, 0x16,
-	0x24, 0x42, 0x6e, 0xe5, 0x81, 0x21, 0xca, 0xec, 0xfd, 0x57, 0x71, 0x16, 0xfa, 0xfb, 0x2c, 0x39,
-	0x65, 0xe4, 0xc2, 0x47, 0xa7, 0xde, 0x05, 0xd8, 0xad, 0x90, 0x07, 0x00, 0x32, 0x7c, 0x8b, 0xb4,
-	0x80, 0x34, 0x73, 0x97, 0x92, 0x4d, 0x8b, 0x4d, 0x4a, 0xf1, 0x5d, 0x72, 0x94, 0x32, 0x9c, 0xb7,
-	0xe1, 0xf8, 0x11, 0xb4, 0x65, 0x4a, 0xb5, 0x9b, 0x6c, 0x88, 0x2c, 0x8c, 0x2c, 0xb0, 0xac, 0xde,
-	0x02, 0x9c, 0x5b, 0x21, 0x8f, 0xc1, 0x1e, 0x25, 0xe7, 0x92, 0xeb, 0xdb, 0x25, 0x8a, 0xe2, 0x04,
-	0xbd, 0xc5, 0x68, 0xb7, 0xb2, 0xfe, 0x17, 0x35, 0xb0, 0x7e, 0x12, 0x27, 0x27, 0x2c, 0x21, 0x1f,
-	0x83, 0x85, 0xee, 0x9d, 0x91, 0xcb, 0x1d, 0xdf, 0x2b, 0x76, 0x7e, 0xf4, 0x36, 0x87, 0x5e, 0x60,
-	0x63, 0x1f, 0x82, 0x83, 0xb2, 0x1f, 0x79, 0xfc, 0xa4, 0x50, 0x38, 0xfe, 0x8f, 0xa5, 0x10, 0xbf,
-	0xac, 0xda, 0xdd, 0x0a, 0xf9, 0x1c, 0x6e, 0xe4, 0xf5, 0xd0, 0x46, 0xe4, 0xcb, 0x27, 0x29, 0xca,
-	0xa5, 0xe2, 0xa0, 0x79, 0x0f, 0xb5, 0x57, 0x6a, 0x27, 0x2b, 0x13, 0xf9, 0x18, 0x6a, 0xfb, 0xe2,
-	0x6c, 0xc5, 0x7f, 0x50, 0x8a, 0xff, 0x04, 0x14, 0xf7, 0x2a, 0x3e, 0xaa, 0xbb, 0x15, 0xf2, 0x29,
-	0x58, 0x72, 0x97, 0x42, 0x9e, 0x73, 0xdd, 0x8a, 0xde, 0xf5, 0x8b, 0x68, 0xc5, 0xf8, 0x01, 0xd8,
-	0xdb, 0x41, 0x24, 0xbf, 0xa9, 0x5d, 0x32, 0xc8, 0xb2, 0x19, 0xb8, 0x15, 0xf2, 0x19, 0x58, 0xb2,
-	0xbe, 0x29, 0x36, 0x99, 0xab, 0x77, 0x7a, 0x8b, 0xd1, 0x6e, 0x85, 0x7c, 0x02, 0x1d, 0xca, 0x26,
-	0x2c, 0x28, 0xd5, 0x89, 0xa4, 0x74, 0xef, 0x05, 0x12, 0x5f, 0x35, 0xc8, 0xef, 0x42, 0x7b, 0xae,
-	0xb2, 0x24, 0x79, 0x95, 0xb5, 0xa8, 0xe0, 0x5c, 0xf4, 0xc4, 0x7f, 0x61, 0x82, 0xd5, 0x3f, 0x4e,
-	0xbc, 0xd9, 0x2b, 0xf2, 0xa1, 0xfe, 0xd7, 0xd1, 0xb5, 0x0b, 0xa1, 0xa6, 0xd7, 0x29, 0xa9, 0x0f,
-	0xfd, 0xad, 0x5b, 0x21, 0x6b, 0xb9, 0x65, 0x75, 0x2e, 0x5a, 0x56, 0x41, 0xaf, 0x9f, 0x83, 0x5b,
-	0x11, 0x25, 0xe8, 0x06, 0xfe, 0x2b, 0x27, 0xd7, 0x6f, 0x1e, 0x75, 0x17, 0x59, 0xd3, 0xaf, 0xf1,
-	0x74, 0x1e, 0x40, 0x0b, 0x5d, 0xaf, 0x76, 0xbb, 0xb9, 0x2d, 0x22, 0xb6, 0xd8, 0x4c, 0xcd, 0xbb,
-	0x95, 0x27, 0xab, 0xff, 0xf8, 0xf5, 0x1d, 0xe3, 0x5f, 0xbe, 0xbe, 0x63, 0xfc, 0xdb, 0xd7, 0x77,
-	0x8c, 0x3f, 0xfe, 0x8f, 0x3b, 0x15, 0x70, 0x82, 0x78, 0xcd, 0x47, 0xb1, 0x3c, 0x69, 0x4a, 0xf1,
-	0xec, 0x09, 0xa6, 0x43, 0xf9, 0xc7, 0xb5, 0x4f, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x25, 0x11,
-	0x2d, 0x1f, 0xcd, 0x26, 0x00, 0x00,
+	// 4227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xb5, 0x3a, 0x49, 0x90, 0x1c, 0x57,
+	0x56, 0xaa, 0xbd, 0xf2, 0x55, 0x55, 0x77, 0x39, 0xc7, 0x96, 0x7b, 0xca, 0x33, 0xf2, 0x4c, 0x1a,
+	0x66, 0x34, 0x8b, 0xdb, 0xb6, 0xac, 0x91, 0x3d, 0x1e, 0x4c, 0xd0, 0xea, 0x2e, 0xc9, 0x65, 0xf7,
+	0x36, 0xd9, 0x25, 0x0d, 0x03, 0x11, 0x54, 0x64, 0x57, 0x66, 0xb7, 0x72, 0x94, 0x95, 0x59, 0xca,
+	0xcc, 0x92, 0xbb, 0x7d, 0x22, 0x38, 0xc2, 0x81, 0x13, 0x11, 0x1c, 0x08, 0x0e, 0xdc, 0xe0, 0xc2,
+	0x85, 0x2d, 0x08, 0x82, 0x08, 0x02, 0x0e, 0x1c, 0x08, 0x62, 0x8e, 0x70, 0x63, 0xe0, 0xce, 0x09,
+	0xee, 0xbc, 0xe5, 0xff, 0x5c, 0x4a, 0xd5, 0x25, 0x89, 0x81, 0x43, 0x47, 0xe7, 0x5b, 0xfe, 0xf6,
+	0xb6, 0xff, 0xde, 0xfb, 0x05, 0x90, 0x3a, 0xc9, 0xe3, 0xed, 0x79, 0x1c, 0xa5, 0x91, 0xd9, 0xe4,
+	0x7f, 0x89, 0x35, 0x80, 0xfa, 0xbe, 0x9f, 0xa4, 0xa6, 0x09, 0xf5, 0x85, 0xef, 0x26, 0x5b, 0x95,
+	0xaf, 0xd5, 0x6e, 0x36, 0x6d, 0xfe, 0xb6, 0x3e, 0x04, 0x63, 0x8c, 0x23, 0x1e, 0x3a, 0xc1, 0xc2,
+	0x33, 0xfb, 0x50, 0x7b, 0xea, 0x04, 0x48, 0xaf, 0xdc, 0xec, 0xda, 0xf4, 0x69, 0x7e, 0x19, 0xda,
+	0xf8, 0x6f, 0x92, 0x5e, 0xce, 0xbd, 0xad, 0x2a, 0xa2, 0x1b, 0x76, 0x0b, 0xe1, 0x31, 0x82, 0xd6,
+	0x11, 0x74, 0x4e, 0xe2, 0xe9, 0xbd, 0x45, 0x38, 0x4d, 0xfd, 0x28, 0xa4, 0xc9, 0x43, 0x67, 0xe6,
+	0xf1, 0x60, 0xc3, 0xe6, 0x6f, 0xc2, 0x39, 0xf1, 0x79, 0xb2, 0x55, 0xc3, 0x05, 0x11, 0x47, 0xdf,
+	0xe6, 0x16, 0xb4, 0xfc, 0x64, 0x37, 0x5a, 0x84, 0xe9, 0x56, 0x1d, 0x59, 0xdb, 0xb6, 0x06, 0xad,
+	0x19, 0xb4, 0xf6, 0xfd, 0xd0, 0xf6, 0x1c, 0xd7, 0xfc, 0x36, 0xd4, 0xf4, 0x46, 0x3b, 0xb7, 0xb6,
+	0xe4, 0x38, 0xc9, 0xb6, 0xa2, 0x6e, 0x8f, 0xdc, 0x64, 0x18, 0xa6, 0xf1, 0xa5, 0x4d, 0x4c, 0x83,
+	0x3b, 0xd0, 0xd6, 0x08, 0x3a, 0xc0, 0x63, 0xef, 0x92, 0xf7, 0xd0, 0xb3, 0xe9, 0xd3, 0x7c, 0x15,
+	0x1a, 0x4f, 0xe9, 0x6c, 0xbc, 0xfb, 0xba, 0x2d, 0xc0, 0x47, 0xd5, 0x0f, 0x2b, 0xd6, 0x1f, 0xd5,
+	0xa0, 0xf1, 0xc3, 0x85, 0x87, 0xa3, 0x68, 0x9b, 0x69, 0x1a, 0xeb, 0xad, 0xd3, 0x37, 0x8d, 0x0b,
+	0x9c, 0x10, 0xf7, 0x5e, 0xe5, 0xbd, 0x0b, 0x60, 0xbe, 0x01, 0x86, 0x73, 0x96, 0x7a, 0xf1, 0x04,
+	0x65, 0x87, 0xa7, 0xaa, 0xa0, 0x18, 0xdb, 0x8c, 0x78, 0xe0, 0xbb, 0x24, 0x2b, 0x37, 0x9a, 0x4c,
+	0x8b, 0x47, 0x73, 0x23, 0x3e, 0x9a, 0xf9, 0x4d, 0x68, 0xe3, 0x88, 0x49, 0x80, 0x5a, 0xd8, 0x6a,
+	0x20, 0xa9, 0x73, 0xab, 0x9b, 0x1f, 0x2a, 0x49, 0xed, 0x16, 0x52, 0x59, 0x45, 0xdb, 0xd0, 0x4e,
+	0xe2, 0xe9, 0xe4, 0x0c, 0xa5, 0xba, 0xd5, 0x64, 0xc6, 0x2f, 0x69, 0xc6, 0x82, 0xb0, 0xed, 0x56,
+	0x22, 0x00, 0x49, 0x33, 0xf6, 0x9e, 0x7a, 0x71, 0xe2, 0x6d, 0xb5, 0x64, 0x49, 0x05, 0xe2, 0x4c,
+	0x9d, 0x33, 0x67, 0xea, 0xa5, 0x93, 0xb9, 0x13, 0x3b, 0xb3, 0xad, 0x36, 0x4f, 0xd6, 0xd3, 0x93,
+	0x1d, 0x13, 0xd2, 0x06, 0xe6, 0xe0, 0x6f, 0xf3, 0x03, 0xe8, 0x31, 0x94, 0x4c, 0xce, 0xfc, 0x00,
+	0x4f, 0xb4, 0x65, 0xf0, 0x08, 0x53, 0x8f, 0xb8, 0xc7, 0xd8, 0x71, 0xec, 0x79, 0x76, 0x57, 0x18,
+	0x05, 0x63, 0xbe, 0x4e, 0x5b, 0x70, 0xdc, 0x49, 0x9a, 0x6c, 0xf5, 0x58, 0xc6, 0x4d, 0x02, 0xc7,
+	0x09, 0x2a, 0xb1, 0x1d, 0xf8, 0xe1, 0x84, 0xa0, 0xad, 0x0d, 0x9e, 0x6c, 0x73, 0x49, 0x93, 0x76,
+	0x2b, 0x50, 0x0a, 0xbf, 0x0e, 0xcd, 0xd3, 0x85, 0x7b, 0xee, 0xa5, 0x5b, 0x9b, 0x32, 0x87, 0x40,
+	0xd6, 0x1d, 0x30, 0xd8, 0x34, 0x59, 0x38, 0xdf, 0x82, 0x26, 0xab, 0x4f, 0x1b, 0xc6, 0x2b, 0x7a,
+	0xba, 0xcc, 0x82, 0x6d, 0xc5, 0x60, 0xfd, 0x6b, 0x15, 0x9a, 0xb6, 0x97, 0x2c, 0x82, 0xd4, 0xfc,
+	0x0e, 0x00, 0xc9, 0x7e, 0xe6, 0xa4, 0xb1, 0x7f, 0xa1, 0x46, 0x96, 0xa5, 0x6f, 0x20, 0xfd, 0x80,
+	0xc9, 0xe6, 0x6d, 0xe8, 0xf2, 0x0c, 0x9a, 0xbd, 0x5a, 0x5e, 0x28, 0xdb, 0x8b, 0xdd, 0x61, 0x36,
+	0x35, 0x0a, 0x77, 0xcf, 0x6a, 0x17, 0x4b, 0xef, 0xd9, 0x0a, 0x32, 0x7f, 0x11, 0x36, 0xfc, 0x30,
+	0x25, 0x75, 0x4c, 0xd3, 0x89, 0xeb, 0x25, 0xda, 0x2e, 0x7a, 0x19, 0x76, 0x0f, 0x91, 0xe6, 0xf7,
+	0x40, 0x24, 0xaa, 0x17, 0x6d, 0xf0, 0xa2, 0xb9, 0xe4, 0x59, 0xda, 0xb2, 0x2a, 0xf3, 0xa9, 0x55,
+	0x5f, 0x46, 0xbe, 0x68, 0x9b, 0xe7, 0x71, 0xb4, 0x98, 0x4f, 0xd0, 0x6e, 0x37, 0xd9, 0x3b, 0x5a,
+	0x0c, 0x8f, 0x5c, 0xd2, 0x5f, 0x18, 0xb9, 0x1e, 0x51, 0xfa, 0x22, 0x7b, 0x02, 0x47, 0xac, 0x13,
+	0x67, 0x3a, 0xf5, 0x92, 0x64, 0xeb, 0x15, 0x76, 0x0c, 0x05, 0x59, 0xbf, 0x0e, 0x8d, 0xa3, 0xd8,
+	0x45, 0xcd, 0xaf, 0xf2, 0x1b, 0xc4, 0xe1, 0x41, 0xa7, 0xec, 0x6e, 0x6d, 0x9b, 0xbf, 0x73, 0x5f,
+	0xaa, 0x15, 0x7d, 0x09, 0xb1, 0x45, 0x5f, 0x11, 0xc0, 0xfa, 0xcb, 0x2a, 0x86, 0x95, 0x28, 0x4e,
+	0x0f, 0x70, 0x25, 0xe7, 0xdc, 0x33, 0xdf, 0x82, 0x46, 0x44, 0x8b, 0x29, 0xc5, 0x65, 0x06, 0xcc,
+	0x3b, 0xb0, 0x85, 0xb6, 0xa4, 0xe2, 0xea, 0x7a, 0x15, 0x67, 0xeb, 0xd6, 0x38, 0x9e, 0x09, 0x40,
+	0x87, 0x8d, 0xce, 0xce, 0x12, 0x4f, 0xb6, 0xd3, 0xb0, 0x15, 0xf4, 0x7f, 0x63, 0xdd, 0xdf, 0x40,
+	0xfb, 0x58, 0xc4, 0x49, 0x14, 0xb3, 0xec, 0x3b, 0xb7, 0x36, 0x34, 0xe7, 0x2e, 0x63, 0x6d, 0x45,
+	0x25, 0x7b, 0x89, 0xbd, 0x74, 0x11, 0x87, 0x13, 0x41, 0x24, 0xac, 0x11, 0xb4, 0x17, 0xc1, 0x0a,
+	0x37, 0x4b, 0x2e, 0x5c, 0x04, 0x41, 0xc2, 0xa1, 0x04, 0xe5, 0xc9, 0x80, 0xf5, 0xbb, 0x15, 0x00,
+	0x92, 0xdc, 0xff, 0xc6, 0xec, 0x5f, 0xe6, 0x30, 0x37, 0xa1, 0xa5, 0x77, 0xb7, 0xc9, 0xb3, 0x2e,
+	0x9f, 0x46, 0x93, 0xad, 0xfb, 0xd0, 0xb1, 0x31, 0x3a, 0xee, 0x46, 0x68, 0xed, 0x17, 0xa9, 0xb9,
+	0x01, 0x55, 0xb4, 0xb1, 0x0a, 0x47, 0x4d, 0xfc, 0xa2, 0x63, 0xb0, 0x0d, 0xb2, 0xad, 0xf4, 0x6c,
+	0x01, 0xd8, 0xa8, 0x5c, 0x37, 0x66, 0xed, 0x90, 0x51, 0xe1, 0xb7, 0xf5, 0xf7, 0x15, 0x68, 0x1e,
+	0x78, 0xb3, 0x53, 0x54, 0xf5, 0xf2, 0x24, 0x45, 0xc3, 0xae, 0x96, 0x0d, 0x7b, 0xc5, 0x4c, 0xa4,
+	0xe6, 0x00, 0x0f, 0x81, 0xf6, 0x24, 0x56, 0xa7, 0x20, 0x52, 0xb3, 0x33, 0x43, 0x17, 0xc5, 0xf3,
+	0x37, 0x84, 0xe0, 0xcc, 0xf6, 0xe8, 0xb4, 0x6f, 0x42, 0x27, 0x70, 0x92, 0x74, 0xb2, 0x98, 0xbb,
+	0x4e, 0xea, 0x71, 0x4c, 0xae, 0xdb, 0x40, 0xa8, 0x07, 0x8c, 0x41, 0x71, 0xf4, 0xa7, 0xc1, 0x82,
+	0xee, 0x04, 0x3f, 0x3c, 0x8b, 0x26, 0x51, 0x18, 0x5c, 0xb2, 0xa5, 0xb4, 0xed, 0x0d, 0xc1, 0x8f,
+	0x10, 0x7d, 0x84, 0x58, 0xeb, 0x77, 0xaa, 0xd0, 0xb8, 0xcf, 0x67, 0xbc, 0x0d, 0xad, 0x19, 0x1f,
+	0x47, 0x47, 0xb2, 0x81, 0x16, 0x21, 0xd3, 0xb7, 0xe5, 0xac, 0xea, 0x92, 0xd3, 0xac, 0x34, 0x2a,
+	0x75, 0x4e, 0x03, 0x8c, 0x05, 0xca, 0xc4, 0x97, 0x46, 0x8d, 0x85, 0xa8, 0x46, 0x29, 0xd6, 0xc1,
+	0xa7, 0xd0, 0x2d, 0x4e, 0x57, 0xbc, 0x22, 0xeb, 0x72, 0x45, 0xfe, 0x42, 0xf1, 0x8a, 0x2c, 0xa8,
+	0x53, 0x86, 0x15, 0xae, 0x4c, 0x9a, 0xab, 0xb8, 0x48, 0x71, 0x2e, 0x63, 0xfd, 0x5c, 0x32, 0xac,
+	0x78, 0xfd, 0xfe, 0x67, 0x05, 0xba, 0xbf, 0xe6, 0xc5, 0xd1, 0x71, 0x1c, 0xcd, 0xa3, 0x04, 0x53,
+	0x8d, 0x5c, 0xb3, 0x3d, 0xd6, 0x2c, 0x3a, 0x8d, 0x9c, 0xfc, 0x8a, 0x7d, 0x29, 0x2a, 0xf1, 0xc9,
+	0x59, 0x59, 0xd1, 0xcf, 0xae, 0xa9, 0xa8, 0xe6, 0x0d, 0x80, 0x99, 0x73, 0xb1, 0xef, 0x39, 0x09,
+	0x06, 0x37, 0x56, 0x3f, 0x2a, 0x32, 0xc7, 0x98, 0x03, 0x68, 0x23, 0x34, 0xbe, 0x08, 0xc7, 0xe2,
+	0x58, 0x75, 0x3b, 0x83, 0xcd, 0xaf, 0x80, 0x81, 0xdf, 0x64, 0xcc, 0x38, 0x54, 0x6c, 0x20, 0x47,
+	0xe0, 0xa1, 0x6b, 0xe9, 0x45, 0xc8, 0x17, 0x70, 0x21, 0x6c, 0xe3, 0x48, 0x65, 0xf9, 0x36, 0x91,
+	0xad, 0xbf, 0xae, 0xc1, 0xa6, 0xd2, 0xc4, 0x23, 0x7f, 0x7e, 0x92, 0x92, 0xf1, 0xe0, 0xf5, 0xcd,
+	0xe1, 0xc7, 0x8b, 0x95, 0x42, 0x34, 0x68, 0xfe, 0x00, 0x9a, 0x6c, 0xc7, 0x5a, 0xd7, 0x6f, 0x95,
+	0x4f, 0x9f, 0x4d, 0x21, 0xba, 0x57, 0x4a, 0x57, 0x43, 0xcc, 0x0f, 0xa1, 0xf1, 0x05, 0x8a, 0x56,
+	0x02, 0x6e, 0xe7, 0x96, 0x75, 0xd5, 0x58, 0x92, 0xbf, 0x1a, 0x2a, 0x03, 0xfe, 0x1f, 0x85, 0x74,
+	0x93, 0x02, 0xe9, 0x2c, 0x7a, 0xea, 0xb9, 0x28, 0xa8, 0xda, 0x0a, 0x7d, 0x6a, 0xf2, 0xe0, 0x13,
+	0xe8, 0x14, 0x0e, 0xb5, 0x22, 0xa7, 0x7b, 0xab, 0x6c, 0x64, 0xbd, 0x92, 0x1b, 0x14, 0xed, 0xf5,
+	0x13, 0x80, 0xfc, 0x88, 0x3f, 0x8f, 0xe5, 0x5b, 0x8f, 0x60, 0x13, 0x95, 0x19, 0x7a, 0x9c, 0x7e,
+	0x89, 0xee, 0x72, 0xfb, 0xac, 0xac, 0xb5, 0xcf, 0xb7, 0xa1, 0x91, 0xd0, 0x00, 0xb5, 0xc8, 0xeb,
+	0x57, 0x28, 0xc3, 0x16, 0x2e, 0xeb, 0xb7, 0x31, 0xd6, 0x89, 0xe5, 0x96, 0x62, 0x5b, 0xa5, 0x1c,
+	0xdb, 0x50, 0xd6, 0xf3, 0xd8, 0x73, 0xfd, 0xa9, 0x9e, 0xd8, 0xb0, 0x73, 0x04, 0x45, 0xd6, 0xb3,
+	0x28, 0x9e, 0x7a, 0xec, 0x11, 0x78, 0xb5, 0x32, 0x40, 0xc9, 0x2b, 0x5f, 0x65, 0x1c, 0xa2, 0x24,
+	0xfc, 0xb5, 0x09, 0x41, 0xc1, 0x89, 0x86, 0x24, 0x73, 0x4c, 0x2e, 0xd8, 0x8a, 0x6b, 0xb6, 0x00,
+	0xd6, 0x9f, 0x57, 0xa1, 0xbb, 0xe7, 0xc7, 0x78, 0x6c, 0xcf, 0x1d, 0x62, 0x42, 0x46, 0xf1, 0xd3,
+	0x0b, 0x53, 0x3f, 0xbd, 0x54, 0x21, 0x58, 0x41, 0x59, 0x2a, 0x50, 0x2d, 0xa7, 0xd0, 0x22, 0xdd,
+	0x1a, 0xd7, 0x13, 0x02, 0x98, 0x77, 0x00, 0x24, 0xc3, 0xe2, 0x9a, 0x82, 0xb6, 0xb1, 0x91, 0xcb,
+	0xe4, 0x38, 0x4a, 0x52, 0x3f, 0x3c, 0xa7, 0x3c, 0x8b, 0x6a, 0x0c, 0xdb, 0x60, 0x56, 0xfa, 0x54,
+	0x95, 0xc8, 0x82, 0xf3, 0x94, 0x06, 0xaf, 0xdd, 0x62, 0x78, 0xe4, 0x4a, 0x7e, 0x71, 0xea, 0x05,
+	0x6c, 0x74, 0x9c, 0x5f, 0x20, 0x40, 0x5b, 0xa2, 0x44, 0x83, 0x0f, 0x84, 0x5b, 0xa2, 0x6f, 0xcc,
+	0xc3, 0xab, 0xd1, 0x9c, 0x73, 0xe1, 0xc2, 0xa2, 0xc5, 0x03, 0x6e, 0x1f, 0xcd, 0x6d, 0x64, 0xc1,
+	0x9b, 0xb8, 0x29, 0x49, 0x2e, 0xa6, 0xc1, 0xa5, 0xbc, 0x83, 0x93, 0x31, 0x5b, 0x11, 0xad, 0xeb,
+	0x50, 0x3d, 0x9a, 0x9b, 0x2d, 0xa8, 0x9d, 0x0c, 0xc7, 0xfd, 0x6b, 0xf4, 0xb1, 0x37, 0xdc, 0xef,
+	0x57, 0xac, 0xdf, 0xab, 0x82, 0x71, 0xb0, 0x40, 0x7d, 0xa2, 0xb5, 0x24, 0xeb, 0xf4, 0x88, 0x24,
+	0x54, 0x7b, 0x9c, 0x4e, 0x38, 0xa8, 0x73, 0x04, 0x60, 0x98, 0x13, 0x8c, 0x86, 0x87, 0x3b, 0xd2,
+	0x4e, 0xfc, 0xea, 0xaa, 0xed, 0xda, 0xc2, 0x62, 0x7e, 0x17, 0x9a, 0xc9, 0xf4, 0x91, 0x37, 0x73,
+	0x50, 0xa0, 0x25, 0xe6, 0x13, 0xc6, 0xca, 0x55, 0x65, 0x2b, 0x1e, 0x8a, 0x3a, 0x7b, 0x18, 0x75,
+	0x77, 0x82, 0x40, 0x5d, 0x76, 0x1a, 0x44, 0x27, 0x6d, 0x90, 0x5a, 0x12, 0x94, 0x64, 0x29, 0x05,
+	0x25, 0x0d, 0xa8, 0x49, 0x84, 0x01, 0x2f, 0x23, 0xc3, 0x09, 0x9d, 0xe0, 0xf2, 0x0b, 0xba, 0xc4,
+	0xc4, 0xa1, 0xaf, 0x6b, 0xee, 0x1d, 0x45, 0x50, 0x23, 0x72, 0x46, 0xeb, 0x9b, 0x60, 0x7c, 0xe6,
+	0x5d, 0x72, 0x16, 0x9d, 0x60, 0x2c, 0xa9, 0x3e, 0x7e, 0xaa, 0x2e, 0x40, 0xd0, 0x63, 0x3f, 0x7b,
+	0x68, 0x23, 0xd6, 0xfa, 0xaf, 0x0a, 0xb4, 0xaf, 0xbc, 0x19, 0xde, 0xc1, 0x40, 0xa3, 0x85, 0xab,
+	0xbc, 0x2a, 0xcb, 0xd0, 0x33, 0xa9, 0xdb, 0x39, 0x8f, 0xf9, 0x3e, 0x74, 0x30, 0x02, 0x63, 0x69,
+	0xc6, 0xe1, 0x58, 0xdd, 0x13, 0xab, 0x02, 0x35, 0xa4, 0xd9, 0xb7, 0xda, 0x5e, 0x7d, 0xd5, 0xf6,
+	0x72, 0x9f, 0x6e, 0xbc, 0x88, 0x4f, 0xa3, 0xd9, 0x6d, 0x4e, 0x31, 0xd1, 0x08, 0x27, 0xb9, 0xcf,
+	0x8a, 0xa9, 0x6e, 0x30, 0xfa, 0x58, 0x63, 0xad, 0xdf, 0x80, 0xea, 0x67, 0x0f, 0x8b, 0x81, 0xaa,
+	0x2b, 0x81, 0x4a, 0x15, 0xe6, 0xd5, 0xbc, 0x30, 0xc7, 0x40, 0xbc, 0x48, 0xbc, 0xf8, 0xc0, 0x4b,
+	0x1d, 0xe5, 0x5f, 0x19, 0x4c, 0xfa, 0xa5, 0x1a, 0x10, 0x8f, 0xae, 0x22, 0xb8, 0x06, 0xad, 0xdb,
+	0x38, 0xff, 0xee, 0x8a, 0xf9, 0x31, 0x9c, 0xa4, 0xfe, 0x0c, 0x6b, 0x11, 0x67, 0x36, 0x57, 0x76,
+	0x98, 0x23, 0xac, 0x7b, 0x60, 0x70, 0x68, 0x45, 0xd5, 0xad, 0x35, 0xe6, 0x1b, 0x50, 0xc7, 0xc9,
+	0xf4, 0x8d, 0x95, 0xcb, 0x6c, 0xd7, 0x66, 0xbc, 0xf5, 0xdf, 0x35, 0x68, 0x29, 0x0f, 0xa7, 0x3d,
+	0x2c, 0xb2, 0x44, 0x8e, 0x3e, 0xcb, 0x95, 0x7a, 0x16, 0x2e, 0x6e, 0x15, 0x1a, 0x10, 0xb5, 0xf5,
+	0xc1, 0x42, 0x77, 0x26, 0xcc, 0x5f, 0x86, 0xee, 0x5c, 0x68, 0xc5, 0x20, 0xf3, 0xc6, 0xf2, 0x38,
+	0xf5, 0x9f, 0xc7, 0x76, 0xe6, 0x39, 0xc0, 0x97, 0x1c, 0xca, 0x11, 0x8d, 0xd7, 0x61, 0x05, 0xa3,
+	0x6c, 0x35, 0x7c, 0x45, 0xac, 0x79, 0xb1, 0x70, 0x41, 0x86, 0x8c, 0xe1, 0xa7, 0x2b, 0x86, 0x8c,
+	0x51, 0xa6, 0xe8, 0xfd, 0xbd, 0xb2, 0xf7, 0x63, 0xb0, 0x9e, 0x46, 0xb3, 0x99, 0xcf, 0xb4, 0x0d,
+	0xb9, 0x69, 0x05, 0x31, 0x4e, 0xac, 0x2f, 0xa0, 0xa5, 0x0e, 0x6d, 0x76, 0xd0, 0x97, 0x87, 0xf7,
+	0x76, 0x1e, 0xec, 0x53, 0xfc, 0x01, 0x68, 0xde, 0x1d, 0x1d, 0xee, 0xd8, 0x3f, 0xee, 0x57, 0x28,
+	0x16, 0x8d, 0x0e, 0xc7, 0xfd, 0xaa, 0x69, 0x40, 0xe3, 0xde, 0xfe, 0xd1, 0xce, 0xb8, 0x5f, 0x33,
+	0xdb, 0x50, 0xbf, 0x7b, 0x74, 0xb4, 0xdf, 0xaf, 0x9b, 0x5d, 0x68, 0xef, 0xed, 0x8c, 0x87, 0xe3,
+	0xd1, 0xc1, 0xb0, 0xdf, 0x20, 0xde, 0xfb, 0xc3, 0xa3, 0x7e, 0x93, 0x3e, 0x1e, 0x8c, 0xf6, 0xfa,
+	0x2d, 0xa2, 0x1f, 0xef, 0x9c, 0x9c, 0xfc, 0xe8, 0xc8, 0xde, 0xeb, 0xb7, 0x69, 0xde, 0x93, 0xb1,
+	0x3d, 0x3a, 0xbc, 0xdf, 0x37, 0xac, 0xf7, 0xa0, 0x53, 0x10, 0x1c, 0x8d, 0xb0, 0x87, 0xf7, 0x70,
+	0x6d, 0x5c, 0xe6, 0xe1, 0xce, 0xfe, 0x83, 0x21, 0x2e, 0xbd, 0x01, 0xc0, 0x9f, 0x93, 0xfd, 0x1d,
+	0x1c, 0x52, 0xb5, 0x7e, 0xab, 0x92, 0x8d, 0xe1, 0x3a, 0xfe, 0x3b, 0xd0, 0x56, 0xe2, 0xd6, 0xf9,
+	0xef, 0xe6, 0x92, 0x6e, 0xec, 0x8c, 0x81, 0x94, 0x81, 0x51, 0x6b, 0xfa, 0x38, 0x59, 0xcc, 0x94,
+	0x65, 0x64, 0xb0, 0xd4, 0xdd, 0x24, 0x13, 0x36, 0x8d, 0xba, 0xad, 0xa0, 0xac, 0xd1, 0x55, 0x67,
+	0x7e, 0x69, 0x74, 0xfd, 0x73, 0x05, 0xe5, 0x40, 0x6a, 0x58, 0x91, 0xb5, 0xae, 0x36, 0xbd, 0x77,
+	0x9f, 0x31, 0xbd, 0xd7, 0x4a, 0x6a, 0x7d, 0xd6, 0xf0, 0x70, 0x3f, 0x69, 0xf4, 0xd8, 0x0b, 0x13,
+	0x0e, 0x1b, 0x58, 0x31, 0x0b, 0xa4, 0xdd, 0x57, 0xca, 0x35, 0xfa, 0xb4, 0x76, 0x72, 0x0d, 0xe6,
+	0xc2, 0xbd, 0xa6, 0x95, 0x56, 0xc9, 0x95, 0x56, 0xcd, 0x94, 0x56, 0x2b, 0x29, 0xad, 0x6e, 0xdd,
+	0x81, 0x86, 0x74, 0x6e, 0xd0, 0x8a, 0x9c, 0x20, 0x98, 0xb0, 0xeb, 0x55, 0x24, 0x9e, 0x23, 0xcc,
+	0xce, 0x6a, 0x16, 0x3c, 0xd2, 0x50, 0x5e, 0xf8, 0x0e, 0x34, 0xa5, 0xa3, 0x50, 0xb0, 0xda, 0xca,
+	0xba, 0x4b, 0xee, 0x63, 0x80, 0xbc, 0x05, 0x81, 0xc1, 0xb7, 0xa3, 0xfa, 0x44, 0xdc, 0xcd, 0xaa,
+	0x94, 0x73, 0x39, 0x61, 0x54, 0x8d, 0x25, 0x1e, 0x60, 0xed, 0x41, 0x7b, 0x6d, 0x93, 0x50, 0xa9,
+	0xa3, 0x9a, 0xab, 0x63, 0x45, 0xdb, 0xd0, 0x8a, 0x71, 0x13, 0x59, 0x07, 0x4a, 0x39, 0x92, 0xcc,
+	0x42, 0x8e, 0xb4, 0x4d, 0x46, 0xe2, 0x07, 0x6e, 0xec, 0x85, 0x2a, 0xfa, 0xac, 0xea, 0x5b, 0x65,
+	0x3c, 0x98, 0xf8, 0xd5, 0xb9, 0xc5, 0x26, 0x37, 0x41, 0x3f, 0xe3, 0xd5, 0xfd, 0x35, 0xa6, 0x5a,
+	0x17, 0xd0, 0x93, 0xfb, 0xd3, 0xf6, 0x9e, 0x2c, 0xa8, 0x51, 0xb3, 0x36, 0xf6, 0x41, 0x16, 0xdc,
+	0xb5, 0xbc, 0x0b, 0x18, 0x32, 0x8d, 0x33, 0xdf, 0x0b, 0x5c, 0x7d, 0x2a, 0x05, 0x91, 0xe9, 0xc9,
+	0x8d, 0x2b, 0x16, 0x23, 0x80, 0xf5, 0x11, 0x74, 0xf5, 0xca, 0x5c, 0xcc, 0x7f, 0x3b, 0xbb, 0xdf,
+	0x2b, 0xe5, 0xd3, 0x09, 0xd7, 0x61, 0xe4, 0x66, 0xb7, 0xbb, 0xf5, 0x17, 0xd4, 0x07, 0xc8, 0xd0,
+	0xe5, 0x4c, 0xb1, 0xb2, 0x9c, 0x29, 0xa2, 0xa8, 0xb3, 0xde, 0x2e, 0x8a, 0x9a, 0xbe, 0x69, 0x4b,
+	0x7e, 0xe8, 0x7a, 0x17, 0x3a, 0x7b, 0x64, 0x80, 0xaf, 0x08, 0xb2, 0x66, 0xff, 0x0b, 0x2e, 0x9e,
+	0x69, 0xb3, 0x39, 0xa2, 0xd8, 0x87, 0x6c, 0x94, 0xfb, 0x90, 0x59, 0xbb, 0xa5, 0x59, 0x68, 0xf3,
+	0x70, 0x72, 0x46, 0xe6, 0x23, 0x4d, 0x4b, 0xfe, 0xb6, 0xfe, 0xae, 0xaa, 0x4f, 0xad, 0x4a, 0xeb,
+	0xf5, 0x5b, 0x2f, 0x27, 0x92, 0xd5, 0x17, 0x4e, 0x24, 0x7f, 0x09, 0x0c, 0x97, 0x53, 0x28, 0xff,
+	0xa9, 0xf6, 0xeb, 0x1b, 0xab, 0xd2, 0x25, 0x95, 0x68, 0x21, 0x97, 0x9d, 0x0f, 0x78, 0x8e, 0x18,
+	0xb2, 0xc3, 0x36, 0x56, 0x1d, 0xb6, 0x99, 0x1f, 0x96, 0xc2, 0x9a, 0x77, 0x31, 0x0f, 0xfc, 0xa9,
+	0xaf, 0x85, 0x90, 0xc1, 0xd6, 0xf7, 0xc1, 0xc8, 0xd6, 0x26, 0xf7, 0x3f, 0x3c, 0x3a, 0x1c, 0x4a,
+	0x84, 0x1d, 0x1d, 0xee, 0x0d, 0x7f, 0x15, 0xc3, 0x03, 0x46, 0x7d, 0x7b, 0xf8, 0x70, 0x68, 0x9f,
+	0x0c, 0x31, 0x40, 0x60, 0x00, 0xc1, 0xac, 0x73, 0x38, 0x1e, 0xf6, 0x6b, 0xd6, 0x8f, 0xa1, 0x7d,
+	0xe0, 0xcc, 0x9f, 0xa9, 0x77, 0xf2, 0x34, 0x62, 0xa1, 0xfa, 0x24, 0xea, 0xd2, 0xfd, 0x16, 0xb4,
+	0x54, 0xa4, 0x55, 0xbe, 0xf0, 0x4c, 0x24, 0xd6, 0x74, 0xeb, 0xab, 0x78, 0x79, 0x3b, 0x97, 0x41,
+	0xe4, 0x70, 0x67, 0x65, 0x8f, 0x2e, 0x47, 0x99, 0x9a, 0xbf, 0xad, 0x3f, 0xad, 0xc0, 0xab, 0x07,
+	0x58, 0xbf, 0x65, 0xc9, 0x8c, 0x66, 0x5e, 0xaf, 0xc5, 0x6f, 0xc0, 0x66, 0x12, 0x2d, 0xb0, 0x3c,
+	0x99, 0x2c, 0xb5, 0x71, 0x7a, 0x82, 0xbe, 0xaf, 0xfc, 0xcb, 0x82, 0x1e, 0x35, 0x50, 0x73, 0xae,
+	0x1a, 0x73, 0x75, 0x08, 0xa9, 0x79, 0xb2, 0xac, 0xac, 0xfe, 0x42, 0x95, 0xd6, 0x3f, 0x55, 0xa0,
+	0x37, 0xbc, 0x98, 0x47, 0x71, 0xaa, 0xb7, 0xfa, 0x1a, 0x34, 0x63, 0xef, 0x89, 0xf6, 0xee, 0xba,
+	0xdd, 0x40, 0x68, 0xb4, 0xb6, 0xc7, 0x74, 0x1b, 0x1d, 0x13, 0x27, 0x5b, 0x24, 0xca, 0x92, 0xbe,
+	0xa2, 0xd7, 0x2c, 0x4d, 0xbc, 0x7d, 0xc2, 0x3c, 0xb6, 0xe2, 0x2d, 0x36, 0x15, 0xeb, 0xc5, 0xa6,
+	0x22, 0xfa, 0x7d, 0x53, 0x58, 0x0b, 0x6a, 0x47, 0x5d, 0x9f, 0x3c, 0xd8, 0xdd, 0x1d, 0x9e, 0x9c,
+	0xa0, 0xe2, 0x7b, 0x68, 0x1a, 0x0f, 0x8e, 0xf7, 0x47, 0xbb, 0x78, 0x0f, 0x88, 0xea, 0xef, 0xed,
+	0x8c, 0xf6, 0x87, 0x7b, 0xa8, 0xfa, 0x3f, 0x44, 0xbf, 0xcf, 0x53, 0xd9, 0x52, 0x6e, 0x51, 0x59,
+	0x93, 0x5b, 0x54, 0xcb, 0xb9, 0x05, 0x79, 0xb2, 0x73, 0x8a, 0x5b, 0xf7, 0x5c, 0xe5, 0xff, 0x1a,
+	0xcc, 0x2e, 0x93, 0x7a, 0x7e, 0x99, 0x94, 0x1a, 0x87, 0xbd, 0xf5, 0x8d, 0x43, 0xeb, 0x6f, 0x31,
+	0x0d, 0x38, 0x8a, 0x1d, 0x4c, 0x79, 0xf7, 0xbc, 0x00, 0x53, 0xa9, 0x8f, 0xa8, 0xf9, 0x41, 0xab,
+	0xea, 0xfb, 0xe7, 0x6b, 0x79, 0x73, 0x37, 0xe3, 0xda, 0xde, 0x15, 0x16, 0xd5, 0xd5, 0x52, 0x03,
+	0xb8, 0x37, 0x4d, 0xdb, 0x92, 0x50, 0x8b, 0x02, 0x14, 0x88, 0xda, 0x75, 0x33, 0xe7, 0x62, 0x32,
+	0xf7, 0x42, 0x57, 0xdb, 0xb4, 0x34, 0x30, 0x8e, 0x05, 0x33, 0xc0, 0xc8, 0x5a, 0x9c, 0x71, 0x45,
+	0x53, 0xe0, 0xea, 0x17, 0xa3, 0x37, 0xa1, 0x47, 0x9d, 0x0e, 0x9d, 0x17, 0x73, 0x3e, 0xa7, 0x36,
+	0x5f, 0xb7, 0xf1, 0xcb, 0xfa, 0x17, 0xac, 0x5a, 0x76, 0x92, 0xc4, 0x3f, 0x0f, 0x51, 0x5c, 0xdb,
+	0x85, 0xd7, 0xb6, 0x42, 0xaf, 0x4e, 0xd3, 0xb7, 0x1f, 0xf8, 0xfa, 0x19, 0x8b, 0xf9, 0xb0, 0x86,
+	0x6b, 0xe9, 0x02, 0xa5, 0x7a, 0x65, 0x81, 0xa2, 0x59, 0x68, 0x97, 0x5e, 0x1c, 0x47, 0xba, 0xbb,
+	0x29, 0x00, 0x1d, 0x1f, 0x19, 0xdc, 0xc9, 0xdc, 0x49, 0x12, 0xcf, 0x55, 0x45, 0x3e, 0x10, 0xea,
+	0x98, 0x31, 0x83, 0x0f, 0xc0, 0xc8, 0xd6, 0x7d, 0x5e, 0x22, 0x64, 0x14, 0xcf, 0xfe, 0x3a, 0xd4,
+	0x0e, 0x31, 0xe3, 0x2a, 0xbc, 0x10, 0xd6, 0x25, 0x93, 0xf9, 0x18, 0x3a, 0xfa, 0x48, 0x23, 0x97,
+	0xcd, 0x87, 0xcd, 0x6c, 0xe4, 0x96, 0xac, 0x4e, 0xaa, 0x74, 0xd4, 0xc1, 0xc8, 0xd5, 0x72, 0x65,
+	0xc0, 0xfa, 0x9b, 0x2a, 0x34, 0x0e, 0x7f, 0xb8, 0x40, 0xe7, 0xa3, 0x91, 0x8b, 0xd3, 0x9f, 0x60,
+	0xd8, 0x53, 0x3b, 0xd2, 0xe0, 0x73, 0x9a, 0x1d, 0x68, 0xcd, 0x11, 0xf3, 0xe9, 0xa8, 0x60, 0xd8,
+	0x6d, 0x41, 0xe0, 0xa2, 0xef, 0x42, 0x57, 0x11, 0xe5, 0x5c, 0xf5, 0x72, 0xc7, 0x48, 0x1e, 0x8d,
+	0x3a, 0xc2, 0x22, 0x6f, 0xa0, 0x59, 0x82, 0xdf, 0x58, 0xd5, 0x4c, 0x68, 0x16, 0x9a, 0x09, 0x79,
+	0xfa, 0xd4, 0x5a, 0x97, 0xf4, 0xa3, 0x4e, 0xd4, 0x41, 0x70, 0x0f, 0x31, 0x37, 0x1f, 0x30, 0x35,
+	0x50, 0xa8, 0x87, 0x4e, 0x6c, 0x7e, 0x15, 0x20, 0xca, 0xe9, 0x86, 0x9c, 0x2f, 0xca, 0xc8, 0x78,
+	0x3e, 0xb9, 0xe7, 0x88, 0x0a, 0x72, 0x3e, 0x46, 0x20, 0xd1, 0xfa, 0x19, 0x8a, 0x4f, 0xf6, 0xfd,
+	0x75, 0xc0, 0x58, 0x78, 0xe6, 0x60, 0xb6, 0x30, 0xd1, 0x1a, 0x32, 0x3e, 0xb9, 0x66, 0x83, 0x42,
+	0x22, 0x13, 0x2e, 0x64, 0x9c, 0x5e, 0x62, 0x32, 0x32, 0xc9, 0x6a, 0x49, 0x64, 0x68, 0x33, 0xea,
+	0x21, 0xbf, 0xf5, 0xb6, 0xfc, 0x50, 0x46, 0x93, 0x18, 0x6b, 0x48, 0x6c, 0x22, 0x82, 0x48, 0x6f,
+	0x40, 0xfb, 0x34, 0x8a, 0x02, 0xa6, 0xb1, 0x51, 0x21, 0xad, 0x45, 0x18, 0x35, 0x2e, 0x49, 0xe3,
+	0x49, 0x96, 0xe1, 0xd2, 0x38, 0x44, 0x10, 0xe9, 0x4d, 0x00, 0x37, 0x5a, 0x9c, 0x06, 0x1e, 0x53,
+	0x49, 0x78, 0x15, 0xa4, 0x1a, 0x82, 0x53, 0x63, 0xcf, 0xbd, 0x88, 0xa9, 0x2d, 0xb5, 0xa1, 0x26,
+	0x22, 0xd4, 0x9a, 0x74, 0x0d, 0x33, 0xad, 0xad, 0x68, 0x2d, 0xc2, 0x10, 0xf1, 0x2d, 0xe8, 0xd2,
+	0x27, 0xd5, 0xa8, 0xcc, 0x60, 0x28, 0x86, 0x8e, 0xc6, 0x2a, 0x26, 0x72, 0x84, 0xcf, 0xa3, 0xd8,
+	0x65, 0x26, 0x50, 0xbb, 0xeb, 0x68, 0xac, 0xda, 0x01, 0xbd, 0x93, 0x10, 0xbd, 0x43, 0x86, 0x49,
+	0x3b, 0x40, 0x04, 0x92, 0xee, 0x36, 0xd8, 0xd8, 0xad, 0x3f, 0xa9, 0xe2, 0xa5, 0xaa, 0x7a, 0x09,
+	0x1c, 0x56, 0xbd, 0x74, 0xf2, 0x93, 0x04, 0x8b, 0x6b, 0xb9, 0xfe, 0x5a, 0x08, 0x7f, 0x8a, 0x20,
+	0x29, 0xda, 0xf5, 0x02, 0x0f, 0xb7, 0xcc, 0x54, 0xa9, 0x25, 0x40, 0x50, 0xcc, 0x80, 0x8a, 0xa6,
+	0xb1, 0xe1, 0x13, 0x34, 0xf7, 0x44, 0x55, 0xed, 0x06, 0x62, 0x0e, 0x19, 0x41, 0x64, 0x64, 0xd6,
+	0x64, 0xa9, 0x5d, 0x0c, 0xc4, 0x28, 0xf2, 0x9b, 0x50, 0xa3, 0xe7, 0x29, 0x28, 0xdb, 0x1a, 0xfb,
+	0x8e, 0x4d, 0x14, 0x62, 0x40, 0x6e, 0x3c, 0xc5, 0x2a, 0x06, 0xa4, 0xac, 0x2b, 0x37, 0x71, 0x6d,
+	0x75, 0x25, 0x84, 0xd1, 0xe7, 0x5c, 0x6f, 0xb6, 0x6d, 0x75, 0x49, 0x1c, 0x46, 0x9f, 0x93, 0x53,
+	0x3c, 0xa1, 0xa7, 0x72, 0x7e, 0xbf, 0x42, 0xa7, 0x78, 0xa2, 0xdf, 0xcd, 0x29, 0xb4, 0xf0, 0x23,
+	0x15, 0x3a, 0x05, 0x7d, 0x5b, 0x0b, 0x30, 0x8e, 0xe6, 0x5e, 0x2c, 0xc2, 0xba, 0x5e, 0x48, 0x5b,
+	0xf9, 0x05, 0x51, 0x35, 0xa0, 0xd0, 0xa4, 0xdd, 0x38, 0x9a, 0x4f, 0x0a, 0x2d, 0xc3, 0x36, 0x21,
+	0x76, 0xa8, 0x6d, 0x48, 0xcf, 0xe8, 0x4c, 0x0c, 0x02, 0x7d, 0x03, 0xb9, 0xaa, 0x3d, 0xa5, 0x83,
+	0xcb, 0x58, 0xdf, 0x9b, 0x1a, 0xb4, 0xfe, 0xa1, 0x8a, 0x19, 0x91, 0xca, 0xd2, 0xb3, 0xcd, 0x56,
+	0x8a, 0x9b, 0x7d, 0x1b, 0xea, 0xe8, 0x40, 0xba, 0x39, 0xf1, 0x65, 0x2d, 0x1e, 0x35, 0x08, 0x23,
+	0x81, 0x7e, 0x6f, 0x61, 0xb6, 0x75, 0xb2, 0x7a, 0x99, 0xc7, 0x32, 0xdc, 0x31, 0xa5, 0x75, 0x8e,
+	0x1f, 0xb2, 0xe8, 0xf0, 0x2c, 0x0a, 0x24, 0x89, 0x93, 0x9d, 0x46, 0x8b, 0x74, 0x32, 0x4b, 0xd4,
+	0xcb, 0xab, 0xa1, 0x30, 0x07, 0x09, 0xda, 0x6e, 0x2f, 0xe6, 0xdc, 0x7f, 0xa2, 0xde, 0xc5, 0x5f,
+	0x61, 0x8e, 0xae, 0x20, 0xef, 0x32, 0x8e, 0x1f, 0x2d, 0x17, 0xe9, 0x7c, 0x91, 0x6e, 0x99, 0x22,
+	0x5f, 0x81, 0x28, 0xca, 0x67, 0xe7, 0x79, 0xa9, 0x28, 0x1f, 0x42, 0x6b, 0x1f, 0x1d, 0x28, 0x9c,
+	0x5e, 0xd2, 0xfe, 0xe6, 0x38, 0x07, 0x35, 0x51, 0x42, 0x9d, 0x41, 0x18, 0x0a, 0x73, 0xc8, 0xfb,
+	0xc3, 0x33, 0xd3, 0x7b, 0xb0, 0xe2, 0x90, 0xa8, 0xde, 0xcd, 0x91, 0x87, 0x1c, 0xfa, 0x70, 0xae,
+	0xc8, 0x55, 0x2c, 0xea, 0x36, 0xd6, 0xa8, 0xc3, 0xc4, 0xfa, 0x29, 0xba, 0x16, 0x96, 0x38, 0xf3,
+	0x28, 0x4c, 0xb8, 0x16, 0x29, 0xb8, 0x15, 0x7f, 0x17, 0x0a, 0x9f, 0xea, 0xf3, 0x0a, 0x1f, 0xfd,
+	0x0c, 0x53, 0x5b, 0xfb, 0x0c, 0x43, 0x19, 0x6f, 0x20, 0x47, 0xe4, 0x46, 0x4c, 0x51, 0x79, 0x82,
+	0xb6, 0x35, 0x3d, 0xef, 0x86, 0xf6, 0x9e, 0xd7, 0x0d, 0xc5, 0xad, 0xa3, 0x56, 0x43, 0x36, 0x07,
+	0xdc, 0x3a, 0x7d, 0x63, 0xc6, 0xda, 0xa0, 0x87, 0x74, 0xfd, 0x4a, 0x9a, 0xbd, 0xbd, 0xf2, 0x9e,
+	0x85, 0x44, 0xba, 0x89, 0xdd, 0x33, 0xd6, 0x3e, 0xa6, 0xe8, 0xf8, 0x49, 0x98, 0x69, 0xf2, 0x94,
+	0xb5, 0x8d, 0x18, 0xfc, 0x44, 0xc3, 0xcd, 0xde, 0x5b, 0x4d, 0x9e, 0x29, 0xfb, 0x45, 0xc8, 0xdd,
+	0x20, 0x9a, 0x3e, 0x5e, 0x7e, 0x74, 0x6d, 0x41, 0x63, 0x97, 0xfa, 0x23, 0xd6, 0x1b, 0xd0, 0x7a,
+	0x28, 0x6d, 0x3f, 0x9a, 0x34, 0x75, 0xce, 0xb5, 0x09, 0xe0, 0xa7, 0xb5, 0x83, 0xb9, 0x62, 0x76,
+	0x0a, 0xf2, 0x47, 0x3a, 0xc7, 0xa4, 0x50, 0x9b, 0xb7, 0x09, 0x71, 0x48, 0xf5, 0x79, 0x5e, 0xb9,
+	0x56, 0x8b, 0x95, 0xab, 0xb5, 0x2b, 0x1d, 0x5a, 0x2f, 0x96, 0xf6, 0x3f, 0xee, 0x49, 0x57, 0xe4,
+	0xfc, 0x7d, 0xe5, 0x2b, 0x8d, 0xdc, 0xb9, 0x42, 0xb3, 0x7e, 0xb3, 0x82, 0x79, 0xae, 0x2a, 0x53,
+	0x29, 0x1a, 0xf8, 0xa7, 0x8b, 0xbc, 0x4a, 0xc8, 0x10, 0x78, 0x8d, 0xc3, 0x5c, 0xd6, 0xf2, 0x3d,
+	0xed, 0xc2, 0x59, 0xd5, 0xae, 0x77, 0x61, 0x17, 0x78, 0x50, 0x77, 0x79, 0x47, 0xa0, 0xb6, 0x42,
+	0x01, 0x19, 0xd5, 0x1a, 0x42, 0x53, 0x64, 0xf8, 0x12, 0xbf, 0x2f, 0x29, 0x56, 0x52, 0x75, 0xae,
+	0xa4, 0xac, 0x1f, 0x40, 0xa7, 0xa0, 0x0f, 0xf2, 0x31, 0x27, 0xf0, 0x9d, 0x44, 0x07, 0x21, 0x06,
+	0xb8, 0x61, 0x25, 0x3f, 0x04, 0x10, 0xd7, 0x53, 0x90, 0xf5, 0xb3, 0x0a, 0x6c, 0x94, 0xbb, 0xe6,
+	0x2b, 0x5b, 0x25, 0xa5, 0xe2, 0x53, 0x25, 0x42, 0x79, 0xf1, 0xf9, 0x75, 0xe8, 0x4e, 0x1f, 0x39,
+	0xb1, 0xfa, 0xfd, 0x8e, 0x6e, 0x34, 0x74, 0x08, 0x27, 0x4d, 0x10, 0xf6, 0x5a, 0xe6, 0xcf, 0x78,
+	0x24, 0x97, 0xef, 0x32, 0x52, 0x33, 0xd1, 0x35, 0x95, 0x62, 0x00, 0xa6, 0x1b, 0x32, 0xe1, 0x1f,
+	0xa3, 0xe0, 0x32, 0x84, 0xf9, 0x11, 0x21, 0xa8, 0x72, 0x4d, 0x2e, 0xc3, 0x28, 0xbc, 0x9c, 0xc9,
+	0x33, 0x01, 0xda, 0x8a, 0x86, 0xe5, 0x79, 0x70, 0x3e, 0xe7, 0xce, 0x5e, 0x4b, 0x68, 0x1a, 0xbe,
+	0xf5, 0x07, 0xa8, 0x6a, 0x7a, 0x0b, 0x44, 0x9f, 0xae, 0x0f, 0xa7, 0x8f, 0x22, 0x33, 0x2f, 0x35,
+	0xa5, 0x4a, 0x1a, 0x2c, 0x23, 0xac, 0x6b, 0xe6, 0x7b, 0xf2, 0x13, 0x02, 0xfd, 0x6b, 0x90, 0x17,
+	0x19, 0xf2, 0x3d, 0xe8, 0x7c, 0x1a, 0xf9, 0xe1, 0x6e, 0xb0, 0x48, 0xe8, 0x21, 0x35, 0xf3, 0x96,
+	0xc2, 0x4f, 0x11, 0x56, 0x0c, 0xbb, 0xf5, 0x67, 0x35, 0xa8, 0xd3, 0x63, 0x21, 0x3d, 0xb3, 0xab,
+	0xa7, 0x3e, 0x73, 0xe9, 0x49, 0x6f, 0x90, 0x55, 0x94, 0x4b, 0x6f, 0x81, 0xb8, 0xea, 0x1d, 0x68,
+	0x2a, 0xc5, 0x95, 0x9f, 0x23, 0x07, 0x57, 0x55, 0xa1, 0xd6, 0xb5, 0x9b, 0x95, 0x77, 0x2b, 0xe6,
+	0x2d, 0x68, 0x4a, 0xb5, 0xf3, 0xec, 0xd9, 0xbe, 0xb4, 0xa2, 0x1c, 0xb2, 0xae, 0xe1, 0x98, 0x77,
+	0xa0, 0x73, 0xf2, 0x28, 0x5a, 0x04, 0xee, 0x89, 0x17, 0x3f, 0xf5, 0xcc, 0xa5, 0x07, 0xef, 0xc1,
+	0x12, 0x8c, 0x9b, 0x43, 0xf7, 0x91, 0x1c, 0x9d, 0x72, 0x7f, 0xb3, 0x93, 0x39, 0xc2, 0x62, 0x96,
+	0x2f, 0x52, 0x48, 0xe2, 0x65, 0x44, 0xa1, 0xce, 0x79, 0x91, 0x11, 0xdf, 0x87, 0x9e, 0x14, 0x56,
+	0x47, 0xf1, 0x0e, 0xd5, 0x62, 0xe6, 0x8a, 0x08, 0x3c, 0x58, 0x81, 0xc3, 0xa1, 0x1f, 0x41, 0x7b,
+	0x1c, 0x5f, 0xca, 0xa8, 0xd7, 0x0a, 0x1c, 0xf9, 0x0e, 0x06, 0xab, 0xd1, 0xa8, 0xb6, 0x3f, 0xae,
+	0x43, 0x13, 0xed, 0xf2, 0x31, 0x6a, 0xfa, 0x3d, 0x68, 0x72, 0x9a, 0xe6, 0x99, 0xcf, 0xbe, 0x06,
+	0x5d, 0xb1, 0xf2, 0x9d, 0x17, 0xd9, 0xf4, 0x0a, 0x1b, 0xfb, 0x2e, 0x18, 0x2c, 0x7b, 0x0a, 0x0c,
+	0xb9, 0xc2, 0xf9, 0xd7, 0x84, 0xb9, 0xf8, 0xa5, 0x77, 0x87, 0xdc, 0x1f, 0xc3, 0xf5, 0xac, 0x2b,
+	0xb2, 0x13, 0xba, 0x72, 0x75, 0x51, 0xd3, 0x24, 0xdf, 0x68, 0xf6, 0xbe, 0x32, 0x28, 0x3c, 0x35,
+	0x29, 0x13, 0x79, 0x0f, 0xea, 0xf4, 0xbb, 0x9e, 0xdc, 0x92, 0x0b, 0xbf, 0x8f, 0xca, 0xcf, 0x95,
+	0xff, 0xf4, 0x07, 0x57, 0xfc, 0x00, 0x9a, 0xb2, 0x4a, 0x2e, 0xcf, 0x52, 0x27, 0x73, 0xf0, 0xea,
+	0x32, 0x5a, 0x0d, 0xc4, 0xdc, 0xe6, 0xc0, 0x0f, 0xe5, 0x3d, 0xff, 0x19, 0x83, 0x2c, 0x9a, 0x01,
+	0xf2, 0x7e, 0x08, 0x4d, 0xe9, 0x72, 0xe4, 0x8b, 0x94, 0xba, 0x1e, 0x83, 0xd5, 0x68, 0x1c, 0xf9,
+	0x3e, 0xf4, 0x6d, 0x6f, 0xea, 0xf9, 0x85, 0x6e, 0x91, 0x59, 0x38, 0xf7, 0x0a, 0x89, 0xdf, 0xac,
+	0x98, 0xbf, 0x02, 0xbd, 0x52, 0x7f, 0xc9, 0xcc, 0x7a, 0x2d, 0xab, 0xda, 0x4e, 0xab, 0x5c, 0xfc,
+	0xaf, 0xaa, 0xd0, 0xdc, 0x3b, 0x8f, 0x9d, 0xf9, 0x23, 0x54, 0xa0, 0xfa, 0xed, 0xe7, 0xe6, 0x52,
+	0x22, 0x38, 0xe8, 0x17, 0xd4, 0xc7, 0x79, 0x09, 0xee, 0x77, 0x3b, 0xb3, 0xac, 0xfe, 0xb2, 0x65,
+	0xe5, 0xfc, 0xda, 0x1d, 0x90, 0xff, 0x6d, 0x68, 0xec, 0xf0, 0x6f, 0x23, 0x33, 0xfd, 0x66, 0x39,
+	0xf1, 0x2a, 0x6b, 0xfa, 0x39, 0x5c, 0x07, 0xeb, 0x5b, 0xbe, 0xed, 0xf5, 0x4d, 0x9f, 0xd9, 0x22,
+	0x63, 0xf3, 0xc5, 0x14, 0x1d, 0x47, 0xdc, 0x86, 0x0e, 0x9f, 0xfc, 0x24, 0xc5, 0x04, 0x76, 0xf6,
+	0x42, 0xe7, 0x7f, 0xb7, 0x72, 0xf7, 0xe6, 0x3f, 0xfe, 0xfb, 0x8d, 0xca, 0x4f, 0xf1, 0xef, 0xdf,
+	0xf0, 0xef, 0xf7, 0xff, 0xe3, 0xc6, 0x35, 0x30, 0xfc, 0x68, 0xdb, 0x65, 0x61, 0xde, 0xed, 0x88,
+	0x50, 0x8f, 0x69, 0xdc, 0xa9, 0xfc, 0xe8, 0xf8, 0xfd, 0xff, 0x01, 0x22, 0x4a, 0x06, 0xa2, 0x89,
+	0x2c, 0x00, 0x00,
 }
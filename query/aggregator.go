@@ -19,7 +19,10 @@ package query
 
 import (
 	"bytes"
+	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos"
@@ -31,6 +34,46 @@ type aggregator struct {
 	name   string
 	result types.Val
 	count  int // used when we need avergae.
+
+	// The aggregators which need every value collect them before computing the result.
+	vals       []float64
+	distinct   map[string]struct{}
+	percentile float64
+}
+
+// newAggregator returns the aggregator for an aggregate function.
+func newAggregator(f *Function) aggregator {
+	ag := aggregator{name: f.Name}
+	switch f.Name {
+	case "median":
+		ag.percentile = 50
+	case "percentile":
+		if len(f.Args) > 0 {
+			// The percentile was validated by the parser.
+			ag.percentile, _ = strconv.ParseFloat(f.Args[0].Value, 64)
+		}
+	}
+	return ag
+}
+
+// aggFieldName returns the name of the result of an aggregate function over arg.
+func aggFieldName(f *Function, arg string) string {
+	switch {
+	case f.Name == "distinct":
+		return fmt.Sprintf("count(distinct %s)", arg)
+	case f.Name == "percentile" && len(f.Args) > 0:
+		return fmt.Sprintf("percentile(%s, %s)", arg, f.Args[0].Value)
+	}
+	return fmt.Sprintf("%s(%s)", f.Name, arg)
+}
+
+// collects returns whether the aggregator needs every value to compute its result.
+func (ag *aggregator) collects() bool {
+	switch ag.name {
+	case "median", "percentile", "stddev", "variance", "distinct":
+		return true
+	}
+	return false
 }
 
 func isUnary(f string) bool {
@@ -255,6 +298,10 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 }
 
 func (ag *aggregator) Apply(val types.Val) {
+	if ag.collects() {
+		ag.collect(val)
+		return
+	}
 	if ag.result.Value == nil {
 		ag.result = val
 		ag.count++
@@ -295,8 +342,71 @@ func (ag *aggregator) Apply(val types.Val) {
 	ag.result = res
 }
 
+// collect keeps a value for the aggregators which need all of them. The statistics skip
+// the values which aren't numbers.
+func (ag *aggregator) collect(val types.Val) {
+	if ag.name == "distinct" {
+		key := types.ValueForType(types.StringID)
+		if err := types.Marshal(val, &key); err != nil {
+			return
+		}
+		if ag.distinct == nil {
+			ag.distinct = make(map[string]struct{})
+		}
+		ag.distinct[key.Value.(string)] = struct{}{}
+		return
+	}
+	switch val.Tid {
+	case types.IntID:
+		ag.vals = append(ag.vals, float64(val.Value.(int64)))
+	case types.FloatID:
+		ag.vals = append(ag.vals, val.Value.(float64))
+	}
+}
+
+// computeCollected sets the result of the aggregators which collect the values.
+func (ag *aggregator) computeCollected() {
+	if !ag.collects() || ag.result.Value != nil {
+		return
+	}
+	if ag.name == "distinct" {
+		ag.result = types.Val{Tid: types.IntID, Value: int64(len(ag.distinct))}
+		return
+	}
+	if len(ag.vals) == 0 {
+		return
+	}
+
+	var res float64
+	switch ag.name {
+	case "median", "percentile":
+		sort.Float64s(ag.vals)
+		// Interpolate between the closest ranks.
+		rank := ag.percentile / 100 * float64(len(ag.vals)-1)
+		lo := int(math.Floor(rank))
+		hi := int(math.Ceil(rank))
+		res = ag.vals[lo] + (rank-float64(lo))*(ag.vals[hi]-ag.vals[lo])
+	case "stddev", "variance":
+		var mean float64
+		for _, v := range ag.vals {
+			mean += v
+		}
+		mean /= float64(len(ag.vals))
+		for _, v := range ag.vals {
+			res += (v - mean) * (v - mean)
+		}
+		// This is the variance of the population of values.
+		res /= float64(len(ag.vals))
+		if ag.name == "stddev" {
+			res = math.Sqrt(res)
+		}
+	}
+	ag.result = types.Val{Tid: types.FloatID, Value: res}
+}
+
 func (ag *aggregator) ValueMarshalled() (*protos.TaskValue, error) {
 	data := types.ValueForType(types.BinaryID)
+	ag.computeCollected()
 	ag.divideByCount()
 	res := &protos.TaskValue{ValType: int32(ag.result.Tid), Val: x.Nilbyte}
 	if ag.result.Value == nil {
@@ -327,6 +437,7 @@ func (ag *aggregator) divideByCount() {
 }
 
 func (ag *aggregator) Value() (types.Val, error) {
+	ag.computeCollected()
 	if ag.result.Value == nil {
 		return ag.result, ErrEmptyVal
 	}
@@ -0,0 +1,711 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/trace"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/dgraph/y"
+)
+
+// analytic is a graph algorithm which scores the uids of the parent of the subgraph,
+// using the edges of attrs between them. The scores are stored in uidToVal, so they can
+// be used like any other value variable.
+type analytic struct {
+	name       string
+	attrs      []string
+	iterations int
+	damping    float64
+	maxNodes   int
+	// fn is the function of the block that distance, score and highlight are computed
+	// against.
+	fn        *gql.Function
+	highlight tok.HighlightOptions
+}
+
+// blockFuncs has, for the analytics computed against a function of their block, the
+// functions they can use.
+var blockFuncs = map[string][]string{
+	"distance":  {"match"},
+	"score":     {"alloftext", "anyoftext"},
+	"highlight": {"alloftext", "anyoftext", "allofterms", "anyofterms"},
+}
+
+// analyticArgs has the arguments accepted by every analytics function, besides maxnodes
+// which they all accept.
+var analyticArgs = map[string][]string{
+	"pagerank":    {"iterations", "damping"},
+	"communities": {"iterations"},
+	"highlight":   {"pre", "post", "snippet"},
+}
+
+// maxIterations is the most iterations pagerank and communities can be asked to run.
+const maxIterations = 1000
+
+func newAnalytic(a *gql.Analytic) (*analytic, error) {
+	res := &analytic{
+		name:       a.Name,
+		attrs:      a.Attrs,
+		iterations: 20,
+		damping:    0.85,
+		maxNodes:   100000,
+	}
+	if a.Name == "highlight" {
+		res.highlight = tok.HighlightOptions{Pre: "<em>", Post: "</em>"}
+	}
+	for k, v := range a.Args {
+		valid := k == "maxnodes"
+		for _, arg := range analyticArgs[a.Name] {
+			valid = valid || arg == k
+		}
+		if !valid {
+			return nil, x.Errorf("Invalid argument %s for %s", k, a.Name)
+		}
+		switch k {
+		case "iterations", "maxnodes", "snippet":
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return nil, x.Errorf("%s should be a positive integer. Got: %s", k, v)
+			}
+			switch k {
+			case "iterations":
+				if n > maxIterations {
+					return nil, x.Errorf("iterations should be at most %d. Got: %d",
+						maxIterations, n)
+				}
+				res.iterations = n
+			case "maxnodes":
+				res.maxNodes = n
+			default:
+				res.highlight.Snippet = n
+			}
+		case "pre", "post":
+			s, err := strconv.Unquote(v)
+			if err != nil {
+				return nil, x.Errorf("%s should be a quoted string. Got: %s", k, v)
+			}
+			if k == "pre" {
+				res.highlight.Pre = s
+			} else {
+				res.highlight.Post = s
+			}
+		case "damping":
+			d, err := strconv.ParseFloat(v, 64)
+			if err != nil || d <= 0 || d >= 1 {
+				return nil, x.Errorf("damping should be between 0 and 1. Got: %s", v)
+			}
+			res.damping = d
+		}
+	}
+	if _, ok := blockFuncs[a.Name]; ok && len(a.Attrs) != 1 {
+		return nil, x.Errorf("%s takes a single predicate. Got: %v", a.Name, a.Attrs)
+	}
+	return res, nil
+}
+
+// blockFunc returns the first function of the block on attr with one of the names, at its
+// root or in its filter.
+func blockFunc(gq *gql.GraphQuery, attr string, names []string) *gql.Function {
+	if isBlockFunc(gq.Func, attr, names) {
+		return gq.Func
+	}
+	return filterBlockFunc(gq.Filter, attr, names)
+}
+
+func filterBlockFunc(ft *gql.FilterTree, attr string, names []string) *gql.Function {
+	// The uids of the block don't match the functions under a not.
+	if ft == nil || ft.Op == "not" {
+		return nil
+	}
+	if isBlockFunc(ft.Func, attr, names) {
+		return ft.Func
+	}
+	for _, child := range ft.Child {
+		if f := filterBlockFunc(child, attr, names); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+func isBlockFunc(f *gql.Function, attr string, names []string) bool {
+	if f == nil || f.Attr != attr {
+		return false
+	}
+	for _, name := range names {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNodes returns an error if the analytic would run over more nodes than allowed.
+func (a *analytic) checkNodes(n int) error {
+	if n > a.maxNodes {
+		return x.Errorf("%s runs over more than %d nodes. Use maxnodes to raise the limit",
+			a.name, a.maxNodes)
+	}
+	return nil
+}
+
+// traverses returns true if the analytic runs over all the uids reachable from the
+// ones of the block, instead of only over the uids of the block.
+func (a *analytic) traverses() bool {
+	return a.name == "components" || a.name == "communities"
+}
+
+// hasAnalytics returns true if any node of the query uses an analytics function.
+func hasAnalytics(gq *gql.GraphQuery) bool {
+	if gq.Analytic != nil {
+		return true
+	}
+	for _, child := range gq.Children {
+		if hasAnalytics(child) {
+			return true
+		}
+	}
+	return false
+}
+
+func processAnalytic(ctx context.Context, sg *SubGraph, rch chan error) {
+	start := time.Now()
+	err := sg.runAnalytic(ctx)
+	sg.stats.task = time.Since(start)
+	if err != nil {
+		if tr, ok := trace.FromContext(ctx); ok {
+			tr.LazyPrintf("Error while running %s: %+v", sg.analytic.name, err)
+		}
+	}
+	rch <- err
+}
+
+func (sg *SubGraph) runAnalytic(ctx context.Context) error {
+	sg.Params.uidToVal = make(map[uint64]types.Val)
+	if sg.SrcUIDs == nil || len(sg.SrcUIDs.Uids) == 0 {
+		return nil
+	}
+	if err := sg.analytic.checkNodes(len(sg.SrcUIDs.Uids)); err != nil {
+		return err
+	}
+	switch sg.analytic.name {
+	case "distance":
+		return sg.matchDistances(ctx)
+	case "score":
+		return sg.textScores(ctx)
+	case "highlight":
+		return sg.highlights(ctx)
+	}
+	// The uids of the parent aren't sorted by uid if it was ordered.
+	nodes := make([]uint64, len(sg.SrcUIDs.Uids))
+	copy(nodes, sg.SrcUIDs.Uids)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	ul := &protos.List{Uids: nodes}
+
+	var matrix []*protos.List
+	var err error
+	if sg.analytic.traverses() {
+		ul, matrix, err = sg.traverse(ctx, ul)
+	} else {
+		matrix, err = sg.fetchEdges(ctx, ul)
+	}
+	if err != nil {
+		return err
+	}
+	nodes = ul.Uids
+	// Only the edges between the uids are considered.
+	adj := make([][]int, len(nodes))
+	for i, l := range matrix {
+		if l == nil {
+			continue
+		}
+		for _, uid := range l.Uids {
+			if j := algo.IndexOf(ul, uid); j >= 0 {
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+
+	switch sg.analytic.name {
+	case "pagerank":
+		ranks, err := pageRank(ctx, adj, sg.analytic.iterations, sg.analytic.damping)
+		if err != nil {
+			return err
+		}
+		for i, r := range ranks {
+			sg.Params.uidToVal[nodes[i]] = types.Val{Tid: types.FloatID, Value: r}
+		}
+	case "degree":
+		for i, d := range degrees(adj) {
+			sg.Params.uidToVal[nodes[i]] = types.Val{Tid: types.IntID, Value: int64(d)}
+		}
+	case "betweenness":
+		cb, err := betweenness(ctx, adj)
+		if err != nil {
+			return err
+		}
+		for i, b := range cb {
+			sg.Params.uidToVal[nodes[i]] = types.Val{Tid: types.FloatID, Value: b}
+		}
+	case "components":
+		for i, c := range components(undirected(adj)) {
+			sg.Params.uidToVal[nodes[i]] = types.Val{Tid: types.UidID, Value: nodes[c]}
+		}
+	case "communities":
+		labels, err := communities(ctx, undirected(adj), sg.analytic.iterations)
+		if err != nil {
+			return err
+		}
+		for i, c := range labels {
+			sg.Params.uidToVal[nodes[i]] = types.Val{Tid: types.UidID, Value: nodes[c]}
+		}
+	default:
+		return x.Errorf("Unknown analytics function %s", sg.analytic.name)
+	}
+	return nil
+}
+
+// fetchEdges returns the uids that the given uids point to with any of the predicates
+// of the analytic, which can be reverse predicates.
+func (sg *SubGraph) fetchEdges(ctx context.Context, uids *protos.List) ([]*protos.List, error) {
+	matrix := make([]*protos.List, len(uids.Uids))
+	linRead := &protos.LinRead{}
+	for _, attr := range sg.analytic.attrs {
+		temp := &SubGraph{
+			Attr:    attr,
+			SrcUIDs: uids,
+			ReadTs:  sg.ReadTs,
+			LinRead: sg.LinRead,
+		}
+		taskQuery, err := createTaskQuery(temp)
+		if err != nil {
+			return nil, err
+		}
+		result, err := processTask(ctx, taskQuery)
+		if err != nil {
+			return nil, err
+		}
+		sg.stats.fromResult(result)
+		y.MergeLinReads(linRead, result.LinRead)
+		mergeEdges(matrix, result.UidMatrix)
+	}
+	sg.LinRead = linRead
+	return matrix, nil
+}
+
+// fetchStrings returns the values of the uids of the parent for the predicate of the
+// analytic, in the language of its function, as strings.
+func (sg *SubGraph) fetchStrings(ctx context.Context) (map[uint64]string, error) {
+	temp := &SubGraph{
+		Attr:    sg.analytic.attrs[0],
+		SrcUIDs: sg.SrcUIDs,
+		ReadTs:  sg.ReadTs,
+		LinRead: sg.LinRead,
+	}
+	if sg.analytic.fn.Lang != "" {
+		temp.Params.Langs = []string{sg.analytic.fn.Lang}
+	}
+	taskQuery, err := createTaskQuery(temp)
+	if err != nil {
+		return nil, err
+	}
+	result, err := processTask(ctx, taskQuery)
+	if err != nil {
+		return nil, err
+	}
+	sg.stats.fromResult(result)
+	sg.LinRead = result.LinRead
+
+	strs := make(map[uint64]string)
+	for i, uid := range sg.SrcUIDs.Uids {
+		if len(result.ValueMatrix[i].Values) == 0 {
+			continue
+		}
+		v, err := convertWithBestEffort(result.ValueMatrix[i].Values[0], temp.Attr)
+		if err != nil {
+			continue
+		}
+		sv, err := types.Convert(v, types.StringID)
+		if err != nil {
+			continue
+		}
+		strs[uid] = sv.Value.(string)
+	}
+	return strs, nil
+}
+
+// matchDistances scores the uids of the parent by the edit distance between their value
+// and the string of the match function.
+func (sg *SubGraph) matchDistances(ctx context.Context) error {
+	strs, err := sg.fetchStrings(ctx)
+	if err != nil {
+		return err
+	}
+	str := sg.analytic.fn.Args[0].Value
+	for uid, s := range strs {
+		d := worker.LevenshteinDistance(s, str, math.MaxInt32)
+		sg.Params.uidToVal[uid] = types.Val{Tid: types.IntID, Value: int64(d)}
+	}
+	return nil
+}
+
+// textScores scores the uids of the parent by how well their value matches the terms of
+// the full-text function, with BM25. The scores are computed by the worker serving the
+// predicate, from the statistics kept in the full-text index.
+func (sg *SubGraph) textScores(ctx context.Context) error {
+	fn := sg.analytic.fn
+	temp := &SubGraph{
+		Attr:    sg.analytic.attrs[0],
+		SrcUIDs: sg.SrcUIDs,
+		SrcFunc: &Function{Name: "score", Args: fn.Args},
+		ReadTs:  sg.ReadTs,
+		LinRead: sg.LinRead,
+	}
+	if fn.Lang != "" {
+		temp.Params.Langs = []string{fn.Lang}
+	}
+	taskQuery, err := createTaskQuery(temp)
+	if err != nil {
+		return err
+	}
+	result, err := processTask(ctx, taskQuery)
+	if err != nil {
+		return err
+	}
+	sg.stats.fromResult(result)
+	sg.LinRead = result.LinRead
+
+	for i, uid := range sg.SrcUIDs.Uids {
+		if i >= len(result.ValueMatrix) || len(result.ValueMatrix[i].Values) == 0 {
+			continue
+		}
+		v, err := convertWithBestEffort(result.ValueMatrix[i].Values[0], temp.Attr)
+		if err != nil {
+			continue
+		}
+		sg.Params.uidToVal[uid] = v
+	}
+	return nil
+}
+
+// highlights gives the values of the uids of the parent with the words matching the
+// function marked. They are analyzed like the function analyzes its text, by the full-text
+// analyzer of the predicate or of its language, or by the term one.
+func (sg *SubGraph) highlights(ctx context.Context) error {
+	fn := sg.analytic.fn
+	var t tok.Tokenizer = tok.TermTokenizer{}
+	if strings.HasSuffix(fn.Name, "text") {
+		lang := fn.Lang
+		if lang == "." {
+			lang = "en"
+		}
+		name := tok.FullTextTokenizer{
+			Lang:     lang,
+			Analyzer: schema.State().TextAnalyzer(fn.Attr),
+		}.Name()
+		var ok bool
+		if t, ok = tok.GetTokenizer(name); !ok {
+			return x.Errorf("Tokenizer not found for %s", name)
+		}
+	}
+	strs, err := sg.fetchStrings(ctx)
+	if err != nil {
+		return err
+	}
+	for uid, s := range strs {
+		h, err := tok.Highlight(t, s, fn.Args[0].Value, sg.analytic.highlight)
+		if err != nil {
+			return err
+		}
+		sg.Params.uidToVal[uid] = types.Val{Tid: types.StringID, Value: h}
+	}
+	return nil
+}
+
+// mergeEdges adds the uids of every list of src to the list at the same index of dst.
+func mergeEdges(dst, src []*protos.List) {
+	for i, l := range src {
+		if dst[i] == nil {
+			dst[i] = l
+			continue
+		}
+		dst[i] = algo.MergeSorted([]*protos.List{dst[i], l})
+	}
+}
+
+// traverse expands the given uids level by level over the predicates of the analytic,
+// until no new uids are found. It returns all the uids reached along with the uids that
+// each of them points to.
+func (sg *SubGraph) traverse(ctx context.Context,
+	uids *protos.List) (*protos.List, []*protos.List, error) {
+	seen := uids
+	edges := make(map[uint64]*protos.List)
+	linRead := &protos.LinRead{}
+	for frontier := uids; len(frontier.Uids) > 0; {
+		if err := sg.analytic.checkNodes(len(seen.Uids)); err != nil {
+			return nil, nil, err
+		}
+		exec := make([]*SubGraph, 0, len(sg.analytic.attrs))
+		for _, attr := range sg.analytic.attrs {
+			exec = append(exec, &SubGraph{
+				Attr:    attr,
+				SrcUIDs: frontier,
+				ReadTs:  sg.ReadTs,
+				LinRead: sg.LinRead,
+			})
+		}
+		if err := expandLevel(ctx, exec); err != nil {
+			return nil, nil, err
+		}
+
+		lists := make([]*protos.List, 0, len(exec))
+		for _, e := range exec {
+			for i, ul := range e.uidMatrix {
+				uid := e.SrcUIDs.Uids[i]
+				if prev, ok := edges[uid]; ok {
+					ul = algo.MergeSorted([]*protos.List{prev, ul})
+				}
+				edges[uid] = ul
+			}
+			lists = append(lists, e.DestUIDs)
+			y.MergeLinReads(linRead, e.LinRead)
+		}
+		reached := algo.MergeSorted(lists)
+		frontier = algo.Difference(reached, seen)
+		seen = algo.MergeSorted([]*protos.List{seen, frontier})
+	}
+	sg.LinRead = linRead
+
+	matrix := make([]*protos.List, len(seen.Uids))
+	for i, uid := range seen.Uids {
+		if ul, ok := edges[uid]; ok {
+			matrix[i] = ul
+		} else {
+			matrix[i] = &protos.List{}
+		}
+	}
+	return seen, matrix, nil
+}
+
+// pageRank returns the PageRank of the nodes of the graph given by its adjacency lists.
+// The rank of the nodes without outgoing edges is shared by all the nodes. It stops with
+// the error of the context if it's done before the iterations are.
+func pageRank(ctx context.Context, adj [][]int, iterations int,
+	damping float64) ([]float64, error) {
+	n := float64(len(adj))
+	rank := make([]float64, len(adj))
+	next := make([]float64, len(adj))
+	for i := range rank {
+		rank[i] = 1 / n
+	}
+	for it := 0; it < iterations; it++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var dangling float64
+		for i := range next {
+			next[i] = 0
+		}
+		for u, out := range adj {
+			if len(out) == 0 {
+				dangling += rank[u]
+				continue
+			}
+			share := rank[u] / float64(len(out))
+			for _, v := range out {
+				next[v] += share
+			}
+		}
+		base := (1-damping)/n + damping*dangling/n
+		for i := range next {
+			next[i] = base + damping*next[i]
+		}
+		rank, next = next, rank
+	}
+	return rank, nil
+}
+
+// degrees returns the number of incoming and outgoing edges of every node.
+func degrees(adj [][]int) []int {
+	deg := make([]int, len(adj))
+	for u, out := range adj {
+		deg[u] += len(out)
+		for _, v := range out {
+			deg[v]++
+		}
+	}
+	return deg
+}
+
+// betweenness returns the betweenness centrality of the nodes, that is the number of
+// shortest paths between other nodes going through them. It uses Brandes' algorithm, and
+// stops with the error of the context if it's done before every node has been a source.
+func betweenness(ctx context.Context, adj [][]int) ([]float64, error) {
+	n := len(adj)
+	cb := make([]float64, n)
+	sigma := make([]float64, n)
+	dist := make([]int, n)
+	delta := make([]float64, n)
+	preds := make([][]int, n)
+	for s := 0; s < n; s++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for i := 0; i < n; i++ {
+			sigma[i], dist[i], delta[i] = 0, -1, 0
+			preds[i] = preds[i][:0]
+		}
+		sigma[s], dist[s] = 1, 0
+
+		// Count the shortest paths from s with a breadth first search.
+		var stack []int
+		queue := []int{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			stack = append(stack, v)
+			for _, w := range adj[v] {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+
+		// Accumulate the dependencies in the order of decreasing distance.
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				cb[w] += delta[w]
+			}
+		}
+	}
+	return cb, nil
+}
+
+// undirected returns the adjacency lists of the graph with the edges in both directions.
+func undirected(adj [][]int) [][]int {
+	out := make([][]int, len(adj))
+	for u, vs := range adj {
+		for _, v := range vs {
+			if u == v {
+				continue
+			}
+			out[u] = append(out[u], v)
+			out[v] = append(out[v], u)
+		}
+	}
+	return out
+}
+
+// components returns, for every node of an undirected graph, the smallest node of its
+// connected component.
+func components(adj [][]int) []int {
+	comp := make([]int, len(adj))
+	for i := range comp {
+		comp[i] = -1
+	}
+	for s := range adj {
+		if comp[s] >= 0 {
+			continue
+		}
+		comp[s] = s
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, v := range adj[u] {
+				if comp[v] < 0 {
+					comp[v] = s
+					queue = append(queue, v)
+				}
+			}
+		}
+	}
+	return comp
+}
+
+// communities returns the community of every node of an undirected graph, found with
+// label propagation. Every node starts in its own community and then repeatedly joins
+// the most common community among its neighbours, until no node changes or the
+// iterations are done. The nodes are visited in a shuffled order, with a fixed seed so
+// that the same graph always gives the same communities. It stops with the error of the
+// context if it's done before the iterations are.
+func communities(ctx context.Context, adj [][]int, iterations int) ([]int, error) {
+	label := make([]int, len(adj))
+	for i := range label {
+		label[i] = i
+	}
+	rng := rand.New(rand.NewSource(int64(len(adj))))
+	counts := make(map[int]int)
+	for it := 0; it < iterations; it++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var changed bool
+		for _, u := range rng.Perm(len(adj)) {
+			if len(adj[u]) == 0 {
+				continue
+			}
+			for k := range counts {
+				delete(counts, k)
+			}
+			for _, v := range adj[u] {
+				counts[label[v]]++
+			}
+			// On a tie, a node stays in its community or joins the smallest one.
+			best, bestCount := label[u], counts[label[u]]
+			for l, c := range counts {
+				if c > bestCount || (c == bestCount && l < best && best != label[u]) {
+					best, bestCount = l, c
+				}
+			}
+			if best != label[u] {
+				label[u] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return label, nil
+}
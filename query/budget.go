@@ -0,0 +1,109 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+type budgetKey struct{}
+
+// resultBudget limits the number of uids and values fetched while processing a
+// query, so that a runaway query fails instead of running the server out of memory.
+type resultBudget struct {
+	limit uint64
+	used  uint64 // Accessed atomically.
+}
+
+// WithBudget returns a context which limits the number of uids and values that can
+// be fetched by the queries processed with it. A limit of zero means no limit.
+func WithBudget(ctx context.Context, limit uint64) context.Context {
+	if limit == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, budgetKey{}, &resultBudget{limit: limit})
+}
+
+func budgetFrom(ctx context.Context) *resultBudget {
+	b, _ := ctx.Value(budgetKey{}).(*resultBudget)
+	return b
+}
+
+// remaining returns the number of uids and values which can still be fetched. Zero
+// means that there is no limit.
+func (b *resultBudget) remaining() uint64 {
+	if b == nil {
+		return 0
+	}
+	used := atomic.LoadUint64(&b.used)
+	if used >= b.limit {
+		// The next charge is going to fail anyway.
+		return 1
+	}
+	return b.limit - used
+}
+
+// charge adds the size of the result to the budget and returns an error if the
+// budget has been exceeded.
+func (b *resultBudget) charge(r *protos.Result) error {
+	return b.add(resultSize(r))
+}
+
+// chargeSort adds the sort values read to order the uid lists to the budget. A value
+// is read for every uid in the lists and every order, so the sort is charged before
+// it's run.
+func (b *resultBudget) chargeSort(s *protos.SortMessage) error {
+	var n uint64
+	for _, ul := range s.UidMatrix {
+		n += uint64(len(ul.Uids))
+	}
+	return b.add(n * uint64(len(s.Order)))
+}
+
+func (b *resultBudget) add(n uint64) error {
+	if b == nil {
+		return nil
+	}
+	if atomic.AddUint64(&b.used, n) > b.limit {
+		return worker.ErrBudgetExceeded
+	}
+	return nil
+}
+
+// resultSize returns the number of uids and values in the result.
+func resultSize(r *protos.Result) uint64 {
+	var n uint64
+	for _, ul := range r.UidMatrix {
+		n += uint64(len(ul.Uids))
+	}
+	for _, vl := range r.ValueMatrix {
+		for _, v := range vl.Values {
+			// Uids without a value have a placeholder.
+			if !bytes.Equal(v.Val, x.Nilbyte) {
+				n++
+			}
+		}
+	}
+	return n
+}
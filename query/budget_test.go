@@ -0,0 +1,73 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestResultSize(t *testing.T) {
+	r := &protos.Result{
+		UidMatrix: []*protos.List{{[]uint64{1, 2}}, {[]uint64{3}}},
+		ValueMatrix: []*protos.ValueList{
+			{Values: []*protos.TaskValue{{Val: []byte("a")}}},
+			{Values: []*protos.TaskValue{{Val: x.Nilbyte}}},
+		},
+	}
+	require.Equal(t, uint64(4), resultSize(r))
+}
+
+func TestBudget(t *testing.T) {
+	ctx := context.Background()
+	require.Nil(t, budgetFrom(WithBudget(ctx, 0)))
+
+	// No budget, nothing to charge.
+	var nb *resultBudget
+	require.Equal(t, uint64(0), nb.remaining())
+	require.NoError(t, nb.charge(&protos.Result{}))
+
+	b := budgetFrom(WithBudget(ctx, 5))
+	require.NotNil(t, b)
+	require.Equal(t, uint64(5), b.remaining())
+
+	r := &protos.Result{UidMatrix: []*protos.List{{[]uint64{1, 2, 3}}}}
+	require.NoError(t, b.charge(r))
+	require.Equal(t, uint64(2), b.remaining())
+	require.Equal(t, worker.ErrBudgetExceeded, b.charge(r))
+	require.Equal(t, uint64(1), b.remaining())
+}
+
+func TestBudgetSort(t *testing.T) {
+	var nb *resultBudget
+	require.NoError(t, nb.chargeSort(&protos.SortMessage{}))
+
+	b := budgetFrom(WithBudget(context.Background(), 10))
+	s := &protos.SortMessage{
+		Order:     []*protos.Order{{Attr: "name"}, {Attr: "age"}},
+		UidMatrix: []*protos.List{{[]uint64{1, 2}}, {[]uint64{3}}},
+	}
+	require.NoError(t, b.chargeSort(s))
+	require.Equal(t, uint64(4), b.remaining())
+	require.Equal(t, worker.ErrBudgetExceeded, b.chargeSort(s))
+}
@@ -241,6 +241,20 @@ func processToFastJsonReqCtx(t *testing.T, query string, ctx context.Context) (s
 	return string(resp), err
 }
 
+// processToOutput processes the query and encodes the result using the given encoder.
+func processToOutput(t *testing.T, query string,
+	encode func(*Latency, []*SubGraph) ([]byte, error)) (string, error) {
+	res, err := gql.Parse(gql.Request{Str: query, Http: true})
+	require.NoError(t, err)
+
+	startTs := timestamp()
+	maxPendingCh <- startTs
+	queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: startTs}
+	require.NoError(t, queryRequest.ProcessQuery(defaultContext()))
+	out, err := encode(queryRequest.Latency, queryRequest.Subgraphs)
+	return string(out), err
+}
+
 func processToFastJSON(t *testing.T, query string) string {
 	res, err := processToFastJsonReq(t, query)
 	require.NoError(t, err)
@@ -0,0 +1,65 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"encoding/hex"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// encodeCursor returns the opaque form of the cursor which is given to the clients. It is
+// hex encoded so that it can be used as the value of the cursor argument as it is.
+func encodeCursor(c *protos.Cursor) (string, error) {
+	b, err := c.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func decodeCursor(s string) (*protos.Cursor, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, x.Errorf("Invalid cursor: %q", s)
+	}
+	c := new(protos.Cursor)
+	if err := c.Unmarshal(b); err != nil || len(c.Values) == 0 {
+		return nil, x.Errorf("Invalid cursor: %q", s)
+	}
+	return c, nil
+}
+
+// Cursors returns the cursors for the next page of the ordered query blocks, by the alias
+// of the block. Passing one as the cursor argument of the block, along with the same order,
+// returns the nodes after the last one in this page.
+func (req *QueryRequest) Cursors() (map[string]string, error) {
+	cursors := make(map[string]string)
+	for _, sg := range req.Subgraphs {
+		if sg.cursor == nil || len(sg.cursor.Values) == 0 {
+			continue
+		}
+		c, err := encodeCursor(sg.cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursors[sg.Params.Alias] = c
+	}
+	return cursors, nil
+}
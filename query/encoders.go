@@ -0,0 +1,437 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Output encodings of the query result, other than the default JSON.
+const (
+	OutputJson  = "json"
+	OutputRDF   = "rdf"
+	OutputCSV   = "csv"
+	OutputProto = "proto"
+)
+
+type graphValue struct {
+	attr string
+	val  types.Val
+}
+
+// graphNode is an outputNode which keeps the typed values of the result, it is used to
+// encode the result as protocol buffers, RDF or CSV.
+type graphNode struct {
+	attr     string
+	uid      uint64
+	values   []graphValue
+	children []*graphNode
+}
+
+func (n *graphNode) AddValue(attr string, v types.Val) {
+	n.values = append(n.values, graphValue{attr: attr, val: v})
+}
+
+func (n *graphNode) AddMapChild(attr string, val outputNode, isRoot bool) {
+	child := val.(*graphNode)
+	for _, c := range n.children {
+		if c.attr == attr {
+			c.values = append(c.values, child.values...)
+			c.children = append(c.children, child.children...)
+			return
+		}
+	}
+	child.attr = attr
+	n.children = append(n.children, child)
+}
+
+func (n *graphNode) AddListChild(attr string, child outputNode) {
+	if child.IsEmpty() {
+		// Unlike JSON, there is no need to return an empty list for a block.
+		return
+	}
+	child.(*graphNode).attr = attr
+	n.children = append(n.children, child.(*graphNode))
+}
+
+func (n *graphNode) New(attr string) outputNode {
+	return &graphNode{attr: attr}
+}
+
+func (n *graphNode) SetUID(uid uint64, attr string) {
+	if attr == "uid" {
+		for _, v := range n.values {
+			if v.attr == attr {
+				return
+			}
+		}
+	}
+	n.uid = uid
+	n.values = append(n.values, graphValue{attr: attr, val: types.Val{Tid: types.UidID, Value: uid}})
+}
+
+func (n *graphNode) IsEmpty() bool {
+	return len(n.values) == 0 && len(n.children) == 0
+}
+
+func (n *graphNode) normalized() ([]outputNode, error) {
+	normalized, err := n.normalize()
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]outputNode, 0, len(normalized))
+	for _, values := range normalized {
+		nodes = append(nodes, &graphNode{values: values})
+	}
+	return nodes, nil
+}
+
+func (n *graphNode) normalize() ([][]graphValue, error) {
+	if len(n.children) == 0 {
+		return [][]graphValue{n.values}, nil
+	}
+
+	parentSlice := [][]graphValue{n.values}
+	for ci := 0; ci < len(n.children); {
+		attr := n.children[ci].attr
+		var childSlice [][]graphValue
+		for ci < len(n.children) && n.children[ci].attr == attr {
+			normalized, err := n.children[ci].normalize()
+			if err != nil {
+				return nil, err
+			}
+			childSlice = append(childSlice, normalized...)
+			ci++
+		}
+		var err error
+		if parentSlice, err = mergeValues(parentSlice, childSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	// Like for JSON, only the uid of the deepest node is kept.
+	for i, values := range parentSlice {
+		last := -1
+		for j, v := range values {
+			if v.attr == "uid" {
+				last = j
+			}
+		}
+		row := make([]graphValue, 0, len(values))
+		for j, v := range values {
+			if v.attr != "uid" || j == last {
+				row = append(row, v)
+			}
+		}
+		parentSlice[i] = row
+	}
+	return parentSlice, nil
+}
+
+func mergeValues(parent, child [][]graphValue) ([][]graphValue, error) {
+	merged := make([][]graphValue, 0, len(parent)*len(child))
+	cnt := 0
+	for _, pa := range parent {
+		for _, ca := range child {
+			cnt += len(pa) + len(ca)
+			if cnt > normalizeLimit {
+				return nil, x.Errorf("Couldn't evaluate @normalize directive - to many results")
+			}
+			list := make([]graphValue, 0, len(pa)+len(ca))
+			list = append(list, pa...)
+			list = append(list, ca...)
+			merged = append(merged, list)
+		}
+	}
+	return merged, nil
+}
+
+// toGraph processes the query blocks into a tree of graphNodes. The children of the
+// returned node are the nodes of all the blocks, with the block alias as attribute.
+func toGraph(sgl []*SubGraph) (*graphNode, error) {
+	root := &graphNode{attr: "_root_"}
+	for _, sg := range sgl {
+		if sg.Params.Alias == "var" || isPathAlias(sg.Params.Alias) {
+			continue
+		}
+		if err := processNodeUids(root, sg); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+func valToString(v types.Val) (string, error) {
+	if v.Tid == types.UidID {
+		return fmt.Sprintf("%#x", v.Value), nil
+	}
+	str := types.ValueForType(types.StringID)
+	if err := types.Marshal(v, &str); err != nil {
+		return "", err
+	}
+	return str.Value.(string), nil
+}
+
+// ToProtocolBuf returns the result of the query as a list of protos.Node, one for every
+// node at the root of the query blocks with the alias of the block as the attribute.
+func ToProtocolBuf(l *Latency, sgl []*SubGraph) ([]*protos.Node, error) {
+	defer func() {
+		l.Json = time.Since(l.Start) - l.Parsing - l.Processing
+	}()
+
+	root, err := toGraph(sgl)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*protos.Node, 0, len(root.children))
+	for _, c := range root.children {
+		n, err := c.toProto()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
+
+func (n *graphNode) toProto() (*protos.Node, error) {
+	out := &protos.Node{Attribute: n.attr}
+	for _, v := range n.values {
+		val, err := types.ObjectValue(v.val.Tid, v.val.Value)
+		if err != nil {
+			return nil, err
+		}
+		out.Properties = append(out.Properties, &protos.Property{Prop: v.attr, Value: val})
+	}
+	for _, c := range n.children {
+		child, err := c.toProto()
+		if err != nil {
+			return nil, err
+		}
+		out.Children = append(out.Children, child)
+	}
+	return out, nil
+}
+
+// ToRDF returns the result of the query as N-Quads, written like they are for exports.
+// Values keep their language or RDF type, and the facets are written on the values and
+// edges they were fetched for.
+func ToRDF(l *Latency, sgl []*SubGraph) ([]byte, error) {
+	defer func() {
+		l.Json = time.Since(l.Start) - l.Parsing - l.Processing
+	}()
+
+	// The uids are needed as the subjects of the N-Quads.
+	for _, sg := range sgl {
+		sg.recurse(func(sg *SubGraph) {
+			sg.Params.GetUid = true
+		})
+	}
+	root, err := toGraph(sgl)
+	if err != nil {
+		return nil, err
+	}
+	w := &rdfWriter{}
+	for _, c := range root.children {
+		if err := w.writeNode(w.subject(c), c); err != nil {
+			return nil, err
+		}
+	}
+	return w.buf.Bytes(), nil
+}
+
+type rdfWriter struct {
+	buf   bytes.Buffer
+	blank int
+}
+
+// subject returns the subject of the N-Quads for the node. Nodes without a uid, like
+// the ones for counts and aggregations, get a new blank node.
+func (w *rdfWriter) subject(n *graphNode) []byte {
+	var b bytes.Buffer
+	if n.uid != 0 {
+		worker.WriteRDFUid(&b, n.uid)
+	} else {
+		w.blank++
+		fmt.Fprintf(&b, "<_:node%d>", w.blank)
+	}
+	return b.Bytes()
+}
+
+// facetsFor returns the facets among the values of the node which were fetched for
+// the given predicate.
+func facetsFor(n *graphNode, pred string) []graphValue {
+	var fcs []graphValue
+	prefix := pred + FacetDelimeter
+	for _, v := range n.values {
+		if strings.HasPrefix(v.attr, prefix) {
+			fcs = append(fcs, graphValue{attr: v.attr[len(prefix):], val: v.val})
+		}
+	}
+	return fcs
+}
+
+func (w *rdfWriter) writeNode(subject []byte, n *graphNode) error {
+	for _, v := range n.values {
+		// The uid is the subject itself and facets are written with their predicate.
+		if v.val.Tid == types.UidID || strings.Contains(v.attr, FacetDelimeter) {
+			continue
+		}
+		pred, lang := v.attr, ""
+		if idx := strings.Index(pred, "@"); idx > 0 {
+			pred, lang = pred[:idx], pred[idx+1:]
+			if strings.ContainsAny(lang, ":.") {
+				// We don't know which of the languages the value was picked from.
+				lang = ""
+			}
+		}
+		str, err := valToString(v.val)
+		if err != nil {
+			return err
+		}
+		w.writePredicate(subject, pred)
+		worker.WriteRDFValue(&w.buf, str, v.val.Tid, lang)
+		if err := w.writeFacets(facetsFor(n, v.attr)); err != nil {
+			return err
+		}
+		w.buf.WriteString(" .\n")
+	}
+
+	for _, c := range n.children {
+		object := w.subject(c)
+		w.writePredicate(subject, c.attr)
+		w.buf.Write(object)
+		if err := w.writeFacets(facetsFor(c, c.attr)); err != nil {
+			return err
+		}
+		w.buf.WriteString(" .\n")
+		if err := w.writeNode(object, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *rdfWriter) writePredicate(subject []byte, pred string) {
+	w.buf.Write(subject)
+	w.buf.WriteString(" <")
+	w.buf.WriteString(pred)
+	w.buf.WriteString("> ")
+}
+
+func (w *rdfWriter) writeFacets(fcs []graphValue) error {
+	if len(fcs) == 0 {
+		return nil
+	}
+	w.buf.WriteString(" (")
+	for i, f := range fcs {
+		if i != 0 {
+			w.buf.WriteByte(',')
+		}
+		str, err := valToString(f.val)
+		if err != nil {
+			return err
+		}
+		w.buf.WriteString(f.attr)
+		w.buf.WriteByte('=')
+		if f.val.Tid == types.StringID {
+			w.buf.WriteString(strconv.Quote(str))
+		} else {
+			w.buf.WriteString(str)
+		}
+	}
+	w.buf.WriteByte(')')
+	return nil
+}
+
+// ToCSV returns the result of a query block with the @normalize directive as CSV. The
+// first row has the names of the columns, which are all the attributes in the order they
+// first appear in the result. Multiple values of an attribute are joined with a ";".
+func ToCSV(l *Latency, sgl []*SubGraph) ([]byte, error) {
+	defer func() {
+		l.Json = time.Since(l.Start) - l.Parsing - l.Processing
+	}()
+
+	var blocks []*SubGraph
+	for _, sg := range sgl {
+		if sg.Params.Alias == "var" || isPathAlias(sg.Params.Alias) {
+			continue
+		}
+		blocks = append(blocks, sg)
+	}
+	if len(blocks) != 1 {
+		return nil, x.Errorf("CSV output is only supported for queries with one block. Got: %v",
+			len(blocks))
+	}
+	if !blocks[0].Params.Normalize {
+		return nil, x.Errorf("CSV output is only supported for blocks with @normalize directive")
+	}
+	root, err := toGraph(blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []string
+	colIdx := make(map[string]int)
+	for _, row := range root.children {
+		for _, v := range row.values {
+			if _, ok := colIdx[v.attr]; !ok {
+				colIdx[v.attr] = len(columns)
+				columns = append(columns, v.attr)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(columns); err != nil {
+		return nil, err
+	}
+	for _, row := range root.children {
+		record := make([]string, len(columns))
+		for _, v := range row.values {
+			str, err := valToString(v.val)
+			if err != nil {
+				return nil, err
+			}
+			idx := colIdx[v.attr]
+			if record[idx] != "" {
+				str = record[idx] + ";" + str
+			}
+			record[idx] = str
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
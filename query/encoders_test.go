@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func stringVal(s string) types.Val {
+	return types.Val{Tid: types.StringID, Value: s}
+}
+
+func makeGraph() outputNode {
+	n := (&graphNode{}).New("me")
+	n.SetUID(1, "uid")
+	n.AddValue("name@en", stringVal("Alice"))
+	n.AddValue("age", types.Val{Tid: types.IntID, Value: int64(25)})
+
+	friend := n.New("friend")
+	friend.SetUID(2, "uid")
+	friend.AddValue("name", stringVal("Bob"))
+	friend.AddValue("friend|close", types.Val{Tid: types.BoolID, Value: true})
+	n.AddListChild("friend", friend)
+
+	friend = n.New("friend")
+	friend.SetUID(3, "uid")
+	friend.AddValue("name", stringVal("Carol"))
+	n.AddListChild("friend", friend)
+
+	// Empty children are not added.
+	n.AddListChild("friend", n.New("friend"))
+	return n
+}
+
+func TestGraphNodeNormalize(t *testing.T) {
+	normalized, err := makeGraph().normalized()
+	require.NoError(t, err)
+	require.Len(t, normalized, 2)
+
+	var rows [][]string
+	for _, row := range normalized {
+		var attrs []string
+		for _, v := range row.(*graphNode).values {
+			str, err := valToString(v.val)
+			require.NoError(t, err)
+			attrs = append(attrs, v.attr+"="+str)
+		}
+		rows = append(rows, attrs)
+	}
+	require.Equal(t, [][]string{
+		{"name@en=Alice", "age=25", "uid=0x2", "name=Bob", "friend|close=true"},
+		{"name@en=Alice", "age=25", "uid=0x3", "name=Carol"},
+	}, rows)
+}
+
+func TestGraphNodeToProto(t *testing.T) {
+	n, err := makeGraph().(*graphNode).toProto()
+	require.NoError(t, err)
+	require.Equal(t, "me", n.Attribute)
+	require.Equal(t, &protos.Property{Prop: "uid", Value: &protos.Value{&protos.Value_UidVal{1}}},
+		n.Properties[0])
+	require.Equal(t, &protos.Property{Prop: "age", Value: &protos.Value{&protos.Value_IntVal{25}}},
+		n.Properties[2])
+	require.Len(t, n.Children, 2)
+	require.Equal(t, "friend", n.Children[1].Attribute)
+	require.Equal(t, &protos.Property{Prop: "name",
+		Value: &protos.Value{&protos.Value_StrVal{"Carol"}}}, n.Children[1].Properties[1])
+}
+
+func TestGraphNodeToRDF(t *testing.T) {
+	n := makeGraph().(*graphNode)
+	w := &rdfWriter{}
+	require.NoError(t, w.writeNode(w.subject(n), n))
+	require.Equal(t, `<_:uid1> <name> "Alice"@en .
+<_:uid1> <age> "25"^^<xs:int> .
+<_:uid1> <friend> <_:uid2> (close=true) .
+<_:uid2> <name> "Bob"^^<xs:string> .
+<_:uid1> <friend> <_:uid3> .
+<_:uid3> <name> "Carol"^^<xs:string> .
+`, w.buf.String())
+
+	count := &graphNode{}
+	count.AddValue("count", types.Val{Tid: types.IntID, Value: int64(2)})
+	w = &rdfWriter{}
+	require.NoError(t, w.writeNode(w.subject(count), count))
+	require.Equal(t, "<_:node1> <count> \"2\"^^<xs:int> .\n", w.buf.String())
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos"
+)
+
+// execStats records how a SubGraph was processed by ProcessGraph. It is used to
+// build the plan returned for explain requests.
+type execStats struct {
+	groupId uint32
+	nodeId  uint64
+	access  string
+
+	task     time.Duration // Processing the task, including the network call.
+	filters  time.Duration
+	order    time.Duration // Sorting and pagination.
+	children time.Duration
+	total    time.Duration
+}
+
+func (s *execStats) fromResult(r *protos.Result) {
+	s.groupId = r.GroupId
+	s.nodeId = r.NodeId
+	s.access = r.Access
+}
+
+// PlanTiming holds the time spent in each phase of processing a SubGraph.
+type PlanTiming struct {
+	TaskNs     uint64 `json:"task_ns"`
+	FiltersNs  uint64 `json:"filters_ns"`
+	OrderNs    uint64 `json:"order_ns"`
+	ChildrenNs uint64 `json:"children_ns"`
+	TotalNs    uint64 `json:"total_ns"`
+}
+
+// PlanNode is a node of the execution plan returned in explain mode. It mirrors
+// the SubGraph tree which was executed for the query.
+type PlanNode struct {
+	Attr     string      `json:"attr,omitempty"`
+	Alias    string      `json:"alias,omitempty"`
+	Func     string      `json:"func,omitempty"`
+	FilterOp string      `json:"filter_op,omitempty"`
+	Access   string      `json:"access,omitempty"`
+	Group    uint32      `json:"group,omitempty"`
+	Node     uint64      `json:"node,omitempty"`
+	UidsIn   int         `json:"uids_in"`
+	UidsOut  int         `json:"uids_out"`
+	Timing   PlanTiming  `json:"timing"`
+	Filters  []*PlanNode `json:"filters,omitempty"`
+	Children []*PlanNode `json:"children,omitempty"`
+}
+
+// Plan returns the execution plan for the processed query blocks.
+func (req *QueryRequest) Plan() []*PlanNode {
+	plan := make([]*PlanNode, 0, len(req.Subgraphs))
+	for _, sg := range req.Subgraphs {
+		plan = append(plan, sg.plan())
+	}
+	return plan
+}
+
+func (sg *SubGraph) plan() *PlanNode {
+	p := &PlanNode{
+		Attr:     sg.Attr,
+		Alias:    sg.Params.Alias,
+		Func:     funcString(sg),
+		FilterOp: sg.FilterOp,
+		Access:   sg.stats.access,
+		Group:    sg.stats.groupId,
+		Node:     sg.stats.nodeId,
+		UidsIn:   len(sg.SrcUIDs.GetUids()),
+		UidsOut:  len(sg.DestUIDs.GetUids()),
+		Timing: PlanTiming{
+			TaskNs:     uint64(sg.stats.task.Nanoseconds()),
+			FiltersNs:  uint64(sg.stats.filters.Nanoseconds()),
+			OrderNs:    uint64(sg.stats.order.Nanoseconds()),
+			ChildrenNs: uint64(sg.stats.children.Nanoseconds()),
+			TotalNs:    uint64(sg.stats.total.Nanoseconds()),
+		},
+	}
+	for _, f := range sg.Filters {
+		p.Filters = append(p.Filters, f.plan())
+	}
+	for _, child := range sg.Children {
+		if child.IsInternal() {
+			continue
+		}
+		p.Children = append(p.Children, child.plan())
+	}
+	return p
+}
+
+// funcString returns the function of the SubGraph in the form it was written in the
+// query, e.g. eq(count(friend), 3).
+func funcString(sg *SubGraph) string {
+	fn := sg.SrcFunc
+	if fn == nil {
+		return ""
+	}
+	args := make([]string, 0, len(fn.Args)+1)
+	if sg.Attr != "" {
+		if fn.IsCount {
+			args = append(args, "count("+sg.Attr+")")
+		} else {
+			args = append(args, sg.Attr)
+		}
+	}
+	for _, arg := range fn.Args {
+		if arg.IsValueVar {
+			args = append(args, "val("+arg.Value+")")
+		} else {
+			args = append(args, strconv.Quote(arg.Value))
+		}
+	}
+	return fn.Name + "(" + strings.Join(args, ", ") + ")"
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package query
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos"
+)
+
+func TestFuncString(t *testing.T) {
+	tests := []struct {
+		sg  *SubGraph
+		out string
+	}{
+		{&SubGraph{Attr: "name"}, ""},
+		{&SubGraph{Attr: "name", SrcFunc: &Function{Name: "anyofterms",
+			Args: []gql.Arg{{Value: "Alice Bob"}}}}, `anyofterms(name, "Alice Bob")`},
+		{&SubGraph{Attr: "friend", SrcFunc: &Function{Name: "ge", IsCount: true,
+			Args: []gql.Arg{{Value: "3"}}}}, `ge(count(friend), "3")`},
+		{&SubGraph{Attr: "age", SrcFunc: &Function{Name: "lt",
+			Args: []gql.Arg{{Value: "a", IsValueVar: true}}}}, `lt(age, val(a))`},
+		{&SubGraph{SrcFunc: &Function{Name: "uid"}}, "uid()"},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.out, funcString(tc.sg))
+	}
+}
+
+func TestPlan(t *testing.T) {
+	filter := &SubGraph{
+		Attr:     "alias",
+		SrcFunc:  &Function{Name: "has"},
+		SrcUIDs:  &protos.List{[]uint64{2, 3}},
+		DestUIDs: &protos.List{[]uint64{3}},
+		stats:    execStats{groupId: 2, nodeId: 4, access: "uid lookup"},
+	}
+	friend := &SubGraph{
+		Attr:     "friend",
+		SrcUIDs:  &protos.List{[]uint64{1}},
+		DestUIDs: &protos.List{[]uint64{3}},
+		Filters:  []*SubGraph{filter},
+		stats:    execStats{groupId: 1, nodeId: 1, access: "uid lookup"},
+	}
+	root := &SubGraph{
+		Attr:     "name",
+		Params:   params{Alias: "me"},
+		SrcFunc:  &Function{Name: "anyofterms", Args: []gql.Arg{{Value: "Alice"}}},
+		DestUIDs: &protos.List{[]uint64{1}},
+		Children: []*SubGraph{
+			friend,
+			{Attr: "expand", Params: params{isInternal: true}},
+		},
+		stats: execStats{groupId: 1, nodeId: 1, access: "index",
+			task: time.Millisecond, total: 2 * time.Millisecond},
+	}
+
+	req := QueryRequest{Subgraphs: []*SubGraph{root}}
+	plan := req.Plan()
+	require.Len(t, plan, 1)
+	p := plan[0]
+	require.Equal(t, `anyofterms(name, "Alice")`, p.Func)
+	require.Equal(t, "me", p.Alias)
+	require.Equal(t, "index", p.Access)
+	require.Equal(t, 0, p.UidsIn)
+	require.Equal(t, 1, p.UidsOut)
+	require.Equal(t, uint64(time.Millisecond), p.Timing.TaskNs)
+	require.Equal(t, uint64(2*time.Millisecond), p.Timing.TotalNs)
+
+	// Internal nodes aren't executed, so they aren't part of the plan.
+	require.Len(t, p.Children, 1)
+	f := p.Children[0]
+	require.Equal(t, "friend", f.Attr)
+	require.Len(t, f.Filters, 1)
+	require.Equal(t, "has(alias)", f.Filters[0].Func)
+	require.Equal(t, uint32(2), f.Filters[0].Group)
+	require.Equal(t, uint64(4), f.Filters[0].Node)
+	require.Equal(t, 2, f.Filters[0].UidsIn)
+	require.Equal(t, 1, f.Filters[0].UidsOut)
+
+	js, err := json.Marshal(plan)
+	require.NoError(t, err)
+	var out []map[string]interface{}
+	require.NoError(t, json.Unmarshal(js, &out))
+	require.Equal(t, "index", out[0]["access"])
+	require.Contains(t, out[0], "timing")
+	require.Contains(t, out[0], "children")
+}
@@ -36,16 +36,170 @@ type groupPair struct {
 type groupResult struct {
 	keys       []groupPair
 	aggregates []groupPair
+	members    []groupUids
 	uids       []uint64
 }
 
+// groupUids is a list of the uids of the members of a group.
+type groupUids struct {
+	attr string
+	uids []uint64
+}
+
+// groupOrder orders the groups of a @groupby by one of their aggregates and paginates them.
+type groupOrder struct {
+	attr          string
+	desc          bool
+	first, offset int
+}
+
+func newGroupOrder(args map[string]string) (*groupOrder, error) {
+	o := &groupOrder{}
+	for k, v := range args {
+		switch k {
+		case "orderasc", "orderdesc":
+			o.attr = v
+			o.desc = k == "orderdesc"
+		case "first", "offset":
+			n, err := strconv.ParseInt(v, 0, 32)
+			if err != nil {
+				return nil, err
+			}
+			if k == "first" {
+				o.first = int(n)
+			} else {
+				o.offset = int(n)
+			}
+		}
+	}
+	return o, nil
+}
+
+// apply returns the page of the groups, ordered by the aggregate if there's one. The groups
+// without a value for the aggregate come last.
+func (o *groupOrder) apply(groups []*groupResult) []*groupResult {
+	if o.attr != "" {
+		sort.SliceStable(groups, func(i, j int) bool {
+			a, aok := groups[i].aggregate(o.attr)
+			b, bok := groups[j].aggregate(o.attr)
+			return lessVal(a, b, aok, bok, o.desc)
+		})
+	}
+	start, end := x.PageRange(o.first, o.offset, len(groups))
+	return groups[start:end]
+}
+
+// lessVal compares two values which might be missing, which are put after the others.
+func lessVal(a, b types.Val, aok, bok, desc bool) bool {
+	if !aok || !bok {
+		return aok && !bok
+	}
+	if desc {
+		a, b = b, a
+	}
+	l, err := types.Less(a, b)
+	return err == nil && l
+}
+
+// groupMembers picks the uids of the members of every group, ordered by the values of a
+// predicate if there's an order.
+type groupMembers struct {
+	order         *protos.Order
+	first, offset int
+}
+
+// setGroupMembers moves the order and pagination of the uids inside a @groupby to the
+// members and returns the predicate to fetch for them.
+func (p *params) setGroupMembers() (string, error) {
+	m := &groupMembers{first: p.Count, offset: p.Offset}
+	attr := "uid"
+	switch len(p.Order) {
+	case 0:
+	case 1:
+		m.order = p.Order[0]
+		if m.order.Count {
+			return "", x.Errorf("The members of a group can't be ordered by a count")
+		}
+		if m.order.Attr != "uid" {
+			attr = m.order.Attr
+			p.Langs = m.order.Langs
+		}
+	default:
+		return "", x.Errorf("The members of a group can only be ordered by one predicate")
+	}
+	p.members = m
+	p.Order = nil
+	p.Count, p.Offset = 0, 0
+	return attr, nil
+}
+
+// pick returns the page of the uids of a group.
+func (m *groupMembers) pick(uids []uint64, child *SubGraph) []uint64 {
+	res := make([]uint64, len(uids))
+	copy(res, uids)
+	if m.order != nil && m.order.Attr == "uid" {
+		if m.order.Desc {
+			sort.Slice(res, func(i, j int) bool { return res[i] > res[j] })
+		}
+	} else if m.order != nil {
+		vals := make(map[uint64]types.Val)
+		for _, uid := range res {
+			idx := algo.IndexOf(child.SrcUIDs, uid)
+			if idx < 0 || idx >= len(child.valueMatrix) || len(child.valueMatrix[idx].Values) == 0 {
+				continue
+			}
+			if val, err := convertWithBestEffort(child.valueMatrix[idx].Values[0],
+				child.Attr); err == nil {
+				vals[uid] = val
+			}
+		}
+		sort.SliceStable(res, func(i, j int) bool {
+			a, aok := vals[res[i]]
+			b, bok := vals[res[j]]
+			return lessVal(a, b, aok, bok, m.order.Desc)
+		})
+	}
+	start, end := x.PageRange(m.first, m.offset, len(res))
+	return res[start:end]
+}
+
+// groupFieldName returns the name of the result of a child inside a @groupby.
+func groupFieldName(child *SubGraph) string {
+	switch {
+	case child.Params.Alias != "":
+		return child.Params.Alias
+	case child.Params.DoCount:
+		return "count"
+	case child.Params.members != nil || child.SrcFunc == nil:
+		return "uid"
+	}
+	return aggFieldName(child.SrcFunc, child.Attr)
+}
+
+// aggregate returns the value of an aggregate of the group.
+func (grp *groupResult) aggregate(attr string) (types.Val, bool) {
+	for _, it := range grp.aggregates {
+		if it.attr == attr {
+			return it.key, true
+		}
+	}
+	return types.Val{}, false
+}
+
 func (grp *groupResult) aggregateChild(child *SubGraph) error {
+	if child.Params.members != nil {
+		grp.members = append(grp.members, groupUids{
+			attr: groupFieldName(child),
+			uids: child.Params.members.pick(grp.uids, child),
+		})
+		return nil
+	}
 	if child.Params.DoCount {
 		if child.Attr != "uid" {
 			return x.Errorf("Only uid predicate is allowed in count within groupby")
 		}
 		grp.aggregates = append(grp.aggregates, groupPair{
-			attr: "count",
+			attr: groupFieldName(child),
 			key: types.Val{
 				Tid:   types.IntID,
 				Value: int64(len(grp.uids)),
@@ -54,7 +208,7 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 		return nil
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
-		fieldName := fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
+		fieldName := groupFieldName(child)
 		finalVal, err := aggregateGroup(grp, child)
 		if err != nil {
 			return err
@@ -133,9 +287,7 @@ func (d *dedup) addValue(attr string, value types.Val, uid uint64) {
 }
 
 func aggregateGroup(grp *groupResult, child *SubGraph) (types.Val, error) {
-	ag := aggregator{
-		name: child.SrcFunc.Name,
-	}
+	ag := newAggregator(child.SrcFunc)
 	for _, uid := range grp.uids {
 		idx := sort.Search(len(child.SrcUIDs.Uids), func(i int) bool {
 			return child.SrcUIDs.Uids[i] >= uid
@@ -221,6 +373,23 @@ func (sg *SubGraph) processGroupBy(doneVars map[string]varValue, path []*SubGrap
 		}
 	}
 
+	for _, it := range sg.Params.groupbyAttrs {
+		if it.Var == "" || sg.DestUIDs == nil {
+			continue
+		}
+		// It's a value variable.
+		attr := fmt.Sprintf("val(%s)", it.Var)
+		if pathNode == nil {
+			pathNode = sg
+		}
+		vals := doneVars[it.Var].Vals
+		for _, uid := range sg.DestUIDs.Uids {
+			if val, ok := vals[uid]; ok {
+				dedupMap.addValue(attr, val, uid)
+			}
+		}
+	}
+
 	// Create all the groups here.
 	res := new(groupResults)
 	res.formGroups(dedupMap, &protos.List{}, []groupPair{})
@@ -237,41 +406,87 @@ func (sg *SubGraph) processGroupBy(doneVars map[string]varValue, path []*SubGrap
 				return err
 			}
 		}
-		chVar := child.Params.Var
-		if chVar != "" {
-			tempMap := make(map[uint64]types.Val)
-			for _, grp := range res.group {
-				if len(grp.keys) == 0 {
-					continue
-				}
-				if len(grp.keys) > 1 {
-					return x.Errorf("Expected one UID for var in groupby but got: %d", len(grp.keys))
-				}
-				uidVal := grp.keys[0].key.Value
-				uid, ok := uidVal.(uint64)
-				if !ok {
-					return x.Errorf("Vars can be assigned only when grouped by UID attribute")
-				}
-				// grp.aggregates could be empty if schema conversion failed during aggregation
-				if len(grp.aggregates) > 0 {
-					tempMap[uid] = grp.aggregates[len(grp.aggregates)-1].key
-				}
-			}
-			doneVars[chVar] = varValue{
-				Vals: tempMap,
-				path: append(path, pathNode),
-			}
-		}
-		child.Params.ignoreResult = true
 	}
 	// Sort to order the groups for determinism.
 	sort.Slice(res.group, func(i, j int) bool {
 		return groupLess(res.group[i], res.group[j])
 	})
+	if order := sg.Params.groupOrder; order != nil {
+		if order.attr != "" && !hasGroupField(sg, order.attr) {
+			return x.Errorf("Groups can only be ordered by an aggregate. Got: %s", order.attr)
+		}
+		res.group = order.apply(res.group)
+	}
+
+	for _, child := range sg.Children {
+		if child.Params.ignoreResult {
+			continue
+		}
+		if chVar := child.Params.Var; chVar != "" {
+			if err := assignGroupVar(res, child, chVar, doneVars, path, pathNode); err != nil {
+				return err
+			}
+		}
+		child.Params.ignoreResult = true
+	}
 	sg.GroupbyRes = res
 	return nil
 }
 
+func hasGroupField(sg *SubGraph, attr string) bool {
+	for _, child := range sg.Children {
+		if !child.Params.ignoreResult && child.Params.members == nil &&
+			groupFieldName(child) == attr {
+			return true
+		}
+	}
+	return false
+}
+
+// assignGroupVar stores the result of a child inside a @groupby in a variable. The uids of
+// the members are a uid variable, while the aggregates are a value variable of the uids
+// grouped by.
+func assignGroupVar(res *groupResults, child *SubGraph, chVar string,
+	doneVars map[string]varValue, path []*SubGraph, pathNode *SubGraph) error {
+	if child.Params.members != nil {
+		var lists []*protos.List
+		for _, grp := range res.group {
+			for _, m := range grp.members {
+				lists = append(lists, &protos.List{Uids: m.uids})
+			}
+		}
+		doneVars[chVar] = varValue{
+			Uids: algo.MergeSorted(lists),
+			path: path,
+		}
+		return nil
+	}
+
+	tempMap := make(map[uint64]types.Val)
+	for _, grp := range res.group {
+		if len(grp.keys) == 0 {
+			continue
+		}
+		if len(grp.keys) > 1 {
+			return x.Errorf("Expected one UID for var in groupby but got: %d", len(grp.keys))
+		}
+		uidVal := grp.keys[0].key.Value
+		uid, ok := uidVal.(uint64)
+		if !ok {
+			return x.Errorf("Vars can be assigned only when grouped by UID attribute")
+		}
+		// The aggregate could be missing if schema conversion failed during aggregation
+		if val, ok := grp.aggregate(groupFieldName(child)); ok {
+			tempMap[uid] = val
+		}
+	}
+	doneVars[chVar] = varValue{
+		Vals: tempMap,
+		path: append(path, pathNode),
+	}
+	return nil
+}
+
 func groupLess(a, b *groupResult) bool {
 	if len(a.uids) < len(b.uids) {
 		return true
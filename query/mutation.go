@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 
 	"golang.org/x/net/trace"
 
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
@@ -213,3 +217,178 @@ func ToInternal(gmu *gql.Mutation,
 
 	return edges, nil
 }
+
+type readKeysKey struct{}
+
+// readKeys collects the keys of the posting lists read by the query of an upsert mutation.
+// They are added to the keys of its transaction, so that it conflicts with any transaction
+// which changed what the query read after it started.
+type readKeys struct {
+	sync.Mutex
+	keys []string
+}
+
+// WithReadKeys returns a context which collects the keys of the posting lists read by the
+// queries processed with it, along with a function returning them.
+func WithReadKeys(ctx context.Context) (context.Context, func() []string) {
+	rk := &readKeys{}
+	return context.WithValue(ctx, readKeysKey{}, rk), func() []string {
+		rk.Lock()
+		defer rk.Unlock()
+		return x.RemoveDuplicates(rk.keys)
+	}
+}
+
+func readKeysFrom(ctx context.Context) *readKeys {
+	rk, _ := ctx.Value(readKeysKey{}).(*readKeys)
+	return rk
+}
+
+func (rk *readKeys) add(keys []string) {
+	if rk == nil || len(keys) == 0 {
+		return
+	}
+	rk.Lock()
+	defer rk.Unlock()
+	rk.keys = append(rk.keys, keys...)
+}
+
+// SubstituteVars replaces the uid(v) and val(v) references in the N-Quads of gmu with the
+// values of the variables computed by the query request. A uid(v) subject or object is
+// expanded into one N-Quad per uid in v. If v is empty, a set N-Quad refers to a new node
+// instead, which is reported back as "uid(v)", while a delete N-Quad is dropped. A val(v)
+// object takes the value of v for the subject uid, the N-Quad is dropped if there is none.
+func (req *QueryRequest) SubstituteVars(gmu *gql.Mutation) error {
+	var err error
+	if gmu.Set, err = req.substituteVars(gmu.Set, true); err != nil {
+		return err
+	}
+	gmu.Del, err = req.substituteVars(gmu.Del, false)
+	return err
+}
+
+func (req *QueryRequest) substituteVars(nquads []*protos.NQuad,
+	isSet bool) ([]*protos.NQuad, error) {
+	res := make([]*protos.NQuad, 0, len(nquads))
+	for _, nq := range nquads {
+		if len(nq.SubjectVar) == 0 && len(nq.ObjectVar) == 0 && len(nq.ValueVar) == 0 {
+			res = append(res, nq)
+			continue
+		}
+
+		subjects := req.varNodes(nq.Subject, nq.SubjectVar, isSet)
+		objects := req.varNodes(nq.ObjectId, nq.ObjectVar, isSet)
+		for _, s := range subjects {
+			for _, o := range objects {
+				n := *nq
+				n.Subject, n.SubjectVar = s, ""
+				n.ObjectId, n.ObjectVar = o, ""
+				if len(nq.ValueVar) > 0 {
+					val, err := req.varObjectValue(nq.ValueVar, s)
+					if err != nil {
+						return res, err
+					}
+					if val == nil {
+						continue
+					}
+					n.ObjectValue, n.ValueVar = val, ""
+				}
+				res = append(res, &n)
+			}
+		}
+	}
+	return res, nil
+}
+
+// varNodes returns the node ids an N-Quad position expands to. id is returned as is if the
+// position doesn't refer to a variable.
+func (req *QueryRequest) varNodes(id, name string, isSet bool) []string {
+	if len(name) == 0 {
+		return []string{id}
+	}
+	uids := req.varUids(name)
+	if len(uids) == 0 {
+		if isSet {
+			return []string{"_:uid(" + name + ")"}
+		}
+		return nil
+	}
+	nodes := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		nodes = append(nodes, fmt.Sprintf("%#x", uid))
+	}
+	return nodes
+}
+
+func (req *QueryRequest) varUids(name string) []uint64 {
+	v, ok := req.vars[name]
+	if !ok {
+		return nil
+	}
+	return v.uidList().Uids
+}
+
+// varObjectValue returns the value of the value variable name for the given subject, nil if
+// the subject has no value.
+func (req *QueryRequest) varObjectValue(name, subject string) (*protos.Value, error) {
+	uid, err := gql.ParseUid(subject)
+	if err != nil {
+		// Blank nodes can't have a value yet.
+		return nil, nil
+	}
+	val, ok := req.vars[name].Vals[uid]
+	if !ok {
+		return nil, nil
+	}
+	return types.ObjectValue(val.Tid, val.Value)
+}
+
+// EvalCond evaluates the condition of a conditional mutation, as parsed by gql.ParseCond,
+// against the variables computed by the query request.
+func (req *QueryRequest) EvalCond(ft *gql.FilterTree) (bool, error) {
+	switch ft.Op {
+	case "and":
+		for _, ch := range ft.Child {
+			if ok, err := req.EvalCond(ch); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case "or":
+		for _, ch := range ft.Child {
+			if ok, err := req.EvalCond(ch); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case "not":
+		if len(ft.Child) != 1 {
+			return false, x.Errorf("Expected one operand for not in condition")
+		}
+		ok, err := req.EvalCond(ft.Child[0])
+		return !ok, err
+	}
+
+	fn := ft.Func
+	if fn == nil || !fn.IsLenVar || len(fn.Args) != 1 {
+		return false, x.Errorf("Invalid function in condition")
+	}
+	want, err := strconv.ParseInt(fn.Args[0].Value, 0, 64)
+	if err != nil {
+		return false, x.Wrapf(err, "While parsing argument of %s in condition", fn.Name)
+	}
+	got := int64(len(req.varUids(fn.Attr)))
+	switch fn.Name {
+	case "eq":
+		return got == want, nil
+	case "lt":
+		return got < want, nil
+	case "le":
+		return got <= want, nil
+	case "gt":
+		return got > want, nil
+	case "ge":
+		return got >= want, nil
+	}
+	return false, x.Errorf("Invalid function %s in condition", fn.Name)
+}
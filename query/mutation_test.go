@@ -0,0 +1,90 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestSubstituteVars(t *testing.T) {
+	req := &QueryRequest{
+		vars: map[string]varValue{
+			"v": {Uids: &protos.List{[]uint64{1, 2}}},
+			"e": {Uids: &protos.List{}},
+			"n": {Vals: map[uint64]types.Val{
+				1: {Tid: types.StringID, Value: "alice"},
+			}},
+		},
+	}
+	gmu := &gql.Mutation{
+		Set: []*protos.NQuad{
+			{SubjectVar: "v", Predicate: "nick", ValueVar: "n"},
+			{SubjectVar: "e", Predicate: "friend", ObjectVar: "v"},
+			{Subject: "_:a", Predicate: "name", ObjectValue: &protos.Value{
+				&protos.Value_DefaultVal{"bob"}}},
+		},
+		Del: []*protos.NQuad{
+			{SubjectVar: "e", Predicate: "name", ObjectValue: &protos.Value{
+				&protos.Value_DefaultVal{x.Star}}},
+		},
+	}
+	require.NoError(t, req.SubstituteVars(gmu))
+	require.Equal(t, []*protos.NQuad{
+		{Subject: "0x1", Predicate: "nick", ObjectValue: &protos.Value{
+			&protos.Value_StrVal{"alice"}}},
+		{Subject: "_:uid(e)", Predicate: "friend", ObjectId: "0x1"},
+		{Subject: "_:uid(e)", Predicate: "friend", ObjectId: "0x2"},
+		{Subject: "_:a", Predicate: "name", ObjectValue: &protos.Value{
+			&protos.Value_DefaultVal{"bob"}}},
+	}, gmu.Set)
+	require.Equal(t, 0, len(gmu.Del))
+}
+
+func TestEvalCond(t *testing.T) {
+	req := &QueryRequest{
+		vars: map[string]varValue{
+			"v": {Uids: &protos.List{[]uint64{1, 2}}},
+			"e": {Uids: &protos.List{}},
+		},
+	}
+	tests := []struct {
+		cond string
+		ok   bool
+	}{
+		{`@if(eq(len(e), 0))`, true},
+		{`@if(eq(len(v), 0))`, false},
+		{`@if(gt(len(v), 1))`, true},
+		{`@if(ge(len(v), 3))`, false},
+		{`@if(le(len(v), 2) AND lt(len(e), 1))`, true},
+		{`@if(eq(len(v), 0) OR eq(len(e), 1))`, false},
+		{`@if(NOT eq(len(v), 0))`, true},
+	}
+	for _, tc := range tests {
+		ft, _, err := gql.ParseCond(tc.cond)
+		require.NoError(t, err, tc.cond)
+		ok, err := req.EvalCond(ft)
+		require.NoError(t, err, tc.cond)
+		require.Equal(t, tc.ok, ok, tc.cond)
+	}
+}
@@ -19,6 +19,7 @@ package query
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -43,7 +44,7 @@ const (
 func ToJson(l *Latency, sgl []*SubGraph) ([]byte, error) {
 	sgr := &SubGraph{}
 	for _, sg := range sgl {
-		if sg.Params.Alias == "var" || sg.Params.Alias == "shortest" {
+		if sg.Params.Alias == "var" || isPathAlias(sg.Params.Alias) {
 			continue
 		}
 		if sg.Params.GetUid {
@@ -62,10 +63,8 @@ type outputNode interface {
 	New(attr string) outputNode
 	SetUID(uid uint64, attr string)
 	IsEmpty() bool
-
-	addCountAtRoot(*SubGraph)
-	addGroupby(*SubGraph, string)
-	addAggregations(*SubGraph) error
+	// normalized returns the flattened nodes for the @normalize directive.
+	normalized() ([]outputNode, error)
 }
 
 func makeScalarNode(attr string, isChild bool, val []byte) *fastJsonNode {
@@ -365,12 +364,24 @@ func (n *fastJsonNode) normalize() ([][]*fastJsonNode, error) {
 	return parentSlice, nil
 }
 
+func (n *fastJsonNode) normalized() ([]outputNode, error) {
+	normalized, err := n.normalize()
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]outputNode, 0, len(normalized))
+	for _, c := range normalized {
+		nodes = append(nodes, &fastJsonNode{attrs: c})
+	}
+	return nodes, nil
+}
+
 type attrVal struct {
 	attr string
 	val  *fastJsonNode
 }
 
-func (n *fastJsonNode) addGroupby(sg *SubGraph, fname string) {
+func addGroupby(n outputNode, sg *SubGraph, fname string) {
 	// Don't add empty groupby
 	if len(sg.GroupbyRes.group) == 0 {
 		return
@@ -384,12 +395,19 @@ func (n *fastJsonNode) addGroupby(sg *SubGraph, fname string) {
 		for _, it := range grp.aggregates {
 			uc.AddValue(it.attr, it.key)
 		}
+		for _, it := range grp.members {
+			for _, uid := range it.uids {
+				m := uc.New(it.attr)
+				m.SetUID(uid, "uid")
+				uc.AddListChild(it.attr, m)
+			}
+		}
 		g.AddListChild("@groupby", uc)
 	}
 	n.AddListChild(fname, g)
 }
 
-func (n *fastJsonNode) addCountAtRoot(sg *SubGraph) {
+func addCountAtRoot(n outputNode, sg *SubGraph) {
 	c := types.ValueForType(types.IntID)
 	// This is count() without any attribute.
 	c.Value = int64(len(sg.DestUIDs.Uids))
@@ -398,7 +416,53 @@ func (n *fastJsonNode) addCountAtRoot(sg *SubGraph) {
 	n.AddListChild(sg.Params.Alias, n1)
 }
 
-func (n *fastJsonNode) addAggregations(sg *SubGraph) error {
+// addBlockAggregates adds the aggregates of the block, like count(distinct pred), to its
+// list. They're computed over the values of the given uids of the block. It returns true
+// if any was added.
+func addBlockAggregates(n outputNode, sg *SubGraph, fieldName string,
+	uids []uint64) (bool, error) {
+	var added bool
+	for _, child := range sg.Children {
+		if !child.isBlockAggregate() {
+			continue
+		}
+		ag := newAggregator(child.SrcFunc)
+		for _, uid := range uids {
+			idx := algo.IndexOf(child.SrcUIDs, uid)
+			if idx < 0 || idx >= len(child.valueMatrix) {
+				continue
+			}
+			for _, tv := range child.valueMatrix[idx].Values {
+				if bytes.Equal(tv.Val, x.Nilbyte) {
+					continue
+				}
+				v, err := convertWithBestEffort(tv, child.Attr)
+				if err != nil {
+					continue
+				}
+				ag.Apply(v)
+			}
+		}
+		v, err := ag.Value()
+		if err != nil && err != ErrEmptyVal {
+			return added, err
+		}
+		if v.Value == nil {
+			continue
+		}
+		name := child.Params.Alias
+		if name == "" {
+			name = aggFieldName(child.SrcFunc, child.Attr)
+		}
+		n1 := n.New(fieldName)
+		n1.AddValue(name, v)
+		n.AddListChild(fieldName, n1)
+		added = true
+	}
+	return added, nil
+}
+
+func addAggregations(n outputNode, sg *SubGraph) error {
 	for _, child := range sg.Children {
 		aggVal, ok := child.Params.uidToVal[0]
 		if !ok {
@@ -412,27 +476,39 @@ func (n *fastJsonNode) addAggregations(sg *SubGraph) error {
 	return nil
 }
 
-func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
-	var seedNode *fastJsonNode
+func processNodeUids(n outputNode, sg *SubGraph) error {
 	if sg.Params.IsEmpty {
-		return n.addAggregations(sg)
+		return addAggregations(n, sg)
+	}
+	if sg.paths != nil {
+		addPaths(n, sg)
+		return nil
 	}
 
 	if sg.uidMatrix == nil {
-		n.AddListChild(sg.Params.Alias, &fastJsonNode{})
+		n.AddListChild(sg.Params.Alias, n.New(""))
 		return nil
 	}
 
+	if sg.Params.WithCount && len(sg.totals) > 0 {
+		addTotal(sg.Params.Alias, sg.totals[0], n)
+	}
+
 	hasChild := false
 	if sg.Params.uidCount != "" {
 		hasChild = true
-		n.addCountAtRoot(sg)
+		addCountAtRoot(n, sg)
 	}
 
 	if sg.Params.isGroupBy {
-		n.addGroupby(sg, sg.Params.Alias)
+		addGroupby(n, sg, sg.Params.Alias)
 		return nil
 	}
+	added, err := addBlockAggregates(n, sg, sg.Params.Alias, sg.DestUIDs.Uids)
+	if err != nil {
+		return err
+	}
+	hasChild = hasChild || added
 
 	lenList := len(sg.uidMatrix[0].Uids)
 	for i := 0; i < lenList; i++ {
@@ -442,7 +518,7 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 			continue
 		}
 
-		n1 := seedNode.New(sg.Params.Alias)
+		n1 := n.New(sg.Params.Alias)
 		if err := sg.preTraverse(uid, n1); err != nil {
 			if err.Error() == "_INV_" {
 				continue
@@ -461,18 +537,18 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 		}
 
 		// Lets normalize the response now.
-		normalized, err := n1.(*fastJsonNode).normalize()
+		normalized, err := n1.normalized()
 		if err != nil {
 			return err
 		}
 		for _, c := range normalized {
-			n.AddListChild(sg.Params.Alias, &fastJsonNode{attrs: c})
+			n.AddListChild(sg.Params.Alias, c)
 		}
 	}
 
 	if !hasChild {
 		// So that we return an empty key if the root didn't have any children.
-		n.AddListChild(sg.Params.Alias, &fastJsonNode{})
+		n.AddListChild(sg.Params.Alias, n.New(""))
 	}
 	return nil
 }
@@ -480,6 +556,10 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 type Extensions struct {
 	Latency *protos.Latency    `json:"server_latency,omitempty"`
 	Txn     *protos.TxnContext `json:"txn,omitempty"`
+	// Plan is the JSON encoded execution plan, returned for explain requests.
+	Plan json.RawMessage `json:"plan,omitempty"`
+	// Cursors has the cursor for the next page of the ordered blocks, by their alias.
+	Cursors map[string]string `json:"cursors,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
@@ -491,7 +571,7 @@ func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
 	var err error
 	n := seedNode.New("_root_")
 	for _, sg := range sg.Children {
-		err = processNodeUids(n.(*fastJsonNode), sg)
+		err = processNodeUids(n, sg)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,230 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// defaultNumPaths is the number of paths returned by a paths block without numpaths.
+	defaultNumPaths = 1000
+	// maxNumPaths is the maximum number of paths which can be asked for.
+	maxNumPaths = 10000
+)
+
+// isPathAlias returns true for the blocks which find paths between two nodes, instead
+// of starting from a set of nodes.
+func isPathAlias(alias string) bool {
+	return alias == "shortest" || alias == "paths"
+}
+
+type pathEdge struct {
+	pathInfo
+	// filtered is true if the node didn't match the filter of the predicate. Paths can
+	// end at it, but not go through it.
+	filtered bool
+}
+
+// AllPaths finds the simple paths from the from node to the to node of a paths block,
+// with at most maxhops edges of the predicates of the block. The paths are searched
+// depth first, visiting the edges in the order of the predicates and the uids, and at
+// most numpaths of them are returned.
+func AllPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
+	if sg.Params.Alias != "paths" {
+		return nil, x.Errorf("Invalid paths query")
+	}
+	if sg.Params.From == 0 || sg.Params.To == 0 {
+		return nil, x.Errorf("from and to are required in paths")
+	}
+	maxHops := int(sg.Params.ExploreDepth)
+	if maxHops <= 0 {
+		return nil, x.Errorf("maxhops is required in paths")
+	}
+	numPaths := sg.Params.numPaths
+	if numPaths == 0 {
+		numPaths = defaultNumPaths
+	}
+	if numPaths > maxNumPaths {
+		return nil, x.Errorf("numpaths can't be more than %d. Got: %d", maxNumPaths, numPaths)
+	}
+
+	adj, err := sg.expandPaths(ctx, maxHops)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := findPaths(adj, sg.Params.From, sg.Params.To, maxHops, numPaths)
+	if err != nil {
+		return nil, err
+	}
+	if len(routes) == 0 {
+		sg.DestUIDs = &protos.List{}
+		return nil, nil
+	}
+
+	// The nodes of all the paths can be used through a variable.
+	var lists []*protos.List
+	for _, r := range routes {
+		uids := make([]uint64, 0, len(r.route))
+		for _, p := range r.route {
+			uids = append(uids, p.uid)
+		}
+		sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+		lists = append(lists, &protos.List{Uids: uids})
+	}
+	sg.DestUIDs = algo.MergeSorted(lists)
+
+	pathsSg := &SubGraph{
+		Params: params{Alias: "_paths_"},
+		paths:  routes,
+	}
+	return []*SubGraph{pathsSg}, nil
+}
+
+// expandPaths expands the from node level by level over the children of the block, for
+// maxHops levels, and returns the edges going out of every node reached. Every node is
+// expanded at most once.
+func (sg *SubGraph) expandPaths(ctx context.Context,
+	maxHops int) (map[uint64][]pathEdge, error) {
+	adj := make(map[uint64][]pathEdge)
+	expanded := map[uint64]bool{sg.Params.From: true}
+	frontier := &protos.List{Uids: []uint64{sg.Params.From}}
+	var numEdges int
+	for hop := 0; hop < maxHops && len(frontier.Uids) > 0; hop++ {
+		exec := make([]*SubGraph, 0, len(sg.Children))
+		for _, child := range sg.Children {
+			temp := new(SubGraph)
+			temp.copyFiltersRecurse(child)
+			temp.SrcUIDs = frontier
+			exec = append(exec, temp)
+		}
+		// The uidMatrix keeps the nodes which didn't match the filters.
+		if err := processLevel(ctx, exec); err != nil {
+			return nil, err
+		}
+
+		lists := make([]*protos.List, 0, len(exec))
+		for _, e := range exec {
+			for i, from := range e.SrcUIDs.Uids {
+				var fcsList []*protos.Facets
+				if e.Params.Facet != nil && i < len(e.facetsMatrix) {
+					fcsList = e.facetsMatrix[i].FacetsList
+				}
+				for j, to := range e.uidMatrix[i].Uids {
+					edge := pathEdge{
+						pathInfo: pathInfo{uid: to, attr: e.Attr},
+						filtered: algo.IndexOf(e.DestUIDs, to) < 0,
+					}
+					if j < len(fcsList) {
+						edge.facet = fcsList[j]
+					}
+					adj[from] = append(adj[from], edge)
+					numEdges++
+				}
+			}
+			lists = append(lists, e.DestUIDs)
+		}
+		if numEdges > 10000000 {
+			// If we've seen too many nodes, stop the query.
+			return nil, ErrTooBig
+		}
+
+		frontier = algo.MergeSorted(lists)
+		algo.ApplyFilter(frontier, func(uid uint64, i int) bool {
+			// Paths end at the to node, so it doesn't need to be expanded.
+			return !expanded[uid] && uid != sg.Params.To
+		})
+		for _, uid := range frontier.Uids {
+			expanded[uid] = true
+		}
+	}
+	return adj, nil
+}
+
+// findPaths returns up to numPaths simple paths from one node to another with at most
+// maxHops edges.
+func findPaths(adj map[uint64][]pathEdge, from, to uint64, maxHops,
+	numPaths int) ([]route, error) {
+	var routes []route
+	var steps int
+	onPath := map[uint64]bool{from: true}
+	cur := []pathInfo{{uid: from}}
+
+	var visit func(uid uint64)
+	visit = func(uid uint64) {
+		for _, e := range adj[uid] {
+			if len(routes) == numPaths || steps > 10000000 {
+				return
+			}
+			steps++
+			if onPath[e.uid] {
+				continue
+			}
+			if e.uid == to {
+				r := make([]pathInfo, len(cur)+1)
+				copy(r, cur)
+				r[len(cur)] = e.pathInfo
+				routes = append(routes, route{route: r})
+				continue
+			}
+			if e.filtered || len(cur) == maxHops {
+				continue
+			}
+			onPath[e.uid] = true
+			cur = append(cur, e.pathInfo)
+			visit(e.uid)
+			cur = cur[:len(cur)-1]
+			delete(onPath, e.uid)
+		}
+	}
+	visit(from)
+	if steps > 10000000 {
+		// The paths can't all be enumerated, even if they are less than numPaths.
+		return nil, ErrTooBig
+	}
+	return routes, nil
+}
+
+// addPaths adds every path as a list of the nodes in it. The nodes after the first one
+// have the predicate of the edge which led to them and its facets.
+func addPaths(n outputNode, sg *SubGraph) {
+	for _, r := range sg.paths {
+		pn := n.New(sg.Params.Alias)
+		for _, p := range r.route {
+			node := pn.New("path")
+			node.SetUID(p.uid, "uid")
+			if p.attr != "" {
+				node.AddValue("via", types.Val{Tid: types.StringID, Value: p.attr})
+			}
+			if p.facet != nil {
+				for _, f := range p.facet.Facets {
+					node.AddValue(p.attr+FacetDelimeter+f.Key, facets.ValFor(f))
+				}
+			}
+			pn.AddListChild("path", node)
+		}
+		n.AddListChild(sg.Params.Alias, pn)
+	}
+}
@@ -0,0 +1,638 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/twpayne/go-geom"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The search algorithms which can be given as the algo argument of a shortest block.
+const (
+	pathDijkstra      = "dijkstra"
+	pathBidirectional = "bidirectional"
+	pathAStar         = "astar"
+)
+
+func isValidPathAlgo(a string) bool {
+	switch a {
+	case pathDijkstra, pathBidirectional, pathAStar:
+		return true
+	}
+	return false
+}
+
+type searchEdge struct {
+	mapItem
+	to uint64
+}
+
+// pathGraph is the part of the graph fetched by the searches of a shortest path. Unlike
+// expandOut, which expands the whole graph level by level, the edges of a node are only
+// fetched when a search reaches it, along with the edges of a few of the cheapest nodes
+// waiting in its queue.
+type pathGraph struct {
+	children []*SubGraph
+	// reverse is true for the search from the to node, which follows the reverse edges.
+	reverse bool
+	weights *weightRange
+	readTs  uint64
+	linRead *protos.LinRead
+
+	edges    map[uint64][]searchEdge
+	numEdges int
+
+	// heuristic is a geo predicate whose distance to the target is added to the cost of
+	// the nodes, making the search an A* search.
+	heuristic string
+	locs      map[uint64]*geom.Point
+
+	// The filters of @avoid and @through, and the nodes which match the latter.
+	avoid   *SubGraph
+	through *SubGraph
+	matches map[uint64]bool
+}
+
+func newPathGraph(sg *SubGraph, reverse bool) (*pathGraph, error) {
+	g := &pathGraph{
+		reverse: reverse,
+		weights: sg.Params.weights,
+		readTs:  sg.ReadTs,
+		linRead: sg.LinRead,
+		edges:   make(map[uint64][]searchEdge),
+		avoid:   sg.avoid,
+	}
+	for _, child := range sg.Children {
+		if !reverse {
+			g.children = append(g.children, child)
+			continue
+		}
+		if len(child.Filters) > 0 {
+			return nil, x.Errorf("Filters aren't supported in a bidirectional shortest path")
+		}
+		// The attr of the reverse subgraph is changed when the edges are fetched.
+		if !strings.HasPrefix(child.Attr, "~") && !schema.State().IsReversed(child.Attr) {
+			return nil, x.Errorf("Predicate %s should have @reverse for a bidirectional "+
+				"shortest path", child.Attr)
+		}
+		g.children = append(g.children, child)
+	}
+	return g, nil
+}
+
+func reverseAttr(attr string) string {
+	if strings.HasPrefix(attr, "~") {
+		return attr[1:]
+	}
+	return "~" + attr
+}
+
+// fetch gets the edges going out of the uids, or coming into them for a reverse search.
+// The edges to nodes which don't match the filters of the predicates or which match the
+// @avoid filter are skipped, unless they lead to the target.
+func (g *pathGraph) fetch(ctx context.Context, uids []uint64, target uint64) error {
+	exec := make([]*SubGraph, 0, len(g.children))
+	for _, child := range g.children {
+		temp := new(SubGraph)
+		temp.copyFiltersRecurse(child)
+		if g.reverse {
+			temp.Attr = reverseAttr(child.Attr)
+		}
+		temp.SrcUIDs = &protos.List{Uids: uids}
+		exec = append(exec, temp)
+	}
+	if err := processLevel(ctx, exec); err != nil {
+		return err
+	}
+
+	var lists []*protos.List
+	for _, uid := range uids {
+		g.edges[uid] = nil
+	}
+	for ci, e := range exec {
+		for i, from := range e.SrcUIDs.Uids {
+			for j, to := range e.uidMatrix[i].Uids {
+				if to != target && algo.IndexOf(e.DestUIDs, to) < 0 {
+					continue
+				}
+				cost, facet, err := e.getCost(i, j, g.weights)
+				if err == ErrFacet {
+					// Ignore the edge and continue.
+					continue
+				} else if err != nil {
+					return err
+				}
+				g.edges[from] = append(g.edges[from], searchEdge{
+					to: to,
+					mapItem: mapItem{
+						cost:  cost,
+						facet: facet,
+						attr:  g.children[ci].Attr,
+					},
+				})
+				g.numEdges++
+			}
+			lists = append(lists, e.uidMatrix[i])
+		}
+	}
+	if g.numEdges > 10000000 {
+		// If we've seen too many nodes, stop the query.
+		return ErrTooBig
+	}
+
+	reached := algo.MergeSorted(lists)
+	if g.avoid != nil {
+		avoided, err := g.avoid.matching(ctx, reached)
+		if err != nil {
+			return err
+		}
+		for _, uid := range uids {
+			edges := g.edges[uid][:0]
+			for _, e := range g.edges[uid] {
+				if e.to == target || algo.IndexOf(avoided, e.to) < 0 {
+					edges = append(edges, e)
+				}
+			}
+			g.edges[uid] = edges
+		}
+		reached = algo.Difference(reached, avoided)
+	}
+	if err := g.fetchLocations(ctx, reached.Uids); err != nil {
+		return err
+	}
+	return g.fetchMatches(ctx, reached.Uids)
+}
+
+// fetchLocations gets the values of the heuristic predicate of the uids which don't have
+// them yet.
+func (g *pathGraph) fetchLocations(ctx context.Context, uids []uint64) error {
+	if g.heuristic == "" {
+		return nil
+	}
+	var missing []uint64
+	for _, uid := range uids {
+		if _, ok := g.locs[uid]; !ok {
+			g.locs[uid] = nil
+			missing = append(missing, uid)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i] < missing[j] })
+	temp := &SubGraph{
+		Attr:    g.heuristic,
+		SrcUIDs: &protos.List{Uids: missing},
+		ReadTs:  g.readTs,
+		LinRead: g.linRead,
+	}
+	if err := processLevel(ctx, []*SubGraph{temp}); err != nil {
+		return err
+	}
+	for i, uid := range missing {
+		if i >= len(temp.valueMatrix) || len(temp.valueMatrix[i].Values) == 0 {
+			continue
+		}
+		tv := temp.valueMatrix[i].Values[0]
+		if types.TypeID(tv.ValType) != types.GeoID {
+			continue
+		}
+		src := types.ValueForType(types.BinaryID)
+		src.Value = tv.Val
+		gc, err := types.Convert(src, types.GeoID)
+		if err != nil {
+			continue
+		}
+		if p, ok := gc.Value.(*geom.Point); ok {
+			g.locs[uid] = p
+		}
+	}
+	return nil
+}
+
+// fetchMatches finds which of the uids match the @through filter.
+func (g *pathGraph) fetchMatches(ctx context.Context, uids []uint64) error {
+	if g.through == nil || len(uids) == 0 {
+		return nil
+	}
+	matched, err := g.through.matching(ctx, &protos.List{Uids: uids})
+	if err != nil {
+		return err
+	}
+	for _, uid := range matched.Uids {
+		g.matches[uid] = true
+	}
+	return nil
+}
+
+// pathSearch is a Dijkstra search from one end of a shortest path over a pathGraph.
+type pathSearch struct {
+	*pathGraph
+	// target is the node at the other end of the path.
+	target  uint64
+	maxHops int
+
+	pq   priorityQueue
+	dist map[uint64]nodeInfo
+}
+
+func newPathSearch(g *pathGraph, target uint64, maxHops int) *pathSearch {
+	if maxHops == 0 {
+		maxHops = int(math.MaxInt32)
+	}
+	return &pathSearch{
+		pathGraph: g,
+		target:    target,
+		maxHops:   maxHops,
+		dist:      make(map[uint64]nodeInfo),
+	}
+}
+
+// push adds the item to the queue, with the cost of the path to it in info.
+func (s *pathSearch) push(item *Item, info nodeInfo) {
+	info.node = item
+	item.cost = info.cost + s.estimate(item.uid)
+	heap.Push(&s.pq, item)
+	s.dist[item.uid] = info
+}
+
+// estimate returns the distance between the locations of the node and the target, or 0 if
+// either of them doesn't have one.
+func (s *pathSearch) estimate(uid uint64) float64 {
+	if s.heuristic == "" {
+		return 0
+	}
+	from, to := s.locs[uid], s.locs[s.target]
+	if from == nil || to == nil {
+		return 0
+	}
+	return float64(types.PointDistance(from, to))
+}
+
+// minCost returns the lowest cost in the queue, which the cost of any path not yet found
+// through the nodes in it is at least. The items left behind by cheaper paths to their
+// nodes are dropped from the top of the queue, so that they aren't counted.
+func (s *pathSearch) minCost() float64 {
+	for s.pq.Len() > 0 {
+		if item := s.pq[0]; s.dist[item.uid].node == item {
+			return item.cost
+		}
+		heap.Pop(&s.pq)
+	}
+	return math.Inf(1)
+}
+
+// needsEdges returns true if the edges of the item have to be fetched to go on from it.
+// Paths end at the target, so its edges are never needed.
+func (s *pathSearch) needsEdges(item *Item) bool {
+	if _, ok := s.edges[item.uid]; ok {
+		return false
+	}
+	return item.hop < s.maxHops && item.uid != s.target
+}
+
+// pathFetchBatch is the most nodes whose edges are fetched at once by a search.
+const pathFetchBatch = 16
+
+// next pops the node with the lowest cost from the queue. If its edges haven't been
+// fetched, they are fetched along with those of the cheapest nodes left in the queue, up
+// to pathFetchBatch nodes. It returns nil once the queue is empty.
+func (s *pathSearch) next(ctx context.Context) (*Item, error) {
+	for s.pq.Len() > 0 {
+		item := heap.Pop(&s.pq).(*Item)
+		if s.dist[item.uid].node != item {
+			// A cheaper path to the node was found after this one was pushed.
+			continue
+		}
+		if !s.needsEdges(item) {
+			return item, nil
+		}
+		uids := append([]uint64{item.uid}, s.cheapest(pathFetchBatch-1)...)
+		sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+		if err := s.fetch(ctx, uids, s.target); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+	return nil, nil
+}
+
+// cheapest returns up to n of the cheapest nodes in the queue whose edges are needed. The
+// heap is walked from its root in the order of the cost, so only the nodes cheaper than the
+// ones returned are looked at.
+func (s *pathSearch) cheapest(n int) []uint64 {
+	var uids []uint64
+	// cand is a heap of the indices of s.pq whose parents were already walked.
+	cand := &heapIndex{pq: s.pq}
+	if s.pq.Len() > 0 {
+		heap.Push(cand, 0)
+	}
+	for cand.Len() > 0 && len(uids) < n {
+		i := heap.Pop(cand).(int)
+		if it := s.pq[i]; s.needsEdges(it) && s.dist[it.uid].node == it {
+			uids = append(uids, it.uid)
+		}
+		for _, c := range []int{2*i + 1, 2*i + 2} {
+			if c < s.pq.Len() {
+				heap.Push(cand, c)
+			}
+		}
+	}
+	return uids
+}
+
+// heapIndex is a heap of indices of a priorityQueue, ordered by the cost of their items.
+type heapIndex struct {
+	pq  priorityQueue
+	idx []int
+}
+
+func (h *heapIndex) Len() int           { return len(h.idx) }
+func (h *heapIndex) Less(i, j int) bool { return h.pq[h.idx[i]].cost < h.pq[h.idx[j]].cost }
+func (h *heapIndex) Swap(i, j int)      { h.idx[i], h.idx[j] = h.idx[j], h.idx[i] }
+func (h *heapIndex) Push(x interface{}) { h.idx = append(h.idx, x.(int)) }
+func (h *heapIndex) Pop() interface{} {
+	old := h.idx
+	n := len(old)
+	x := old[n-1]
+	h.idx = old[:n-1]
+	return x
+}
+
+// relax updates the cost of the nodes which the item has edges to, and returns the ones
+// for which a cheaper path was found. Paths end at the target, so it isn't relaxed.
+func (s *pathSearch) relax(item *Item) []uint64 {
+	if item.uid == s.target {
+		return nil
+	}
+	var updated []uint64
+	cur := s.dist[item.uid].cost
+	for _, e := range s.edges[item.uid] {
+		cost := cur + e.cost
+		if d, ok := s.dist[e.to]; ok && d.cost <= cost {
+			continue
+		}
+		info := nodeInfo{parent: item.uid, mapItem: e.mapItem}
+		info.cost = cost
+		s.push(&Item{uid: e.to, hop: item.hop + 1}, info)
+		updated = append(updated, e.to)
+	}
+	return updated
+}
+
+// path returns the nodes from the source of the search to the uid, with the predicate and
+// the facets of the edge into every one of them.
+func (s *pathSearch) path(uid uint64) []pathInfo {
+	var result []pathInfo
+	for i := 0; i <= len(s.dist); i++ {
+		info := s.dist[uid]
+		result = append(result, pathInfo{uid: uid, attr: info.attr, facet: info.facet})
+		if info.parent == 0 {
+			break
+		}
+		uid = info.parent
+	}
+	l := len(result)
+	for i := 0; i < l/2; i++ {
+		result[i], result[l-i-1] = result[l-i-1], result[i]
+	}
+	return result
+}
+
+// SearchPath finds the shortest path with a Dijkstra search which only fetches the edges
+// of the nodes it reaches. With algo astar, the search favours the nodes closer to the to
+// node by the geo predicate given as the heuristic. The distance is in meters, so the path
+// found is only the shortest if the cost of the edges is at least the distance between
+// their nodes.
+//
+// With @through, the search goes on from the nodes matching the filter in a second search,
+// and the path is the cheapest one ending in it. It can go back over the nodes it went
+// through before reaching a matching node.
+func SearchPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
+	g, err := newPathGraph(sg, false)
+	if err != nil {
+		return nil, err
+	}
+	from, to := sg.Params.From, sg.Params.To
+	if sg.Params.pathAlgo == pathAStar {
+		attr := sg.Params.heuristic
+		if attr == "" {
+			return nil, x.Errorf("A geo predicate is required as the heuristic of astar")
+		}
+		if typ, err := schema.State().TypeOf(attr); err != nil || typ != types.GeoID {
+			return nil, x.Errorf("Heuristic %s of astar should be a geo predicate", attr)
+		}
+		g.heuristic = attr
+		g.locs = make(map[uint64]*geom.Point)
+		if err := g.fetchLocations(ctx, []uint64{from, to}); err != nil {
+			return nil, err
+		}
+	}
+	s := newPathSearch(g, to, int(sg.Params.ExploreDepth))
+	s.push(&Item{uid: from}, nodeInfo{})
+	if sg.through == nil {
+		found, err := s.search(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			sg.DestUIDs = &protos.List{}
+			return nil, nil
+		}
+		return pathResult(ctx, sg, route{route: s.path(to)}), nil
+	}
+
+	g.through = sg.through
+	g.matches = make(map[uint64]bool)
+	if err := g.fetchMatches(ctx, []uint64{from}); err != nil {
+		return nil, err
+	}
+	after := newPathSearch(g, to, int(sg.Params.ExploreDepth))
+	found, err := searchThrough(ctx, s, after)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		sg.DestUIDs = &protos.List{}
+		return nil, nil
+	}
+	// The first node of the second half is the last node of the first one.
+	rest := after.path(to)
+	return pathResult(ctx, sg, route{route: append(s.path(rest[0].uid), rest[1:]...)}), nil
+}
+
+// search runs the search until the target is reached, and returns false if it can't be.
+func (s *pathSearch) search(ctx context.Context) (bool, error) {
+	for {
+		item, err := s.next(ctx)
+		if err != nil {
+			return false, err
+		}
+		if item == nil {
+			return false, nil
+		}
+		if item.uid == s.target {
+			return true, nil
+		}
+		s.relax(item)
+	}
+}
+
+// searchThrough runs the searches until the target is reached by after, and returns false
+// if it can't be. The nodes which match the @through filter are moved from s to after as
+// they are reached, and the search with the cheaper node is advanced at every step.
+func searchThrough(ctx context.Context, s, after *pathSearch) (bool, error) {
+	for s.pq.Len() > 0 || after.pq.Len() > 0 {
+		cur := s
+		if after.minCost() < s.minCost() {
+			cur = after
+		}
+		item, err := cur.next(ctx)
+		if err != nil {
+			return false, err
+		}
+		if item == nil {
+			continue
+		}
+		if cur == after && item.uid == after.target {
+			return true, nil
+		}
+		if cur == s && s.matches[item.uid] {
+			info := s.dist[item.uid]
+			if d, ok := after.dist[item.uid]; !ok || info.cost < d.cost {
+				after.push(&Item{uid: item.uid, hop: item.hop}, nodeInfo{
+					mapItem: mapItem{cost: info.cost},
+				})
+			}
+		}
+		cur.relax(item)
+	}
+	return false, nil
+}
+
+// pathResult sets the nodes of the path as the DestUIDs of the shortest block, and returns
+// the subgraph which outputs it.
+func pathResult(ctx context.Context, sg *SubGraph, r route) []*SubGraph {
+	uids := make([]uint64, 0, len(r.route))
+	for _, p := range r.route {
+		uids = append(uids, p.uid)
+	}
+	sg.DestUIDs = &protos.List{Uids: uids}
+	return createkroutesubgraph(ctx, []route{r})
+}
+
+// BidirectionalPath finds the shortest path with two Dijkstra searches, one from the from
+// node over the predicates and one from the to node over their reverse edges. The search
+// with the smaller queue is advanced at every step, until no path through the nodes left
+// in the queues can be cheaper than the one found.
+func BidirectionalPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
+	if sg.through != nil {
+		return nil, x.Errorf("@through isn't supported in a bidirectional shortest path")
+	}
+	from, to := sg.Params.From, sg.Params.To
+	fg, err := newPathGraph(sg, false)
+	if err != nil {
+		return nil, err
+	}
+	bg, err := newPathGraph(sg, true)
+	if err != nil {
+		return nil, err
+	}
+	fwd := newPathSearch(fg, to, int(sg.Params.ExploreDepth))
+	bwd := newPathSearch(bg, from, int(sg.Params.ExploreDepth))
+	fwd.push(&Item{uid: from}, nodeInfo{})
+	bwd.push(&Item{uid: to}, nodeInfo{})
+
+	meet, err := meetSearches(ctx, fwd, bwd, from)
+	if err != nil {
+		return nil, err
+	}
+	if meet == 0 {
+		sg.DestUIDs = &protos.List{}
+		return nil, nil
+	}
+	return pathResult(ctx, sg, route{route: joinPaths(fwd, bwd, meet, to)}), nil
+}
+
+// meetSearches advances the search with the smaller queue until no path through the nodes
+// left in the queues can be cheaper than the cheapest one found, and returns the node where
+// the two halves of that path meet, or 0 if there is no path.
+func meetSearches(ctx context.Context, fwd, bwd *pathSearch, from uint64) (uint64, error) {
+	best := math.Inf(1)
+	var meet uint64
+	check := func(s, other *pathSearch, uids []uint64) {
+		for _, uid := range uids {
+			o, ok := other.dist[uid]
+			if !ok {
+				continue
+			}
+			d := s.dist[uid]
+			if d.node.hop+o.node.hop > s.maxHops {
+				continue
+			}
+			if cost := d.cost + o.cost; cost < best {
+				best = cost
+				meet = uid
+			}
+		}
+	}
+	check(fwd, bwd, []uint64{from})
+
+	for fwd.pq.Len() > 0 && bwd.pq.Len() > 0 {
+		if fwd.minCost()+bwd.minCost() >= best {
+			break
+		}
+		s, other := fwd, bwd
+		if bwd.pq.Len() < fwd.pq.Len() {
+			s, other = bwd, fwd
+		}
+		item, err := s.next(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if item == nil {
+			break
+		}
+		check(s, other, s.relax(item))
+	}
+	return meet, nil
+}
+
+// joinPaths returns the nodes of the path through meet, along with the predicate and the
+// facets of the edge into every one of them.
+func joinPaths(fwd, bwd *pathSearch, meet, to uint64) []pathInfo {
+	result := fwd.path(meet)
+	for uid := meet; uid != to; {
+		// The reverse search reached uid over the edge from it to the next node.
+		info := bwd.dist[uid]
+		result = append(result, pathInfo{uid: info.parent, attr: info.attr, facet: info.facet})
+		uid = info.parent
+	}
+	return result
+}
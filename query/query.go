@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,6 +35,7 @@ import (
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/task"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
@@ -114,6 +116,8 @@ type params struct {
 	Count      int
 	Offset     int
 	AfterUID   uint64
+	Cursor     *protos.Cursor
+	Nulls      string // Position of the uids without a sort value: first, last or exclude.
 	DoCount    bool
 	GetUid     bool
 	Order      []*protos.Order
@@ -129,6 +133,7 @@ type params struct {
 	Recurse      bool
 	Cascade      bool
 	IgnoreReflex bool
+	WithCount    bool
 
 	From           uint64
 	To             uint64
@@ -143,8 +148,24 @@ type params struct {
 	groupbyAttrs   []gql.AttrLang
 	uidCount       string
 	numPaths       int
+	pathAlgo       string // Search algorithm of a shortest block.
+	weights        *weightRange
+	heuristic      string   // Geo predicate used by the astar search.
 	parentIds      []uint64 // This is a stack that is maintained and passed down to children.
 	IsEmpty        bool     // Won't have any SrcUids or DestUids. Only used to get aggregated vars
+
+	recurseArgs gql.RecurseArgs
+	// nodeDepths has the depth at which a recurse block first reached its nodes, when they
+	// are annotated with it.
+	nodeDepths map[uint64]uint64
+	// The filters of a predicate in a recurse block only apply to the nodes reached between
+	// minDepth and maxDepth. Zero means no limit.
+	minDepth, maxDepth uint64
+
+	// groupOrder orders and paginates the groups of a @groupby.
+	groupOrder *groupOrder
+	// members is set for the uids of the members of every group inside a @groupby.
+	members *groupMembers
 }
 
 // Function holds the information about gql functions.
@@ -179,10 +200,21 @@ type SubGraph struct {
 	Filters      []*SubGraph
 	facetsFilter *protos.FilterTree
 	MathExp      *mathTree
+	analytic     *analytic
+	paths        []route   // The paths found by a paths block.
+	avoid        *SubGraph // Nodes a shortest path can't go through.
+	through      *SubGraph // Nodes a shortest path has to go through one of.
 	Children     []*SubGraph
 
 	// destUIDs is a list of destination UIDs, after applying filters, pagination.
 	DestUIDs *protos.List
+
+	stats execStats
+	// totals has the number of uids in every list of the uidMatrix before pagination,
+	// for the @withcount directive.
+	totals []int
+	// cursor is the position of the last uid at the root of an ordered block.
+	cursor *protos.Cursor
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -193,6 +225,11 @@ func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
 	for _, filter := range sg.Filters {
 		filter.recurse(set)
 	}
+	for _, f := range []*SubGraph{sg.avoid, sg.through} {
+		if f != nil {
+			f.recurse(set)
+		}
+	}
 }
 
 func (sg *SubGraph) IsGroupBy() bool {
@@ -203,6 +240,12 @@ func (sg *SubGraph) IsInternal() bool {
 	return sg.Params.isInternal
 }
 
+// isBlockAggregate returns true if the values of the predicate of sg are aggregated over
+// the uids of its parent block, as for count(distinct pred) outside of @groupby.
+func (sg *SubGraph) isBlockAggregate() bool {
+	return !sg.IsInternal() && sg.SrcFunc != nil && isAggregatorFn(sg.SrcFunc.Name)
+}
+
 func (sg *SubGraph) createSrcFunction(gf *gql.Function) {
 	if gf == nil {
 		return
@@ -300,12 +343,19 @@ func addCount(pc *SubGraph, count uint64, dst outputNode) {
 	dst.AddValue(fieldName, c)
 }
 
+// addTotal adds the number of uids before pagination for the @withcount directive.
+func addTotal(fieldName string, total int, dst outputNode) {
+	c := types.ValueForType(types.IntID)
+	c.Value = int64(total)
+	dst.AddValue(fmt.Sprintf("total(%s)", fieldName), c)
+}
+
 func aggWithVarFieldName(pc *SubGraph) string {
 	fieldName := fmt.Sprintf("val(%v)", pc.Params.Var)
 	if len(pc.Params.NeedsVar) > 0 {
 		fieldName = fmt.Sprintf("val(%v)", pc.Params.NeedsVar[0].Name)
 		if pc.SrcFunc != nil {
-			fieldName = fmt.Sprintf("%s(%v)", pc.SrcFunc.Name, fieldName)
+			fieldName = aggFieldName(pc.SrcFunc, fieldName)
 		}
 	}
 	if pc.Params.Alias != "" {
@@ -369,7 +419,6 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 			dst.SetUID(uid, "uid")
 		}
 	}
-
 	var invalidUids map[uint64]bool
 	var facetsNode outputNode
 	// We go through all predicate children of the subprotos.
@@ -378,7 +427,11 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 			continue
 		}
 		if pc.Params.isGroupBy {
-			dst.addGroupby(pc, pc.Attr)
+			addGroupby(dst, pc, pc.Attr)
+			continue
+		}
+		if pc.isBlockAggregate() {
+			// It's added to the list of the block by the parent.
 			continue
 		}
 		if pc.IsInternal() {
@@ -403,6 +456,9 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 		ul := pc.uidMatrix[idx]
 
 		fieldName := pc.fieldName()
+		if pc.Params.WithCount && idx < len(pc.totals) {
+			addTotal(fieldName, pc.totals[idx], dst)
+		}
 		if len(pc.counts) > 0 {
 			addCount(pc, uint64(pc.counts[idx]), dst)
 		} else if pc.SrcFunc != nil && pc.SrcFunc.Name == "checkpwd" {
@@ -455,6 +511,9 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 				uc.AddValue(pc.Params.uidCount, c)
 				dst.AddListChild(fieldName, uc)
 			}
+			if _, err := addBlockAggregates(dst, pc, fieldName, ul.Uids); err != nil {
+				return err
+			}
 		} else {
 			if pc.Params.Alias == "" && len(pc.Params.Langs) > 0 {
 				fieldName += "@"
@@ -509,6 +568,12 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 		// Lets pop the stack.
 		sg.Params.parentIds = (sg.Params.parentIds)[:len(sg.Params.parentIds)-1]
 	}
+	// The node is in an annotated recurse block. It's only annotated if it has any of the
+	// predicates asked for, otherwise it's left out like in a recurse block without
+	// annotations.
+	if d, ok := sg.Params.nodeDepths[uid]; ok && !dst.IsEmpty() {
+		dst.AddValue("_depth_", types.Val{Tid: types.IntID, Value: int64(d)})
+	}
 	if facetsNode != nil && !facetsNode.IsEmpty() {
 		dst.AddMapChild("@facets", facetsNode, false)
 	}
@@ -568,6 +633,9 @@ func filterCopy(sg *SubGraph, ft *gql.FilterTree) error {
 		if !isValidFuncName(ft.Func.Name) {
 			return x.Errorf("Invalid function name : %s", ft.Func.Name)
 		}
+		if ft.Func.IsLenVar {
+			return x.Errorf("len() can only be used in the condition of a mutation")
+		}
 
 		isUidFuncWithoutVar := isUidFnWithoutVar(ft.Func)
 		if isUidFuncWithoutVar {
@@ -622,6 +690,9 @@ func uniqueKey(gchild *gql.GraphQuery) string {
 		// empty because MathExp should have atleast one of them.
 		key = fmt.Sprintf("val(%+v)", gchild.Var)
 	}
+	if gchild.Analytic != nil {
+		key = fmt.Sprintf("val(%+v)", gchild.Var)
+	}
 	if gchild.IsGroupby {
 		key += "groupby"
 	}
@@ -637,8 +708,8 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 	// sg.ReadTs = readTs
 
 	for _, gchild := range gq.Children {
-		if sg.Params.Alias == "shortest" && gchild.Expand != "" {
-			return x.Errorf("expand() not allowed inside shortest")
+		if isPathAlias(sg.Params.Alias) && gchild.Expand != "" {
+			return x.Errorf("expand() not allowed inside %s", sg.Params.Alias)
 		}
 
 		key := ""
@@ -670,6 +741,7 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 			FacetOrderDesc: gchild.FacetDesc,
 			IgnoreReflex:   sg.Params.IgnoreReflex,
 			Order:          gchild.Order,
+			WithCount:      gchild.WithCount,
 		}
 		if gchild.Facets != nil {
 			args.Facet = &protos.Param{gchild.Facets.AllKeys, gchild.Facets.Keys}
@@ -695,9 +767,22 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 		if len(args.Order) != 0 && len(args.FacetOrder) != 0 {
 			return x.Errorf("Cannot specify order at both args and facets")
 		}
+		if (args.minDepth > 0 || args.maxDepth > 0) && !sg.Params.Recurse {
+			return x.Errorf("mindepth and maxdepth can only be used in a recurse block")
+		}
+
+		attr := gchild.Attr
+		if sg.Params.isGroupBy && attr == "uid" && !gchild.IsCount {
+			// The members are ordered by the values of a predicate, which is fetched instead.
+			orderAttr, err := args.setGroupMembers()
+			if err != nil {
+				return err
+			}
+			attr = orderAttr
+		}
 
 		dst := &SubGraph{
-			Attr:   gchild.Attr,
+			Attr:   attr,
 			Params: args,
 		}
 		if gchild.MathExp != nil {
@@ -707,6 +792,19 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 			}
 			dst.MathExp = mathExp
 		}
+		if gchild.Analytic != nil {
+			a, err := newAnalytic(gchild.Analytic)
+			if err != nil {
+				return err
+			}
+			if names, ok := blockFuncs[a.name]; ok {
+				if a.fn = blockFunc(gq, a.attrs[0], names); a.fn == nil {
+					return x.Errorf("%s(%s) needs a %s function on %s in the block",
+						a.name, a.attrs[0], strings.Join(names, " or "), a.attrs[0])
+				}
+			}
+			dst.analytic = a
+		}
 
 		if gchild.Func != nil &&
 			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier()) {
@@ -766,6 +864,30 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		}
 		args.AfterUID = uint64(after)
 	}
+	if v, ok := gq.Args["cursor"]; ok {
+		if len(args.Order) == 0 {
+			return x.Errorf("Cursor can only be used along with orderasc or orderdesc")
+		}
+		if args.Offset != 0 || args.AfterUID != 0 {
+			return x.Errorf("Cursor can't be used along with offset or after")
+		}
+		cursor, err := decodeCursor(v)
+		if err != nil {
+			return err
+		}
+		args.Cursor = cursor
+	}
+	if v, ok := gq.Args["nulls"]; ok {
+		if len(args.Order) == 0 {
+			return x.Errorf("Nulls can only be used along with orderasc or orderdesc")
+		}
+		switch v {
+		case "first", "last", "exclude":
+		default:
+			return x.Errorf("Invalid value for nulls: %s. Expected first, last or exclude", v)
+		}
+		args.Nulls = v
+	}
 	if v, ok := gq.Args["depth"]; ok && (gq.Recurse ||
 		args.Alias == "shortest") {
 		from, err := strconv.ParseUint(v, 0, 64)
@@ -774,21 +896,78 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		}
 		args.ExploreDepth = from
 	}
-	if v, ok := gq.Args["numpaths"]; ok && args.Alias == "shortest" {
+	for _, k := range []string{"mindepth", "maxdepth"} {
+		v, ok := gq.Args[k]
+		if !ok {
+			continue
+		}
+		d, err := strconv.ParseUint(v, 0, 64)
+		if err != nil || d == 0 {
+			return x.Errorf("Expected a positive integer for %s. Got: %s", k, v)
+		}
+		if k == "mindepth" {
+			args.minDepth = d
+		} else {
+			args.maxDepth = d
+		}
+	}
+	if args.maxDepth > 0 && args.minDepth > args.maxDepth {
+		return x.Errorf("mindepth can't be greater than maxdepth")
+	}
+	if v, ok := gq.Args["maxhops"]; ok && args.Alias == "paths" {
+		maxHops, err := strconv.ParseUint(v, 0, 64)
+		if err != nil {
+			return err
+		}
+		args.ExploreDepth = maxHops
+	}
+	if v, ok := gq.Args["algo"]; ok && args.Alias == "shortest" {
+		if !isValidPathAlgo(v) {
+			return x.Errorf("Invalid algo %s in shortest. Expected one of %s, %s or %s",
+				v, pathDijkstra, pathBidirectional, pathAStar)
+		}
+		args.pathAlgo = v
+	}
+	if v, ok := gq.Args["heuristic"]; ok && args.Alias == "shortest" {
+		args.heuristic = v
+	}
+	_, hasMin := gq.Args["minweight"]
+	_, hasMax := gq.Args["maxweight"]
+	if (hasMin || hasMax) && args.Alias == "shortest" {
+		args.weights = &weightRange{min: math.Inf(-1), max: math.Inf(1)}
+		if v, ok := gq.Args["minweight"]; ok {
+			min, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return x.Errorf("Invalid minweight: %s", v)
+			}
+			args.weights.min = min
+		}
+		if v, ok := gq.Args["maxweight"]; ok {
+			max, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return x.Errorf("Invalid maxweight: %s", v)
+			}
+			args.weights.max = max
+		}
+		if args.weights.min > args.weights.max {
+			return x.Errorf("minweight can't be more than maxweight")
+		}
+	}
+	if v, ok := gq.Args["numpaths"]; ok && isPathAlias(args.Alias) {
 		numPaths, err := strconv.ParseUint(v, 0, 64)
 		if err != nil {
 			return err
 		}
 		args.numPaths = int(numPaths)
 	}
-	if v, ok := gq.Args["from"]; ok && args.Alias == "shortest" {
+	if v, ok := gq.Args["from"]; ok && isPathAlias(args.Alias) {
 		from, err := strconv.ParseUint(v, 0, 64)
 		if err != nil {
 			return err
 		}
 		args.From = uint64(from)
 	}
-	if v, ok := gq.Args["to"]; ok && args.Alias == "shortest" {
+	if v, ok := gq.Args["to"]; ok && isPathAlias(args.Alias) {
 		to, err := strconv.ParseUint(v, 0, 64)
 		if err != nil {
 			return err
@@ -802,6 +981,13 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		}
 		args.Count = int(first)
 	}
+	if len(gq.GroupbyArgs) > 0 {
+		order, err := newGroupOrder(gq.GroupbyArgs)
+		if err != nil {
+			return err
+		}
+		args.groupOrder = order
+	}
 	return nil
 }
 
@@ -860,6 +1046,8 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		IsEmpty:      gq.IsEmpty,
 		Order:        gq.Order,
 		Recurse:      gq.Recurse,
+		recurseArgs:  gq.RecurseArgs,
+		WithCount:    gq.WithCount,
 	}
 	if gq.Facets != nil {
 		args.Facet = &protos.Param{gq.Facets.AllKeys, gq.Facets.Keys}
@@ -890,6 +1078,9 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		if !isValidFuncName(gq.Func.Name) {
 			return nil, x.Errorf("Invalid function name : %s", gq.Func.Name)
 		}
+		if gq.Func.IsLenVar {
+			return nil, x.Errorf("len() can only be used in the condition of a mutation")
+		}
 		sg.createSrcFunction(gq.Func)
 	}
 
@@ -915,6 +1106,23 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		}
 		sg.facetsFilter = facetsFilter
 	}
+	if (gq.Avoid != nil || gq.Through != nil) && gq.Alias != "shortest" {
+		return nil, x.Errorf("@avoid and @through can only be used in a shortest block")
+	}
+	if gq.Avoid != nil {
+		f, err := pathFilter(gq.Avoid)
+		if err != nil {
+			return nil, err
+		}
+		sg.avoid = f
+	}
+	if gq.Through != nil {
+		f, err := pathFilter(gq.Through)
+		if err != nil {
+			return nil, err
+		}
+		sg.through = f
+	}
 	return sg, nil
 }
 
@@ -994,6 +1202,20 @@ type varValue struct {
 	strList []*protos.ValueList
 }
 
+// uidList returns the uids of the variable. Value variables only keep the uids as keys of
+// the value map, so they are sorted here.
+func (v varValue) uidList() *protos.List {
+	if v.Uids != nil && len(v.Uids.Uids) > 0 {
+		return v.Uids
+	}
+	uids := make([]uint64, 0, len(v.Vals))
+	for uid := range v.Vals {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	return &protos.List{uids}
+}
+
 func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (mp map[uint64]types.Val,
 	rerr error) {
 	if parent == nil {
@@ -1011,9 +1233,7 @@ func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (mp map[ui
 			return mp, nil
 		}
 
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
-		}
+		ag := newAggregator(sg.SrcFunc)
 		for _, val := range vals {
 			ag.Apply(val)
 		}
@@ -1054,9 +1274,7 @@ func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (mp map[ui
 	mp = make(map[uint64]types.Val)
 	// Go over the sibling node and aggregate.
 	for i, list := range relSG.uidMatrix {
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
-		}
+		ag := newAggregator(sg.SrcFunc)
 		for _, uid := range list.Uids {
 			if val, ok := vals[uid]; ok {
 				ag.Apply(val)
@@ -1243,6 +1461,14 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 			return x.Errorf("Missing values/constant in math expression")
 		}
 		// Put it in this node.
+	} else if sg.analytic != nil {
+		// The scores were computed while processing the parent.
+		if sg.Params.Var != "" {
+			doneVars[sg.Params.Var] = varValue{
+				Vals: sg.Params.uidToVal,
+				path: path,
+			}
+		}
 	} else if len(sg.Params.NeedsVar) > 0 {
 		// This is a var() block.
 		srcVar := sg.Params.NeedsVar[0]
@@ -1314,7 +1540,7 @@ func (sg *SubGraph) populateVarMap(doneVars map[string]varValue,
 		return nil
 	}
 	out := make([]uint64, 0, len(sg.DestUIDs.Uids))
-	if sg.Params.Alias == "shortest" {
+	if isPathAlias(sg.Params.Alias) {
 		goto AssignStep
 	}
 
@@ -1345,8 +1571,9 @@ func (sg *SubGraph) populateVarMap(doneVars map[string]varValue,
 		var exclude bool
 		for _, child := range sg.Children {
 			// For uid we dont actually populate the uidMatrix or values. So a node asking for
-			// uid would always be excluded. Therefore we skip it.
-			if child.Attr == "uid" {
+			// uid would always be excluded. Therefore we skip it. The aggregates of the block
+			// aren't values of its nodes either.
+			if child.Attr == "uid" || (!sg.IsGroupBy() && child.isBlockAggregate()) {
 				continue
 			}
 
@@ -1543,12 +1770,24 @@ func (sg *SubGraph) recursiveFillVars(doneVars map[string]varValue) error {
 			return err
 		}
 	}
+	for _, f := range []*SubGraph{sg.avoid, sg.through} {
+		if f == nil {
+			continue
+		}
+		if err = f.recursiveFillVars(doneVars); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (sg *SubGraph) fillVars(mp map[string]varValue) error {
 	lists := make([]*protos.List, 0, 3)
 	for _, v := range sg.Params.NeedsVar {
+		if v.Typ == gql.UID_VAR && sg.isSetFnArg(v.Name) {
+			// Combined below according to the set function.
+			continue
+		}
 		if l, ok := mp[v.Name]; ok {
 			if (v.Typ == gql.ANY_VAR || v.Typ == gql.LIST_VAR) && l.strList != nil {
 				// TODO: If we support value vars for list type then this needn't be true
@@ -1577,11 +1816,57 @@ func (sg *SubGraph) fillVars(mp map[string]varValue) error {
 	if err := sg.replaceVarInFunc(); err != nil {
 		return err
 	}
+	if sg.SrcFunc != nil && isSetFn(sg.SrcFunc.Name) {
+		lists = append(lists, sg.evalSetFn(mp))
+	}
 	lists = append(lists, sg.DestUIDs)
 	sg.DestUIDs = algo.MergeSorted(lists)
 	return nil
 }
 
+func (sg *SubGraph) isSetFnArg(name string) bool {
+	if sg.SrcFunc == nil || !isSetFn(sg.SrcFunc.Name) {
+		return false
+	}
+	for _, arg := range sg.SrcFunc.Args {
+		if arg.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// evalSetFn returns the intersection, difference or union of the uid variables which are
+// the arguments of the set function. Variables which aren't populated are empty.
+func (sg *SubGraph) evalSetFn(mp map[string]varValue) *protos.List {
+	lists := make([]*protos.List, 0, len(sg.SrcFunc.Args))
+	for _, arg := range sg.SrcFunc.Args {
+		l := &protos.List{}
+		if v, ok := mp[arg.Value]; ok {
+			l = v.uidList()
+		}
+		lists = append(lists, l)
+	}
+
+	switch sg.SrcFunc.Name {
+	case "intersect":
+		out := &protos.List{Uids: make([]uint64, len(lists[0].Uids))}
+		copy(out.Uids, lists[0].Uids)
+		for _, l := range lists[1:] {
+			algo.IntersectWith(out, l, out)
+		}
+		return out
+	case "difference":
+		out := lists[0]
+		for _, l := range lists[1:] {
+			out = algo.Difference(out, l)
+		}
+		return out
+	default:
+		return algo.MergeSorted(lists)
+	}
+}
+
 // eq(score,val(myscore)), we disallow vars in facets filter so we don't need to worry about
 // that as of now.
 func (sg *SubGraph) replaceVarInFunc() error {
@@ -1693,18 +1978,16 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			continue
 		}
 
-		if !worker.Config.ExpandEdge {
-			return out,
-				x.Errorf("Cannot run expand() query when ExpandEdge(--expand_edge) is false.")
-		}
-
 		if child.Params.Expand == "_all_" {
 			// Get the predicate list for expansion. Otherwise we already
 			// have the list populated.
-			child.ExpandPreds, err = getNodePredicates(ctx, sg)
+			child.ExpandPreds, err = getExpandPredicates(ctx, sg)
 			if err != nil {
 				return out, err
 			}
+		} else if !worker.Config.ExpandEdge {
+			return out,
+				x.Errorf("Cannot run expand() query when ExpandEdge(--expand_edge) is false.")
 		}
 
 		up := uniquePreds(child.ExpandPreds)
@@ -1727,9 +2010,43 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	return out, nil
 }
 
+// processTask sends the task to the workers serving its predicate. The task may fetch what
+// is left of the budget of the query, which its result is charged to, and the keys it
+// reads are collected if the query asks for them.
+func processTask(ctx context.Context, q *protos.Query) (*protos.Result, error) {
+	budget := budgetFrom(ctx)
+	q.Budget = budget.remaining()
+	rk := readKeysFrom(ctx)
+	q.ReadKeys = rk != nil
+	result, err := worker.ProcessTaskOverNetwork(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if err := budget.charge(result); err != nil {
+		return nil, err
+	}
+	rk.add(result.Keys)
+	return result, nil
+}
+
 // ProcessGraph processes the SubGraph instance accumulating result for the query
 // from different instances. Note: taskQuery is nil for root node.
 func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
+	start := time.Now()
+	errCh := make(chan error, 1)
+	processGraph(ctx, sg, parent, errCh)
+	// The time is recorded before sending the error, so that it's visible to the
+	// caller once it has received from rch.
+	sg.stats.total = time.Since(start)
+	rch <- <-errCh
+}
+
+func processGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
+	if err := ctx.Err(); err != nil {
+		// The query has been cancelled or has run past its deadline.
+		rch <- err
+		return
+	}
 	if sg.Attr == "uid" {
 		// We dont need to call ProcessGraph for uid, as we already have uids
 		// populated from parent and there is nothing to process but uidMatrix
@@ -1739,7 +2056,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		return
 	}
 	var err error
-	if parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid" {
+	if parent == nil && isUidOrSetFn(sg.SrcFunc) {
 		// I'm root and I'm using some variable that has been populated.
 		// Retain the actual order in uidMatrix. But sort the destUids.
 		if sg.SrcUIDs != nil && len(sg.SrcUIDs.Uids) != 0 {
@@ -1756,9 +2073,9 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	} else if len(sg.Attr) == 0 {
 		// This is when we have uid function in children.
-		if sg.SrcFunc != nil && sg.SrcFunc.Name == "uid" {
-			// If its a uid() filter, we just have to intersect the SrcUIDs with DestUIDs
-			// and return.
+		if isUidOrSetFn(sg.SrcFunc) {
+			// If its a uid() or a set function filter, we just have to intersect the
+			// SrcUIDs with DestUIDs and return.
 			if err := sg.fillVars(sg.Params.ParentVars); err != nil {
 				rch <- err
 				return
@@ -1793,7 +2110,8 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
-			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			taskStart := time.Now()
+			result, err := processTask(ctx, taskQuery)
 			if err != nil {
 				if tr, ok := trace.FromContext(ctx); ok {
 					tr.LazyPrintf("Error while processing task: %+v", err)
@@ -1801,6 +2119,8 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
+			sg.stats.task = time.Since(taskStart)
+			sg.stats.fromResult(result)
 
 			sg.uidMatrix = result.UidMatrix
 			sg.valueMatrix = result.ValueMatrix
@@ -1852,6 +2172,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 
 	// Run filters if any.
 	if len(sg.Filters) > 0 {
+		filterStart := time.Now()
 		// Run all filters in parallel.
 		filterChan := make(chan error, len(sg.Filters))
 		for _, filter := range sg.Filters {
@@ -1910,8 +2231,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			lists = append(lists, sg.DestUIDs)
 			sg.DestUIDs = algo.IntersectSorted(lists)
 		}
+		sg.stats.filters = time.Since(filterStart)
 	}
 
+	orderStart := time.Now()
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetOrder) == 0 {
 		// There is no ordering. Just apply pagination and return.
 		if err = sg.applyPagination(ctx); err != nil {
@@ -1922,12 +2245,13 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		// If we are asked for count, we don't need to change the order of results.
 		if !sg.Params.DoCount {
 			// We need to sort first before pagination.
-			if err = sg.applyOrderAndPagination(ctx); err != nil {
+			if err = sg.applyOrderAndPagination(ctx, parent == nil); err != nil {
 				rch <- err
 				return
 			}
 		}
 	}
+	sg.stats.order = time.Since(orderStart)
 
 	// We store any variable defined by this node in the map and pass it on
 	// to the children which might depend on it.
@@ -1963,6 +2287,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	if sg.IsGroupBy() {
 		// Add the attrs required by groupby nodes
 		for _, it := range sg.Params.groupbyAttrs {
+			if it.Var != "" {
+				// Value variables are looked up while grouping.
+				continue
+			}
 			// TODO - Throw error if Attr is of list type.
 			sg.Children = append(sg.Children, &SubGraph{
 				Attr:    it.Attr,
@@ -1976,6 +2304,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	}
 
+	childStart := time.Now()
 	childChan := make(chan error, len(sg.Children))
 	for i := 0; i < len(sg.Children); i++ {
 		child := sg.Children[i]
@@ -1985,6 +2314,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 
 		child.SrcUIDs = sg.DestUIDs // Make the connection.
+		if child.analytic != nil {
+			go processAnalytic(ctx, child, childChan)
+			continue
+		}
 		if child.IsInternal() {
 			// We dont have to execute these nodes.
 			continue
@@ -1995,7 +2328,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	var childErr error
 	// Now get all the results back.
 	for _, child := range sg.Children {
-		if child.IsInternal() {
+		if child.IsInternal() && child.analytic == nil {
 			continue
 		}
 		if err = <-childChan; err != nil {
@@ -2005,12 +2338,17 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			}
 		}
 	}
+	sg.stats.children = time.Since(childStart)
 	rch <- childErr
 }
 
 // applyWindow applies windowing to sg.sorted.
 func (sg *SubGraph) applyPagination(ctx context.Context) error {
 	params := sg.Params
+	if params.WithCount {
+		sg.updateUidMatrix()
+		sg.setTotals()
+	}
 
 	if params.Count == 0 && params.Offset == 0 { // No pagination.
 		return nil
@@ -2029,12 +2367,15 @@ func (sg *SubGraph) applyPagination(ctx context.Context) error {
 
 // applyOrderAndPagination orders each posting list by a given attribute
 // before applying pagination.
-func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
+func (sg *SubGraph) applyOrderAndPagination(ctx context.Context, isRoot bool) error {
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetOrder) == 0 {
 		return nil
 	}
 
 	sg.updateUidMatrix()
+	if sg.Params.WithCount {
+		sg.setTotals()
+	}
 
 	// See if we need to apply order based on facet.
 	if len(sg.Params.FacetOrder) != 0 {
@@ -2044,7 +2385,10 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 	for _, it := range sg.Params.NeedsVar {
 		// TODO(pawan) - Return error if user uses var order with predicates.
 		if len(sg.Params.Order) > 0 && it.Name == sg.Params.Order[0].Attr &&
-			(it.Typ == gql.VALUE_VAR) {
+			!sg.Params.Order[0].Count && (it.Typ == gql.VALUE_VAR) {
+			if sg.Params.Cursor != nil {
+				return x.Errorf("Cursor can't be used when ordering by a value variable")
+			}
 			// If the Order name is same as var name and it's a value variable, we sort using that variable.
 			return sg.sortAndPaginateUsingVar(ctx)
 		}
@@ -2062,8 +2406,15 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 		UidMatrix: sg.uidMatrix,
 		Offset:    int32(sg.Params.Offset),
 		Count:     int32(sg.Params.Count),
+		Nulls:     sg.Params.Nulls,
 		ReadTs:    sg.ReadTs,
 		LinRead:   sg.LinRead,
+		Cursor:    sg.Params.Cursor,
+		// The cursor for the next page is only returned for the root of the blocks.
+		ReturnCursors: isRoot,
+	}
+	if err := budgetFrom(ctx).chargeSort(sort); err != nil {
+		return err
 	}
 	result, err := worker.SortOverNetwork(ctx, sort)
 	if err != nil {
@@ -2072,12 +2423,24 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 
 	x.AssertTrue(len(result.UidMatrix) == len(sg.uidMatrix))
 	sg.uidMatrix = result.UidMatrix
+	if isRoot && len(result.Cursors) > 0 {
+		sg.cursor = result.Cursors[0]
+	}
 	// Update the destUids as we might have removed some UIDs for which we didn't find any values
 	// while sorting.
 	sg.updateDestUids()
 	return nil
 }
 
+// setTotals records the number of uids in every list of the uidMatrix, it's called after
+// the filters are applied and before the pagination.
+func (sg *SubGraph) setTotals() {
+	sg.totals = make([]int, len(sg.uidMatrix))
+	for i, ul := range sg.uidMatrix {
+		sg.totals[i] = len(ul.Uids)
+	}
+}
+
 func (sg *SubGraph) updateDestUids() {
 	// Update sg.destUID. Iterate over the UID matrix (which is not sorted by
 	// UID). For each element in UID matrix, we do a binary search in the
@@ -2155,21 +2518,29 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 		ul := sg.uidMatrix[i]
 		uids := make([]uint64, 0, len(ul.Uids))
 		values := make([][]types.Val, 0, len(ul.Uids))
+		var nulls []uint64
 		for _, uid := range ul.Uids {
 			v, ok := sg.Params.uidToVal[uid]
 			if !ok {
-				// We skip the UIDs which don't have a value.
+				// We skip the UIDs which don't have a value, unless nulls come first or last.
+				nulls = append(nulls, uid)
 				continue
 			}
 			values = append(values, []types.Val{v})
 			uids = append(uids, uid)
 		}
-		if len(values) == 0 {
+		if len(values) == 0 && sg.Params.Nulls == "" {
 			continue
 		}
 		if err := types.Sort(values, &protos.List{uids}, []bool{sg.Params.Order[0].Desc}); err != nil {
 			return err
 		}
+		switch sg.Params.Nulls {
+		case "first":
+			uids = append(nulls, uids...)
+		case "last":
+			uids = append(uids, nulls...)
+		}
 		sg.uidMatrix[i].Uids = uids
 	}
 
@@ -2189,7 +2560,9 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 // isValidArg checks if arg passed is valid keyword.
 func isValidArg(a string) bool {
 	switch a {
-	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth":
+	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth",
+		"cursor", "maxhops", "algo", "heuristic", "minweight", "maxweight", "mindepth", "maxdepth",
+		"nulls":
 		return true
 	}
 	return false
@@ -2199,10 +2572,25 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof":
+		"has", "uid", "uid_in", "anyof", "allof", "prefix", "match":
 		return true
 	}
-	return isInequalityFn(f) || types.IsGeoFunc(f)
+	return isInequalityFn(f) || types.IsGeoFunc(f) || isSetFn(f)
+}
+
+// isSetFn returns true for the functions which combine uid variables, like uid() they are
+// evaluated over the variables instead of a predicate.
+func isSetFn(f string) bool {
+	switch f {
+	case "intersect", "difference", "union":
+		return true
+	}
+	return false
+}
+
+// isUidOrSetFn returns true if the uids of fn come from the uids or variables given to it.
+func isUidOrSetFn(fn *Function) bool {
+	return fn != nil && (fn.Name == "uid" || isSetFn(fn.Name))
 }
 
 func isInequalityFn(f string) bool {
@@ -2215,7 +2603,7 @@ func isInequalityFn(f string) bool {
 
 func isAggregatorFn(f string) bool {
 	switch f {
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance", "distinct":
 		return true
 	}
 	return false
@@ -2226,9 +2614,15 @@ func isUidFnWithoutVar(f *gql.Function) bool {
 }
 
 func getNodePredicates(ctx context.Context, sg *SubGraph) ([]*protos.ValueList, error) {
+	return getNodeValues(ctx, sg, x.PredicateListAttr, sg.DestUIDs)
+}
+
+// getNodeValues returns the values of attr for the given uids.
+func getNodeValues(ctx context.Context, sg *SubGraph, attr string,
+	uids *protos.List) ([]*protos.ValueList, error) {
 	temp := new(SubGraph)
-	temp.Attr = "_predicate_"
-	temp.SrcUIDs = sg.DestUIDs
+	temp.Attr = attr
+	temp.SrcUIDs = uids
 	temp.ReadTs = sg.ReadTs
 	temp.LinRead = sg.LinRead
 	taskQuery, err := createTaskQuery(temp)
@@ -2242,6 +2636,67 @@ func getNodePredicates(ctx context.Context, sg *SubGraph) ([]*protos.ValueList,
 	return result.ValueMatrix, nil
 }
 
+// typeFields returns the fields of the declared types of a node, or nil if the node
+// doesn't have any type that is defined in the schema.
+func typeFields(types *protos.ValueList) *protos.ValueList {
+	var fields *protos.ValueList
+	for _, tv := range types.Values {
+		t, ok := schema.State().GetType(string(tv.Val))
+		if !ok {
+			continue
+		}
+		if fields == nil {
+			fields = &protos.ValueList{}
+		}
+		for _, f := range t.Fields {
+			fields.Values = append(fields.Values, &protos.TaskValue{Val: []byte(f)})
+		}
+	}
+	return fields
+}
+
+// getExpandPredicates returns the predicates to expand for the nodes of sg when using
+// expand(_all_). Nodes with declared types are expanded to the fields of their types,
+// other nodes fall back to the predicates they have.
+func getExpandPredicates(ctx context.Context, sg *SubGraph) ([]*protos.ValueList, error) {
+	var preds []*protos.ValueList
+	untyped := sg.DestUIDs
+	if len(schema.State().Types()) > 0 && len(sg.DestUIDs.Uids) > 0 {
+		nodeTypes, err := getNodeValues(ctx, sg, x.TypeAttr, sg.DestUIDs)
+		if err != nil {
+			return nil, err
+		}
+		untyped = &protos.List{}
+		for i, uid := range sg.DestUIDs.Uids {
+			var fields *protos.ValueList
+			if i < len(nodeTypes) {
+				fields = typeFields(nodeTypes[i])
+			}
+			if fields == nil {
+				untyped.Uids = append(untyped.Uids, uid)
+				continue
+			}
+			preds = append(preds, fields)
+		}
+	}
+	if len(untyped.Uids) == 0 && len(preds) > 0 {
+		return preds, nil
+	}
+
+	if !worker.Config.ExpandEdge {
+		if len(preds) > 0 {
+			// Only the nodes with types can be expanded.
+			return preds, nil
+		}
+		return nil, x.Errorf("Cannot run expand() query when ExpandEdge(--expand_edge) is false.")
+	}
+	rest, err := getNodeValues(ctx, sg, x.PredicateListAttr, untyped)
+	if err != nil {
+		return nil, err
+	}
+	return append(preds, rest...), nil
+}
+
 func GetAllPredicates(subGraphs []*SubGraph) (predicates []string) {
 	predicatesMap := make(map[string]bool)
 	for _, sg := range subGraphs {
@@ -2265,6 +2720,9 @@ func (sg *SubGraph) getAllPredicates(predicates map[string]bool) {
 	}
 	if len(sg.Params.groupbyAttrs) != 0 {
 		for _, pred := range sg.Params.groupbyAttrs {
+			if pred.Var != "" {
+				continue
+			}
 			predicates[pred.Attr] = true
 		}
 	}
@@ -2313,7 +2771,7 @@ func (req *QueryRequest) ProcessQuery(ctx context.Context) (err error) {
 		gq := queries[i]
 
 		if gq == nil || (len(gq.UID) == 0 && gq.Func == nil && len(gq.NeedsVar) == 0 &&
-			gq.Alias != "shortest" && !gq.IsEmpty) {
+			!isPathAlias(gq.Alias) && !gq.IsEmpty) {
 			err := x.Errorf("Invalid query, query internal id is zero and generator is nil")
 			if tr, ok := trace.FromContext(ctx); ok {
 				tr.LazyPrintf(err.Error())
@@ -2361,7 +2819,17 @@ func (req *QueryRequest) ProcessQuery(ctx context.Context) (err error) {
 		return true
 	}
 
-	var shortestSg []*SubGraph
+	var numPaths int
+	for _, sg := range req.Subgraphs {
+		if sg.Params.Alias == "paths" {
+			numPaths++
+		}
+	}
+	if numPaths > 1 {
+		return x.Errorf("Only one paths block allowed per query. Got: %d", numPaths)
+	}
+
+	var shortestSg, pathsSg []*SubGraph
 	for i := 0; i < len(req.Subgraphs) && numQueriesDone < len(req.Subgraphs); i++ {
 		errChan := make(chan error, len(req.Subgraphs))
 		var idxList []int
@@ -2396,6 +2864,12 @@ func (req *QueryRequest) ProcessQuery(ctx context.Context) (err error) {
 					shortestSg, err = ShortestPath(ctx, sg)
 					errChan <- err
 				}()
+			} else if sg.Params.Alias == "paths" {
+				go func() {
+					var perr error
+					pathsSg, perr = AllPaths(ctx, sg)
+					errChan <- perr
+				}()
 			} else if sg.Params.Recurse {
 				go func() {
 					errChan <- Recurse(ctx, sg)
@@ -2449,6 +2923,7 @@ func (req *QueryRequest) ProcessQuery(ctx context.Context) (err error) {
 	if len(shortestSg) != 0 {
 		req.Subgraphs = append(req.Subgraphs, shortestSg...)
 	}
+	req.Subgraphs = append(req.Subgraphs, pathsSg...)
 
 	// Generate lin read response.
 	dst := &protos.LinRead{}
@@ -2483,6 +2958,7 @@ func (e *InternalError) Error() string {
 type ExecuteResult struct {
 	Subgraphs  []*SubGraph
 	SchemaNode []*protos.SchemaNode
+	Types      []*protos.TypeUpdate
 }
 
 func (qr *QueryRequest) Process(ctx context.Context) (er ExecuteResult, err error) {
@@ -2492,14 +2968,39 @@ func (qr *QueryRequest) Process(ctx context.Context) (er ExecuteResult, err erro
 	}
 	er.Subgraphs = qr.Subgraphs
 
-	if qr.GqlQuery.Schema != nil {
-		if er.SchemaNode, err = worker.GetSchemaOverNetwork(ctx, qr.GqlQuery.Schema); err != nil {
-			return er, x.Wrapf(&InternalError{err: err}, "error while fetching schema")
+	if s := qr.GqlQuery.Schema; s != nil {
+		// If only types are asked for, we don't need to fetch the schema of predicates.
+		if len(s.Types) == 0 || len(s.Predicates) > 0 {
+			if er.SchemaNode, err = worker.GetSchemaOverNetwork(ctx, s); err != nil {
+				return er, x.Wrapf(&InternalError{err: err}, "error while fetching schema")
+			}
 		}
+		er.Types = getTypes(s)
 	}
 	return er, nil
 }
 
+// getTypes returns the type definitions asked for in the schema request. All types
+// are returned unless the request asks for specific predicates or types. Every group
+// stores all the types, so they are read from the local schema.
+func getTypes(s *protos.SchemaRequest) []*protos.TypeUpdate {
+	names := s.Types
+	if len(names) == 0 {
+		if len(s.Predicates) > 0 {
+			return nil
+		}
+		names = schema.State().Types()
+		sort.Strings(names)
+	}
+	var out []*protos.TypeUpdate
+	for _, name := range names {
+		if t, ok := schema.State().GetType(name); ok {
+			out = append(out, &protos.TypeUpdate{TypeName: name, Fields: t.Fields})
+		}
+	}
+	return out
+}
+
 func StripBlankNode(mp map[string]uint64) map[string]uint64 {
 	temp := make(map[string]uint64)
 	for k, v := range mp {
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net"
 	"os"
 	"reflect"
@@ -44,6 +45,7 @@ import (
 	"github.com/dgraph-io/dgraph/protos"
 
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
@@ -382,6 +384,15 @@ func populateGraph(t *testing.T) {
 	addEdgeToValue(t, "age", 10006, "25", nil)
 	addEdgeToValue(t, "name", 10007, "Elizabeth", nil)
 	addEdgeToValue(t, "age", 10007, "25", nil)
+
+	// Data to check types. 4003 doesn't have a type.
+	addEdgeToValue(t, "_type_", 4001, "Animal", nil)
+	addEdgeToValue(t, "species", 4001, "cat", nil)
+	addEdgeToValue(t, "color", 4001, "black", nil)
+	addEdgeToValue(t, "_type_", 4002, "Animal", nil)
+	addEdgeToValue(t, "species", 4002, "dog", nil)
+	addEdgeToValue(t, "species", 4003, "fish", nil)
+	addEdgeToValue(t, "color", 4003, "gold", nil)
 }
 
 func TestGetUID(t *testing.T) {
@@ -1249,6 +1260,93 @@ func TestGroupByAgg(t *testing.T) {
 		js)
 }
 
+func TestGroupByStatistics(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age) {
+					count(distinct name)
+				}
+			}
+			var(func: uid(1)) {
+				friend @groupby(school) {
+					a as stddev(age)
+					b as median(age)
+				}
+			}
+
+			schools(func: uid(a), orderasc: name) {
+				name
+				val(a)
+				val(b)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"age":17,"count(distinct name)":1},{"age":19,"count(distinct name)":1},{"age":15,"count(distinct name)":2}]}]}],"schools":[{"name":"School A","val(a)":1,"val(b)":16},{"name":"School B","val(a)":2,"val(b)":17}]}}`,
+		js)
+}
+
+func TestGroupByOrderAndMembers(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(school, orderdesc: total, first: 1) {
+					total: sum(age)
+					top: uid(orderdesc: age, first: 1)
+				}
+			}
+			all(func: uid(1)) {
+				friend @groupby(school, orderasc: count) {
+					count(uid)
+					uid
+				}
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"school":"0x1389","total":34,"top":[{"uid":"0x1f"}]}]}]}],"all":[{"friend":[{"@groupby":[{"school":"0x1388","count":2,"uid":[{"uid":"0x18"},{"uid":"0x19"}]},{"school":"0x1389","count":3,"uid":[{"uid":"0x17"},{"uid":"0x1f"},{"uid":"0x65"}]}]}]}]}}`,
+		js)
+}
+
+func TestGroupByMembersVar(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: uid(1)) {
+				friend @groupby(school) {
+					t as uid(orderasc: age, first: 1)
+				}
+			}
+
+			youngest(func: uid(t)) {
+				name
+				age
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"youngest":[{"name":"Rick Grimes","age":15},{"name":"Glenn Rhee","age":15}]}}`,
+		js)
+
+	query = `
+		{
+			me(func: uid(1)) {
+				friend @groupby(school, orderdesc: age) {
+					count(uid)
+				}
+			}
+		}
+	`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
 func TestGroupByMulti(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -1801,6 +1899,77 @@ func TestRecurseVariable2(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"},{"name":"Andrea"},{"name":"Alice"},{"name":"Bob"},{"name":"Matt"},{"name":"John"}],"me2":[{"name":"Michonne"},{"name":"Rick Grimes"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea"}]}}`, js)
 }
 
+func TestRecurseNoLoopAnnotate(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(depth: 3, loop: false, annotate: true) {
+				friend
+				name
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","_depth_":1,"friend":[{"name":"Rick Grimes","_depth_":2},{"name":"Glenn Rhee","_depth_":2},{"name":"Daryl Dixon","_depth_":2},{"name":"Andrea","_depth_":2}]}]}}`, js)
+}
+
+func TestRecurseFlat(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(depth: 4, flat: true, annotate: true) {
+				follow
+				name
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","_depth_":1},{"name":"Glenn Rhee","_depth_":2},{"name":"Andrea","_depth_":2},{"name":"Alice","_depth_":4},{"name":"Bob","_depth_":3},{"name":"John","_depth_":4}]}}`, js)
+
+	query = `
+		{
+			me(func: uid(0x01)) @recurse(flat: true) {
+				follow
+				name
+			}
+		}`
+	js = processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"},{"name":"Glenn Rhee"},{"name":"Andrea"},{"name":"Alice"},{"name":"Bob"},{"name":"Matt"},{"name":"John"}]}}`, js)
+}
+
+func TestRecurseDepthFilters(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01), depth: 3) @recurse {
+				friend(maxdepth: 2) @filter(anyofterms(name, "Rick Andrea"))
+				name
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","friend":[{"name":"Rick Grimes","friend":[{"name":"Michonne"}]},{"name":"Andrea","friend":[{"name":"Glenn Rhee"}]}]}]}}`, js)
+
+	query = `
+		{
+			me(func: uid(0x01), depth: 3) @recurse {
+				friend(mindepth: 3) @filter(anyofterms(name, "Glenn"))
+				name
+			}
+		}`
+	js = processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","friend":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea","friend":[{"name":"Glenn Rhee"}]}]}]}}`, js)
+}
+
+func TestRecurseDepthFilterErrors(t *testing.T) {
+	populateGraph(t)
+	for _, query := range []string{
+		`{ me(func: uid(0x01)) { friend(mindepth: 2) { name } } }`,
+		`{ me(func: uid(0x01)) @recurse { friend(mindepth: 3, maxdepth: 2) name } }`,
+		`{ me(func: uid(0x01)) @recurse { friend(maxdepth: 0) name } }`,
+	} {
+		_, err := processToFastJsonReq(t, query)
+		require.Error(t, err, query)
+	}
+}
+
 func TestShortestPath_ExpandError(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -2098,6 +2267,147 @@ func TestShortestPath_filter2(t *testing.T) {
 	require.JSONEq(t, `{"data": { "me": []}}`, js)
 }
 
+func TestShortestPathBidirectional(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			A as shortest(from:23, to:31, algo: bidirectional) {
+				friend
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x17","friend":[{"uid":"0x1","friend":[{"uid":"0x1f"}]}]}],"me":[{"name":"Michonne"},{"name":"Rick Grimes"},{"name":"Andrea"}]}}`,
+		js)
+}
+
+func TestShortestPathBidirectionalNoReverse(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			shortest(from:1, to:1000, algo: bidirectional) {
+				path
+			}
+		}`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "@reverse")
+}
+
+func TestShortestPathAStar(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			A as shortest(from:23, to:24, algo: astar, heuristic: loc) {
+				friend
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x17","friend":[{"uid":"0x1","friend":[{"uid":"0x18"}]}]}],"me":[{"name":"Michonne"},{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}}`,
+		js)
+}
+
+func TestShortestPathAStarErrors(t *testing.T) {
+	populateGraph(t)
+	for _, args := range []string{
+		"algo: astar",
+		"algo: astar, heuristic: name",
+		"heuristic: loc",
+		"algo: astar, heuristic: loc, numpaths: 2",
+		"algo: fastest",
+	} {
+		query := `
+		{
+			shortest(from:23, to:24, ` + args + `) {
+				friend
+			}
+		}`
+		_, err := processToFastJsonReq(t, query)
+		require.Error(t, err, args)
+	}
+}
+
+func TestShortestPathMinWeight(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			shortest(from:1000, to:1002, minweight: 0.5) {
+				path @facets(weight)
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x3e8","path":[{"uid":"0x3ea","path|weight":0.700000}]}]}}`,
+		js)
+
+	query = `
+		{
+			shortest(from:1000, to:1002, maxweight: 0.05) {
+				path @facets(weight)
+			}
+		}`
+	js = processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {}}`, js)
+}
+
+func TestShortestPathAvoid(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			A as shortest(from:1, to:1002) @avoid(anyofterms(name, "bob")) {
+				path @facets(weight)
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x1","path":[{"uid":"0x1f","path":[{"uid":"0x3e8","path":[{"uid":"0x3ea","path|weight":0.700000}],"path|weight":0.100000}],"path|weight":0.100000}]}],"me":[{"name":"Michonne"},{"name":"Andrea"},{"name":"Alice"},{"name":"Matt"}]}}`,
+		js)
+}
+
+func TestShortestPathThrough(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			A as shortest(from:1, to:1000) @through(anyofterms(name, "john")) {
+				follow
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x1","follow":[{"uid":"0x1f","follow":[{"uid":"0x3e9","follow":[{"uid":"0x3eb","follow":[{"uid":"0x3ea","follow":[{"uid":"0x3e8"}]}]}]}]}]}],"me":[{"name":"Michonne"},{"name":"Andrea"},{"name":"Alice"},{"name":"Bob"},{"name":"Matt"},{"name":"John"}]}}`,
+		js)
+}
+
+func TestShortestPathConstraintErrors(t *testing.T) {
+	populateGraph(t)
+	for _, query := range []string{
+		`{ shortest(from:1, to:1000, numpaths: 2) @through(anyofterms(name, "john")) { follow } }`,
+		`{ shortest(from:1, to:1000, algo: bidirectional) @through(anyofterms(name, "john")) { friend } }`,
+		`{ shortest(from:1, to:1000, minweight: 2, maxweight: 1) { follow } }`,
+		`{ me(func: uid(1)) @avoid(anyofterms(name, "john")) { name } }`,
+	} {
+		_, err := processToFastJsonReq(t, query)
+		require.Error(t, err, query)
+	}
+}
+
 func TestUseVarsFilterMultiId(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -3053,66 +3363,258 @@ func TestValidFulltextIndex(t *testing.T) {
 }
 
 // dob (date of birth) is not a string
-func TestFilterRegexError(t *testing.T) {
+func TestPrefix(t *testing.T) {
 	populateGraph(t)
 	query := `
     {
-      me(func: uid(0x01)) {
-        name
-        friend @filter(regexp(dob, /^[a-z A-Z]+$/)) {
-          name
-        }
+      me(func: prefix(alias, "jo")) {
+        alias
       }
     }
 `
-
-	_, err := processToFastJsonReq(t, query)
-	require.Error(t, err)
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"alias":"John Alice"},{"alias":"Bob Joe"},{"alias":"John Oliver"}]}}`, js)
 }
 
-func TestFilterRegex1(t *testing.T) {
+func TestPrefixLongerThanGrams(t *testing.T) {
 	populateGraph(t)
+	// The terms are looked up by their first three runes, the rest is checked on the values.
 	query := `
     {
-      me(func: uid(0x01)) {
-        name
-        friend @filter(regexp(name, /^[a-z A-Z]+$/)) {
-          name
-        }
+      me(func: prefix(alias, "John Oli")) {
+        alias
+      }
+      none(func: prefix(alias, "Johnny")) {
+        alias
       }
     }
 `
-
-	_, err := processToFastJsonReq(t, query)
-	require.Error(t, err)
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"alias":"John Oliver"}], "none": []}}`, js)
 }
 
-func TestFilterRegex2(t *testing.T) {
+func TestFilterPrefix(t *testing.T) {
 	populateGraph(t)
 	query := `
     {
       me(func: uid(0x01)) {
-        name
-        friend @filter(regexp(name, /^[^ao]+$/)) {
-          name
+        friend @filter(prefix(alias, "al")) {
+          alias
         }
       }
     }
 `
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"alias":"Zambo Alice"},{"alias":"John Alice"},{"alias":"Allan Matt"}]}]}}`,
+		js)
+}
 
-	_, err := processToFastJsonReq(t, query)
-	require.Error(t, err)
+func TestPrefixError(t *testing.T) {
+	populateGraph(t)
+	for _, f := range []string{`prefix(alias, "j")`, `prefix(name, "mi")`} {
+		query := `{ me(func: ` + f + `) { alias } }`
+		_, err := processToFastJsonReq(t, query)
+		require.Error(t, err, f)
+	}
 }
 
-func TestFilterRegex3(t *testing.T) {
+func TestMatch(t *testing.T) {
 	populateGraph(t)
 	query := `
     {
-      me(func: uid(0x01)) {
+      me(func: match(name, "Andreas", 1)) {
         name
-        friend @filter(regexp(name, /^Rick/)) {
-          name
-        }
+      }
+      typo(func: match(name, "Micchonne", 1)) {
+        name
+      }
+    }
+`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Andrea"}], "typo":[{"name":"Michonne"}]}}`, js)
+}
+
+func TestFilterMatch(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      me(func: uid(0x01)) {
+        friend @filter(match(name, "Glen Rhee", 1)) {
+          name
+        }
+      }
+    }
+`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"name":"Glenn Rhee"}]}]}}`, js)
+}
+
+func TestMatchOrderByDistance(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      var(func: match(name, "Andrea", 1)) {
+        d as distance(name)
+      }
+      me(func: uid(d), orderasc: val(d)) {
+        name
+        val(d)
+      }
+    }
+`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Andrea","val(d)":0},{"name":"Andre","val(d)":1}]}}`, js)
+}
+
+func TestMatchError(t *testing.T) {
+	populateGraph(t)
+	for _, q := range []string{
+		`{ me(func: match(name, "An", 1)) { name } }`,
+		`{ me(func: match(name, "Andreas", 2)) { name } }`,
+		`{ me(func: match(name, "Andrea", -1)) { name } }`,
+		`{ me(func: match(alias, "Bob Joe", 1)) { name } }`,
+		`{ me(func: uid(0x01)) { name d: distance(name) } }`,
+	} {
+		_, err := processToFastJsonReq(t, q)
+		require.Error(t, err, q)
+	}
+}
+
+func TestFullTextScore(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      var(func: anyoftext(alias, "john alice")) {
+        s as score(alias)
+      }
+      me(func: uid(s), orderdesc: val(s), first: 1) {
+        alias
+      }
+      var(func: uid(0x01)) {
+        friend @filter(anyoftext(alias, "alice oliver")) {
+          f as score(alias)
+        }
+      }
+      rare(func: uid(f), orderdesc: val(f), first: 1) {
+        alias
+      }
+    }
+`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"alias":"John Alice"}], "rare":[{"alias":"John Oliver"}]}}`, js)
+}
+
+func TestFullTextScoreError(t *testing.T) {
+	populateGraph(t)
+	for _, q := range []string{
+		`{ me(func: uid(0x01)) { friend { s as score(alias) } } }`,
+		`{ me(func: match(alias, "John Alice", 1)) { s as score(alias) } }`,
+		`{ me(func: anyoftext(alias, "john")) { s as score(alias, name) } }`,
+	} {
+		_, err := processToFastJsonReq(t, q)
+		require.Error(t, err, q)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      me(func: anyoftext(alias, "alice")) {
+        alias
+        h: highlight(alias)
+      }
+      friends(func: uid(0x01)) {
+        friend @filter(allofterms(alias, "john")) {
+          h: highlight(alias, pre: "[", post: "]")
+        }
+      }
+    }
+`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {
+		"me":[{"alias":"Zambo Alice","h":"Zambo <em>Alice</em>"},
+			{"alias":"John Alice","h":"John <em>Alice</em>"}],
+		"friends":[{"friend":[{"h":"[John] Alice"},{"h":"[John] Oliver"}]}]}}`, js)
+}
+
+func TestHighlightError(t *testing.T) {
+	populateGraph(t)
+	for _, q := range []string{
+		`{ me(func: uid(0x01)) { friend { h: highlight(alias) } } }`,
+		`{ me(func: anyoftext(alias, "alice")) { highlight(alias) } }`,
+		`{ me(func: anyoftext(alias, "alice")) { h: highlight(alias, pre: <b>) } }`,
+	} {
+		_, err := processToFastJsonReq(t, q)
+		require.Error(t, err, q)
+	}
+}
+
+func TestFilterRegexError(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      me(func: uid(0x01)) {
+        name
+        friend @filter(regexp(dob, /^[a-z A-Z]+$/)) {
+          name
+        }
+      }
+    }
+`
+
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
+func TestFilterRegex1(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      me(func: uid(0x01)) {
+        name
+        friend @filter(regexp(name, /^[a-z A-Z]+$/)) {
+          name
+        }
+      }
+    }
+`
+
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
+func TestFilterRegex2(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      me(func: uid(0x01)) {
+        name
+        friend @filter(regexp(name, /^[^ao]+$/)) {
+          name
+        }
+      }
+    }
+`
+
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
+func TestFilterRegex3(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+      me(func: uid(0x01)) {
+        name
+        friend @filter(regexp(name, /^Rick/)) {
+          name
+        }
       }
     }
 `
@@ -3722,6 +4224,103 @@ func TestToFastJSONOrderName1(t *testing.T) {
 		js)
 }
 
+func TestToFastJSONOrderNameNulls(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				friend(orderasc: name, nulls: first) {
+					uid
+					name
+				}
+			}
+		}
+	`
+
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"uid":"0x65"},{"uid":"0x1f","name":"Andrea"},{"uid":"0x19","name":"Daryl Dixon"},{"uid":"0x18","name":"Glenn Rhee"},{"uid":"0x17","name":"Rick Grimes"}]}]}}`,
+		js)
+
+	query = `
+		{
+			me(func: uid(0x01)) {
+				friend(orderdesc: name, nulls: last, first: 2, offset: 3) {
+					uid
+					name
+				}
+			}
+		}
+	`
+
+	js = processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"uid":"0x1f","name":"Andrea"},{"uid":"0x65"}]}]}}`,
+		js)
+
+	query = `
+		{
+			me(func: uid(0x01)) {
+				friend(orderdesc: name, nulls: exclude) {
+					uid
+				}
+			}
+		}
+	`
+
+	js = processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"uid":"0x17"},{"uid":"0x18"},{"uid":"0x19"},{"uid":"0x1f"}]}]}}`,
+		js)
+}
+
+func TestOrderVarNulls(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: uid(0x01, 0x17)) {
+				a as age
+			}
+
+			me(func: uid(0x01, 0x17, 0x18, 0x65), orderdesc: val(a), nulls: first) {
+				uid
+			}
+		}
+	`
+
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"uid":"0x18"},{"uid":"0x65"},{"uid":"0x1"},{"uid":"0x17"}]}}`,
+		js)
+}
+
+func TestOrderNullsError(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				friend(nulls: first) {
+					name
+				}
+			}
+		}
+	`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Nulls can only be used along with orderasc or orderdesc")
+
+	query = `
+		{
+			me(func: uid(0x01), orderasc: name, nulls: middle) {
+				name
+			}
+		}
+	`
+	_, err = processToFastJsonReq(t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid value for nulls: middle")
+}
+
 func TestToFastJSONOrderNameError(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -4976,41 +5575,171 @@ func TestNormalizeDirective(t *testing.T) {
 		js)
 }
 
-func TestNearPoint(t *testing.T) {
+func TestCSVOutput(t *testing.T) {
 	populateGraph(t)
-	query := `{
-		me(func: near(geometry, [-122.082506, 37.4249518], 1)) {
-			name
+	query := `
+		{
+			me(func: uid(0x01)) @normalize {
+				mn: name
+				friend(first: 2) {
+					n: name
+					d: dob
+				}
+			}
 		}
-	}`
+	`
+	csv, err := processToOutput(t, query, ToCSV)
+	require.NoError(t, err)
+	require.Equal(t, `mn,n,d
+Michonne,Rick Grimes,1910-01-02T00:00:00Z
+Michonne,Glenn Rhee,1909-05-05T00:00:00Z
+`, csv)
 
-	js := processToFastJSON(t, query)
-	expected := `{"data": {"me":[{"name":"Googleplex"},{"name":"SF Bay area"},{"name":"Mountain View"}]}}`
-	require.JSONEq(t, expected, js)
+	_, err = processToOutput(t, `{ me(func: uid(0x01)) { name } }`, ToCSV)
+	require.Error(t, err)
 }
 
-func TestWithinPolygon(t *testing.T) {
+func TestRDFOutput(t *testing.T) {
 	populateGraph(t)
-	query := `{
-		me(func: within(geometry, [[[-122.06, 37.37], [-122.1, 37.36], [-122.12, 37.4], [-122.11, 37.43], [-122.04, 37.43], [-122.06, 37.37]]])) {
-			name
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				friend(first: 1) {
+					name
+				}
+			}
 		}
-	}`
-	js := processToFastJSON(t, query)
-	expected := `{"data": {"me":[{"name":"Googleplex"},{"name":"Shoreline Amphitheater"}]}}`
-	require.JSONEq(t, expected, js)
+	`
+	rdf, err := processToOutput(t, query, ToRDF)
+	require.NoError(t, err)
+	require.Equal(t, `<_:uid1> <name> "Michonne"^^<xs:string> .
+<_:uid1> <friend> <_:uid17> .
+<_:uid17> <name> "Rick Grimes"^^<xs:string> .
+`, rdf)
 }
 
-func TestContainsPoint(t *testing.T) {
+func TestCursorPagination(t *testing.T) {
 	populateGraph(t)
-	query := `{
-		me(func: contains(geometry, [-122.082506, 37.4249518])) {
-			name
+	page := func(cursor string) (string, string) {
+		args := ""
+		if cursor != "" {
+			args = ", cursor: " + cursor
 		}
-	}`
+		res, err := gql.Parse(gql.Request{Str: `{
+			me(func: uid(1, 23, 24, 25, 31), orderasc: name, first: 2` + args + `) {
+				name
+			}
+		}`})
+		require.NoError(t, err)
+		queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: timestamp()}
+		require.NoError(t, queryRequest.ProcessQuery(defaultContext()))
+		js, err := ToJson(queryRequest.Latency, queryRequest.Subgraphs)
+		require.NoError(t, err)
+		cursors, err := queryRequest.Cursors()
+		require.NoError(t, err)
+		return string(js), cursors["me"]
+	}
 
-	js := processToFastJSON(t, query)
-	expected := `{"data": {"me":[{"name":"SF Bay area"},{"name":"Mountain View"}]}}`
+	js, cursor := page("")
+	require.JSONEq(t, `{"me":[{"name":"Andrea"},{"name":"Daryl Dixon"}]}`, js)
+	require.NotEmpty(t, cursor)
+	js, cursor = page(cursor)
+	require.JSONEq(t, `{"me":[{"name":"Glenn Rhee"},{"name":"Michonne"}]}`, js)
+	js, cursor = page(cursor)
+	require.JSONEq(t, `{"me":[{"name":"Rick Grimes"}]}`, js)
+	js, cursor = page(cursor)
+	require.JSONEq(t, `{"me":[]}`, js)
+	require.Empty(t, cursor)
+}
+
+func TestCursorInvalid(t *testing.T) {
+	populateGraph(t)
+	_, err := processToFastJsonReq(t, `{
+		me(func: uid(1, 23), orderasc: name, cursor: 0abc) {
+			name
+		}
+	}`)
+	require.Error(t, err)
+
+	_, err = processToFastJsonReq(t, `{
+		me(func: uid(1, 23), cursor: 0a0b) {
+			name
+		}
+	}`)
+	require.Error(t, err)
+}
+
+func TestWithCount(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1, 23, 24, 25, 31), first: 2, offset: 1) @withcount {
+				name
+				friend(first: 1) @withcount {
+					name
+				}
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"total(me)":5,"me":[{"name":"Rick Grimes","total(friend)":1,"friend":[{"name":"Michonne"}]},{"name":"Glenn Rhee","total(friend)":0}]}}`,
+		js)
+}
+
+func TestWithCountOrderAndFilter(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend(orderasc: name, first: 2) @withcount @filter(not anyofterms(name, "Rick")) {
+					name
+				}
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"total(friend)":4,"friend":[{"name":"Andrea"},{"name":"Daryl Dixon"}]}]}}`,
+		js)
+}
+
+func TestNearPoint(t *testing.T) {
+	populateGraph(t)
+	query := `{
+		me(func: near(geometry, [-122.082506, 37.4249518], 1)) {
+			name
+		}
+	}`
+
+	js := processToFastJSON(t, query)
+	expected := `{"data": {"me":[{"name":"Googleplex"},{"name":"SF Bay area"},{"name":"Mountain View"}]}}`
+	require.JSONEq(t, expected, js)
+}
+
+func TestWithinPolygon(t *testing.T) {
+	populateGraph(t)
+	query := `{
+		me(func: within(geometry, [[[-122.06, 37.37], [-122.1, 37.36], [-122.12, 37.4], [-122.11, 37.43], [-122.04, 37.43], [-122.06, 37.37]]])) {
+			name
+		}
+	}`
+	js := processToFastJSON(t, query)
+	expected := `{"data": {"me":[{"name":"Googleplex"},{"name":"Shoreline Amphitheater"}]}}`
+	require.JSONEq(t, expected, js)
+}
+
+func TestContainsPoint(t *testing.T) {
+	populateGraph(t)
+	query := `{
+		me(func: contains(geometry, [-122.082506, 37.4249518])) {
+			name
+		}
+	}`
+
+	js := processToFastJSON(t, query)
+	expected := `{"data": {"me":[{"name":"SF Bay area"},{"name":"Mountain View"}]}}`
 	require.JSONEq(t, expected, js)
 }
 
@@ -5546,10 +6275,66 @@ func TestSchemaBlock1(t *testing.T) {
 		{Predicate: "_predicate_", Type: "string"},
 		{Predicate: "salary", Type: "float"},
 		{Predicate: "password", Type: "password"},
+		{Predicate: "species", Type: "string"},
+		{Predicate: "_type_", Type: "string"},
 	}
 	checkSchemaNodes(t, expected, actual)
 }
 
+func TestGetTypes(t *testing.T) {
+	require.Equal(t, []*protos.TypeUpdate{
+		{TypeName: "Animal", Fields: []string{"species"}},
+		{TypeName: "Person", Fields: []string{"name", "age"}},
+	}, getTypes(&protos.SchemaRequest{}))
+	require.Equal(t, []*protos.TypeUpdate{
+		{TypeName: "Person", Fields: []string{"name", "age"}},
+	}, getTypes(&protos.SchemaRequest{Types: []string{"Person", "Plant"}}))
+	require.Nil(t, getTypes(&protos.SchemaRequest{Predicates: []string{"name"}}))
+}
+
+func TestTypeFields(t *testing.T) {
+	fields := typeFields(&protos.ValueList{Values: []*protos.TaskValue{
+		{Val: []byte("Person")}, {Val: []byte("Animal")}, {Val: []byte("Plant")},
+	}})
+	require.Equal(t, map[string]struct{}{"name": {}, "age": {}, "species": {}},
+		uniquePreds([]*protos.ValueList{fields}))
+	require.Nil(t, typeFields(&protos.ValueList{Values: []*protos.TaskValue{
+		{Val: []byte("Plant")},
+	}}))
+}
+
+func TestTypeFunction(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: type(Animal)) {
+				uid
+				species
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"uid":"0xfa1","species":"cat"},{"uid":"0xfa2","species":"dog"}]}}`, js)
+}
+
+func TestTypeExpandAll(t *testing.T) {
+	populateGraph(t)
+	// Nodes with a type are expanded to the fields of their type, other nodes to all
+	// the predicates they have.
+	query := `
+		{
+			me(func: uid(4001, 4002, 4003)) {
+				expand(_all_)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"species":"cat"},{"species":"dog"},{"species":"fish","color":"gold"}]}}`,
+		js)
+}
+
 func TestSchemaBlock2(t *testing.T) {
 	query := `
 		schema(pred: name) {
@@ -5626,7 +6411,7 @@ func TestSchemaBlock5(t *testing.T) {
 
 const schemaStr = `
 name                           : string @index(term, exact, trigram) @count .
-alias                          : string @index(exact, term, fulltext) .
+alias                          : string @index(exact, term, fulltext, edgengram(2, 3)) .
 dob                            : dateTime @index(year) .
 dob_day                        : dateTime @index(day) .
 film.film.initial_release_date : dateTime @index(year) .
@@ -5649,6 +6434,15 @@ occupations                    : [string] @index(term) .
 graduation                     : [dateTime] @index(year) @count .
 salary                         : float @index(float) .
 password                       : password .
+species                        : string .
+
+type Person {
+	name
+	age
+}
+type Animal {
+	species
+}
 `
 
 // Duplicate implemention as in cmd/dgraph/main_test.go
@@ -7088,6 +7882,120 @@ func TestAggregateRoot5(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"sum(val(m))":0.000000}]}}`, js)
 }
 
+func TestAggregateRootStatistics(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				median(val(a))
+				percentile(val(a), 75)
+				count(distinct val(a))
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"median(val(a))":19},{"percentile(val(a), 75)":28.5},{"count(distinct val(a))":3}]}}`, js)
+}
+
+func TestAggregateLevelStatistics(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend {
+					a as age
+				}
+				median(val(a))
+				variance(val(a))
+				count(distinct val(a))
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"age":15},{"age":15},{"age":17},{"age":19}],"median(val(a))":16,"variance(val(a))":2.75,"count(distinct val(a))":3}]}}`, js)
+}
+
+func TestCountDistinctPredicate(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1, 23, 24, 25, 31)) {
+				ages: count(distinct age)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"ages":4}]}}`, js)
+
+	query = `
+		{
+			me(func: uid(1)) {
+				name
+				friend {
+					name
+					count(distinct age)
+				}
+			}
+		}
+	`
+	js = processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","friend":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea"},{"count(distinct age)":3}]}]}}`, js)
+}
+
+func aggregate(t *testing.T, f *Function, vals ...types.Val) types.Val {
+	ag := newAggregator(f)
+	for _, v := range vals {
+		ag.Apply(v)
+	}
+	res, err := ag.Value()
+	require.NoError(t, err)
+	return res
+}
+
+func TestAggregatorStatistics(t *testing.T) {
+	ints := func(vals ...int64) []types.Val {
+		var res []types.Val
+		for _, v := range vals {
+			res = append(res, types.Val{Tid: types.IntID, Value: v})
+		}
+		return res
+	}
+	vals := ints(19, 15, 17, 15)
+	require.Equal(t, 16.0, aggregate(t, &Function{Name: "median"}, vals...).Value)
+	require.Equal(t, 17.0, aggregate(t, &Function{Name: "median"}, ints(19, 15, 17)...).Value)
+	require.Equal(t, 2.75, aggregate(t, &Function{Name: "variance"}, vals...).Value)
+	require.Equal(t, 2.0, aggregate(t, &Function{Name: "stddev"}, ints(15, 19)...).Value)
+	require.Equal(t, int64(3), aggregate(t, &Function{Name: "distinct"}, vals...).Value)
+
+	pct := func(p string) *Function {
+		return &Function{Name: "percentile", Args: []gql.Arg{{Value: p}}}
+	}
+	require.Equal(t, 15.0, aggregate(t, pct("0"), vals...).Value)
+	require.Equal(t, 19.0, aggregate(t, pct("100"), vals...).Value)
+	require.Equal(t, 18.0, aggregate(t, pct("75"), ints(15, 17, 19)...).Value)
+
+	// The values which aren't numbers are skipped.
+	str := types.Val{Tid: types.StringID, Value: "a"}
+	require.Equal(t, 17.0, aggregate(t, &Function{Name: "median"}, append(ints(19, 15, 17), str)...).Value)
+	ag := newAggregator(&Function{Name: "stddev"})
+	ag.Apply(str)
+	_, err := ag.Value()
+	require.Equal(t, ErrEmptyVal, err)
+	require.Equal(t, int64(2), aggregate(t, &Function{Name: "distinct"}, str, str,
+		types.Val{Tid: types.StringID, Value: "b"}).Value)
+}
+
+func TestAggFieldName(t *testing.T) {
+	require.Equal(t, "count(distinct age)", aggFieldName(&Function{Name: "distinct"}, "age"))
+	require.Equal(t, "percentile(val(a), 95)",
+		aggFieldName(&Function{Name: "percentile", Args: []gql.Arg{{Value: "95"}}}, "val(a)"))
+	require.Equal(t, "median(age)", aggFieldName(&Function{Name: "median"}, "age"))
+}
+
 func TestAggregateRootError(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -7443,6 +8351,66 @@ func TestMultiSort7Paginate(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Alice","age":25},{"name":"Alice","age":75},{"name":"Alice","age":75},{"name":"Bob","age":25},{"name":"Bob","age":75},{"name":"Colin","age":25},{"name":"Elizabeth","age":25}]}}`, js)
 }
 
+func TestMultiSort8Offset(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(10005, 10006, 10001, 10002, 10003, 10004, 10007, 10000), orderasc: name, orderdesc: age, first: 2, offset: 2) {
+			name
+			age
+		}
+	}`
+
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Alice","age":25},{"name":"Bob","age":75}]}}`, js)
+}
+
+func TestSortByCount(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(1, 23, 24, 25, 31), orderdesc: count(friend), orderasc: name) {
+			name
+		}
+	}`
+
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"},{"name":"Andrea"},{"name":"Rick Grimes"},{"name":"Daryl Dixon"},{"name":"Glenn Rhee"}]}}`, js)
+
+	query = `{
+		me(func: uid(1, 23, 24, 25, 31), orderdesc: count(friend), orderasc: name, first: 1, offset: 2) {
+			name
+		}
+	}`
+
+	js = processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"}]}}`, js)
+
+	query = `{
+		me(func: uid(1, 23, 24), orderasc: count(friend)) {
+			name
+		}
+	}`
+
+	js = processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"},{"name":"Rick Grimes"},{"name":"Michonne"}]}}`, js)
+}
+
+func TestSortByReverseCount(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(1)) {
+			friend(orderdesc: count(~friend), orderasc: name, first: 3) {
+				name
+			}
+		}
+	}`
+
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"name":"Glenn Rhee"},{"name":"Andrea"},{"name":"Daryl Dixon"}]}]}}`, js)
+}
+
 func TestFilterRootOverride(t *testing.T) {
 	populateGraph(t)
 
@@ -7507,60 +8475,241 @@ func TestUidVariable(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea"}]}}`, js)
 }
 
-func TestMultipleValueVarError(t *testing.T) {
+func TestUidVariableDifference(t *testing.T) {
 	populateGraph(t)
 
 	query := `{
-		var(func:ge(graduation, "1930")) {
-			o as graduation
+		var(func: uid(1)) {
+			friend {
+				f as uid
+			}
+		}
+		var(func: uid(23, 24)) {
+			g as uid
 		}
 
-		me(func: uid(o)) {
-			graduation
+		me(func: difference(f, g)) {
+			name
 		}
 	}`
 
-	ctx := defaultContext()
-	_, err := processToFastJsonReqCtx(t, query, ctx)
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "Value variables not supported for predicate with list type.")
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Daryl Dixon"},{"name":"Andrea"}]}}`, js)
 }
 
-func TestReturnEmptyBlock(t *testing.T) {
+func TestUidVariableIntersectInFilter(t *testing.T) {
 	populateGraph(t)
+
 	query := `{
-		me(func:allofterms(name, "Michonne")) @filter(eq(name, "Rick Grimes")) {
+		var(func: uid(1)) {
+			friend {
+				f as uid
+			}
+		}
+		var(func: uid(1, 23, 24)) {
+			g as uid
 		}
 
-		me2(func: eq(name, "XYZ"))
-
-		me3(func: eq(name, "Michonne")) {
+		me(func: uid(1, 23, 24, 25)) @filter(intersect(f, g)) {
 			name
 		}
 	}`
 
 	js := processToFastJSON(t, query)
-	require.JSONEq(t, `{"data": {"me":[],"me2":[],"me3":[{"name":"Michonne"}]}}`, js)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}}`, js)
 }
 
-func TestExpandVal(t *testing.T) {
-	err := schema.ParseBytes([]byte(schemaStr), 1)
-	x.Check(err)
-	addPassword(t, 1, "password", "123456")
-	// We ignore password in expand(_all_)
+func TestUidVariableUnion(t *testing.T) {
 	populateGraph(t)
-	query := `
-	{
+
+	query := `{
+		var(func: uid(23, 24)) {
+			g as uid
+		}
 		var(func: uid(1)) {
-			pred as _predicate_
+			h as uid
 		}
 
-		me(func: uid(1)) {
-			expand(val(pred))
+		me(func: union(g, h)) @filter(not difference(g, h)) {
+			name
 		}
-	}
-	`
-	js := processToFastJSON(t, query)
+	}`
+
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"}]}}`, js)
+}
+
+func TestExplainPlan(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: anyofterms(name, "Michonne")) {
+			name
+			friend @filter(has(alias)) {
+				name
+			}
+		}
+	}`
+
+	res, err := gql.Parse(gql.Request{Str: query})
+	require.NoError(t, err)
+	queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: timestamp()}
+	require.NoError(t, queryRequest.ProcessQuery(defaultContext()))
+
+	plan := queryRequest.Plan()
+	require.Len(t, plan, 1)
+	root := plan[0]
+	require.Equal(t, "me", root.Alias)
+	require.Equal(t, `anyofterms(name, "Michonne")`, root.Func)
+	require.Equal(t, "index", root.Access)
+	require.Equal(t, uint32(1), root.Group)
+	require.NotZero(t, root.UidsOut)
+	require.NotZero(t, root.Timing.TotalNs)
+
+	require.Len(t, root.Children, 2)
+	friend := root.Children[1]
+	require.Equal(t, "friend", friend.Attr)
+	require.Equal(t, "uid lookup", friend.Access)
+	require.Equal(t, root.UidsOut, friend.UidsIn)
+	require.Len(t, friend.Filters, 1)
+	require.Equal(t, "has(alias)", friend.Filters[0].Func)
+	require.Equal(t, "uid lookup", friend.Filters[0].Access)
+}
+
+func TestQueryBudgetExceeded(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(1)) {
+			friend {
+				name
+			}
+		}
+	}`
+
+	ctx := WithBudget(defaultContext(), 3)
+	_, err := processToFastJsonReqCtx(t, query, ctx)
+	require.Equal(t, worker.ErrBudgetExceeded, err)
+
+	ctx = WithBudget(defaultContext(), 100)
+	_, err = processToFastJsonReqCtx(t, query, ctx)
+	require.NoError(t, err)
+}
+
+func TestQueryCancelled(t *testing.T) {
+	populateGraph(t)
+
+	ctx, cancel := context.WithCancel(defaultContext())
+	cancel()
+	_, err := processToFastJsonReqCtx(t, `{ me(func: uid(1)) { name } }`, ctx)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestProcessStream(t *testing.T) {
+	populateGraph(t)
+	defer func(n int) { StreamPageSize = n }(StreamPageSize)
+	StreamPageSize = 2
+
+	query := `{
+		me(func: uid(23, 24, 25, 31), orderdesc: name) {
+			name
+		}
+	}`
+	res, err := gql.Parse(gql.Request{Str: query})
+	require.NoError(t, err)
+	queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: timestamp()}
+
+	var chunks []string
+	err = queryRequest.ProcessStream(defaultContext(), func(js []byte) error {
+		chunks = append(chunks, string(js))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`{"me":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}`,
+		`{"me":[{"name":"Daryl Dixon"},{"name":"Andrea"}]}`,
+	}, chunks)
+}
+
+func TestProcessStreamWithVars(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		var(func: uid(1)) {
+			f as friend
+		}
+		me(func: uid(f), first: 2) {
+			name
+		}
+	}`
+	res, err := gql.Parse(gql.Request{Str: query})
+	require.NoError(t, err)
+	queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: timestamp()}
+
+	// Queries using variables are sent in one chunk.
+	var chunks []string
+	err = queryRequest.ProcessStream(defaultContext(), func(js []byte) error {
+		chunks = append(chunks, string(js))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"me":[{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}`}, chunks)
+}
+
+func TestMultipleValueVarError(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		var(func:ge(graduation, "1930")) {
+			o as graduation
+		}
+
+		me(func: uid(o)) {
+			graduation
+		}
+	}`
+
+	ctx := defaultContext()
+	_, err := processToFastJsonReqCtx(t, query, ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Value variables not supported for predicate with list type.")
+}
+
+func TestReturnEmptyBlock(t *testing.T) {
+	populateGraph(t)
+	query := `{
+		me(func:allofterms(name, "Michonne")) @filter(eq(name, "Rick Grimes")) {
+		}
+
+		me2(func: eq(name, "XYZ"))
+
+		me3(func: eq(name, "Michonne")) {
+			name
+		}
+	}`
+
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[],"me2":[],"me3":[{"name":"Michonne"}]}}`, js)
+}
+
+func TestExpandVal(t *testing.T) {
+	err := schema.ParseBytes([]byte(schemaStr), 1)
+	x.Check(err)
+	addPassword(t, 1, "password", "123456")
+	// We ignore password in expand(_all_)
+	populateGraph(t)
+	query := `
+	{
+		var(func: uid(1)) {
+			pred as _predicate_
+		}
+
+		me(func: uid(1)) {
+			expand(val(pred))
+		}
+	}
+	`
+	js := processToFastJSON(t, query)
 	require.JSONEq(t, `{"data": {"me":[{"survival_rate":98.990000,"address":"31, 32 street, Jupiter","bin_data":"YmluLWRhdGE=","power":13.250000,"gender":"female","_xid_":"mich","alive":true,"full_name":"Michonne's large name for hashing","dob_day":"1910-01-01T00:00:00Z","graduation":"1932-01-01T00:00:00Z","age":38,"noindex_name":"Michonne's name not indexed","loc":{"type":"Point","coordinates":[1.1,2]},"name":"Michonne","sword_present":"true","dob":"1910-01-01T00:00:00Z"}]}}`, js)
 }
 
@@ -7607,3 +8756,593 @@ func TestCountPanic(t *testing.T) {
 	js := processToFastJSON(t, query)
 	require.JSONEq(t, `{"data": {"q":[{"uid":"0x1","name":"Michonne","count(name)":1},{"uid":"0x12c","count(name)":0}]}}`, js)
 }
+
+func TestAnalyticsDegree(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: uid(1, 23, 24, 25)) {
+				d as degree(friend)
+			}
+			me(func: uid(d), orderdesc: val(d), first: 2) {
+				name
+				val(d)
+				double: math(d * 2)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne","val(d)":4,"double":8},{"name":"Rick Grimes","val(d)":2,"double":4}]}}`,
+		js)
+}
+
+func TestAnalyticsPageRank(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: uid(1, 23, 24, 25, 31)) {
+				pr as pagerank(friend, iterations: 30, damping: 0.85)
+			}
+			me(func: uid(pr), orderdesc: val(pr), first: 1) {
+				name
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"}]}}`, js)
+}
+
+func TestAnalyticsBetweenness(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1, 23, 24)) {
+				name
+				central: betweenness(friend)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne","central":1.000000},{"name":"Rick Grimes","central":0.000000},{"name":"Glenn Rhee","central":0.000000}]}}`,
+		js)
+}
+
+func TestAnalyticsReadKeys(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1, 23, 24)) {
+				central: betweenness(friend)
+			}
+		}
+	`
+	ctx, readKeys := WithReadKeys(defaultContext())
+	_, err := processToFastJsonReqCtx(t, query, ctx)
+	require.NoError(t, err)
+	// The edges read by the analytics are conflict keys of an upsert.
+	require.Contains(t, readKeys(), string(x.DataKey("friend", 23)))
+}
+
+func TestAnalyticsInvalidArg(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				pr as pagerank(friend, damping: 2)
+			}
+		}
+	`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
+func TestAnalyticsComponents(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: uid(1, 1000)) {
+				c as components(friend, ~friend)
+			}
+			clusters(func: uid(c)) @groupby(val(c)) {
+				count(uid)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"clusters":[{"@groupby":[{"val(c)":"0x3e8","count":1},{"val(c)":"0x1","count":6}]}]}}`,
+		js)
+}
+
+func TestAnalyticsComponentsMaxNodes(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				c as components(friend, maxnodes: 3)
+			}
+		}
+	`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
+func TestAnalyticsMaxNodes(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1, 23, 24)) {
+				pr as pagerank(friend, maxnodes: 2)
+			}
+		}
+	`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
+func TestAnalyticsCommunities(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: uid(1)) {
+				c as communities(friend, iterations: 10)
+			}
+			me(func: uid(c)) @groupby(val(c)) {
+				count(uid)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	// Label propagation puts Michonne and all her friends in the community of Rick Grimes.
+	require.JSONEq(t,
+		`{"data": {"me":[{"@groupby":[{"val(c)":"0x17","count":6}]}]}}`,
+		js)
+}
+
+func TestPageRank(t *testing.T) {
+	ctx := context.Background()
+	// All the nodes of a cycle have the same rank.
+	rank, err := pageRank(ctx, [][]int{{1}, {2}, {0}}, 20, 0.85)
+	require.NoError(t, err)
+	for _, r := range rank {
+		require.InDelta(t, 1.0/3, r, 1e-9)
+	}
+
+	// 0 and 1 point to 2, which points to nothing.
+	rank, err = pageRank(ctx, [][]int{{2}, {2}, nil}, 50, 0.85)
+	require.NoError(t, err)
+	require.InDelta(t, rank[0], rank[1], 1e-9)
+	require.True(t, rank[2] > rank[0])
+	require.InDelta(t, 1.0, rank[0]+rank[1]+rank[2], 1e-9)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = pageRank(cctx, [][]int{{1}, {0}}, 20, 0.85)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestDegrees(t *testing.T) {
+	require.Equal(t, []int{3, 2, 1}, degrees([][]int{{1, 2}, {0}, nil}))
+}
+
+func TestBetweenness(t *testing.T) {
+	ctx := context.Background()
+	// 0 -> 1 -> 2 -> 3
+	cb, err := betweenness(ctx, [][]int{{1}, {2}, {3}, nil})
+	require.NoError(t, err)
+	require.Equal(t, []float64{0, 2, 2, 0}, cb)
+
+	// 0 reaches 3 through both 1 and 2.
+	cb, err = betweenness(ctx, [][]int{{1, 2}, {3}, {3}, nil})
+	require.NoError(t, err)
+	require.Equal(t, []float64{0, 0.5, 0.5, 0}, cb)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = betweenness(cctx, [][]int{{1}, nil})
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestNewAnalytic(t *testing.T) {
+	a, err := newAnalytic(&gql.Analytic{Name: "pagerank", Attrs: []string{"follows"},
+		Args: map[string]string{"iterations": "5"}})
+	require.NoError(t, err)
+	require.Equal(t, &analytic{name: "pagerank", attrs: []string{"follows"}, iterations: 5,
+		damping: 0.85, maxNodes: 100000}, a)
+	require.False(t, a.traverses())
+
+	a, err = newAnalytic(&gql.Analytic{Name: "betweenness", Attrs: []string{"follows"},
+		Args: map[string]string{"maxnodes": "10"}})
+	require.NoError(t, err)
+	require.Equal(t, 10, a.maxNodes)
+	require.NoError(t, a.checkNodes(10))
+	require.Error(t, a.checkNodes(11))
+
+	a, err = newAnalytic(&gql.Analytic{Name: "highlight", Attrs: []string{"description"},
+		Args: map[string]string{"pre": `"<b>"`, "snippet": "5"}})
+	require.NoError(t, err)
+	require.Equal(t, tok.HighlightOptions{Pre: "<b>", Post: "</em>", Snippet: 5}, a.highlight)
+
+	for _, a := range []*gql.Analytic{
+		{Name: "pagerank", Args: map[string]string{"iterations": "0"}},
+		{Name: "pagerank", Args: map[string]string{"iterations": "1001"}},
+		{Name: "pagerank", Args: map[string]string{"damping": "1.5"}},
+		{Name: "pagerank", Args: map[string]string{"depth": "2"}},
+		{Name: "degree", Args: map[string]string{"iterations": "2"}},
+		{Name: "components", Args: map[string]string{"maxnodes": "-1"}},
+		{Name: "components", Args: map[string]string{"damping": "0.5"}},
+		{Name: "highlight", Attrs: []string{"a"}, Args: map[string]string{"pre": "<b>"}},
+		{Name: "highlight", Attrs: []string{"a"}, Args: map[string]string{"snippet": "0"}},
+		{Name: "highlight", Attrs: []string{"a", "b"}},
+	} {
+		_, err := newAnalytic(a)
+		require.Error(t, err, "%+v", a)
+	}
+}
+
+func TestComponents(t *testing.T) {
+	// 0 -> 2, 3 -> 1, 4 -> 3 and 5 alone.
+	adj := undirected([][]int{{2}, nil, nil, {1}, {3}, {5}})
+	require.Equal(t, [][]int{{2}, {3}, {0}, {1, 4}, {3}, nil}, adj)
+	require.Equal(t, []int{0, 1, 0, 1, 1, 5}, components(adj))
+}
+
+func TestCommunities(t *testing.T) {
+	// Two cliques, 0-1-2-3 and 4-5-6-7, joined by the edge 3-4.
+	adj := undirected([][]int{{1, 2, 3}, {2, 3}, {3}, {4}, {5, 6, 7}, {6, 7}, {7}, nil})
+	ctx := context.Background()
+	labels, err := communities(ctx, adj, 20)
+	require.NoError(t, err)
+	for i := 1; i < 4; i++ {
+		require.Equal(t, labels[0], labels[i])
+		require.Equal(t, labels[4], labels[4+i])
+	}
+	require.NotEqual(t, labels[0], labels[4])
+	again, err := communities(ctx, adj, 20)
+	require.NoError(t, err)
+	require.Equal(t, labels, again)
+
+	// Without any iterations every node is in its own community.
+	labels, err = communities(ctx, adj, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7}, labels)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = communities(cctx, adj, 20)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestAllPaths(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			p as paths(from: 1, to: 24, maxhops: 3) {
+				friend
+			}
+			me(func: uid(p)) {
+				name
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_paths_":[{"path":[{"uid":"0x1"},{"uid":"0x18","via":"friend"}]},{"path":[{"uid":"0x1"},{"uid":"0x1f","via":"friend"},{"uid":"0x18","via":"friend"}]}],"me":[{"name":"Michonne"},{"name":"Glenn Rhee"},{"name":"Andrea"}]}}`,
+		js)
+}
+
+func TestAllPathsFilterAndLimits(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			paths(from: 1, to: 24, maxhops: 3) {
+				friend @filter(not anyofterms(name, "Andrea"))
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_paths_":[{"path":[{"uid":"0x1"},{"uid":"0x18","via":"friend"}]}]}}`,
+		js)
+
+	query = `
+		{
+			paths(from: 1, to: 24, maxhops: 3, numpaths: 1) {
+				friend
+			}
+		}
+	`
+	js = processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_paths_":[{"path":[{"uid":"0x1"},{"uid":"0x18","via":"friend"}]}]}}`,
+		js)
+
+	query = `
+		{
+			paths(from: 1, to: 24) {
+				friend
+			}
+		}
+	`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+}
+
+func TestAllPathsFacets(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			paths(from: 1, to: 1001, maxhops: 3) {
+				path @facets(weight)
+			}
+		}
+	`
+	js := processToFastJSON(t, query)
+	require.JSONEq(t,
+		`{"data": {"_paths_":[{"path":[{"uid":"0x1"},{"uid":"0x1f","via":"path","path|weight":0.100000},{"uid":"0x3e8","via":"path","path|weight":0.100000},{"uid":"0x3e9","via":"path","path|weight":0.100000}]}]}}`,
+		js)
+}
+
+func TestAllPathsOneBlock(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			paths(from: 1, to: 24, maxhops: 3) {
+				friend
+			}
+			paths(from: 1, to: 31, maxhops: 3) {
+				friend
+			}
+		}
+	`
+	_, err := processToFastJsonReq(t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Only one paths block allowed")
+}
+
+func edgesTo(uids ...uint64) []pathEdge {
+	var edges []pathEdge
+	for _, uid := range uids {
+		edges = append(edges, pathEdge{pathInfo: pathInfo{uid: uid, attr: "friend"}})
+	}
+	return edges
+}
+
+func routeUids(routes []route) [][]uint64 {
+	var res [][]uint64
+	for _, r := range routes {
+		var uids []uint64
+		for _, p := range r.route {
+			uids = append(uids, p.uid)
+		}
+		res = append(res, uids)
+	}
+	return res
+}
+
+func TestFindPaths(t *testing.T) {
+	adj := map[uint64][]pathEdge{
+		1: edgesTo(2, 3),
+		2: edgesTo(4, 1),
+		3: edgesTo(4, 2),
+		4: edgesTo(5),
+	}
+	routes, err := findPaths(adj, 1, 4, 3, 10)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{1, 2, 4}, {1, 3, 4}, {1, 3, 2, 4}}, routeUids(routes))
+	require.Equal(t, "friend", routes[0].route[1].attr)
+
+	routes, err = findPaths(adj, 1, 4, 2, 10)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{1, 2, 4}, {1, 3, 4}}, routeUids(routes))
+
+	routes, err = findPaths(adj, 1, 4, 3, 1)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{1, 2, 4}}, routeUids(routes))
+
+	routes, err = findPaths(adj, 4, 1, 3, 10)
+	require.NoError(t, err)
+	require.Empty(t, routes)
+}
+
+func TestFindPathsFiltered(t *testing.T) {
+	adj := map[uint64][]pathEdge{
+		1: edgesTo(2, 3),
+		2: edgesTo(4),
+		3: edgesTo(4, 2),
+	}
+	// Node 2 didn't match the filter, so paths can't go through it.
+	adj[1][0].filtered = true
+	adj[3][1].filtered = true
+	// The last node of a path doesn't have to match the filter.
+	adj[3][0].filtered = true
+	routes, err := findPaths(adj, 1, 4, 3, 10)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{1, 3, 4}}, routeUids(routes))
+}
+
+type testEdge struct {
+	from, to uint64
+	cost     float64
+}
+
+// testSearches returns the searches from both ends of a path over the edges. The edges of
+// every node are already known, so they are never fetched.
+func testSearches(edges []testEdge, from, to uint64, maxHops int) (*pathSearch, *pathSearch) {
+	fg := &pathGraph{edges: make(map[uint64][]searchEdge)}
+	bg := &pathGraph{edges: make(map[uint64][]searchEdge), reverse: true}
+	for _, e := range edges {
+		item := mapItem{attr: "road", cost: e.cost}
+		fg.edges[e.from] = append(fg.edges[e.from], searchEdge{to: e.to, mapItem: item})
+		bg.edges[e.to] = append(bg.edges[e.to], searchEdge{to: e.from, mapItem: item})
+	}
+	fwd := newPathSearch(fg, to, maxHops)
+	bwd := newPathSearch(bg, from, maxHops)
+	fwd.push(&Item{uid: from}, nodeInfo{})
+	bwd.push(&Item{uid: to}, nodeInfo{})
+	return fwd, bwd
+}
+
+func pathUids(path []pathInfo) []uint64 {
+	var uids []uint64
+	for _, p := range path {
+		uids = append(uids, p.uid)
+	}
+	return uids
+}
+
+func inQueue(s *pathSearch, uid uint64) bool {
+	for _, it := range s.pq {
+		if it.uid == uid && s.dist[uid].node == it {
+			return true
+		}
+	}
+	return false
+}
+
+var testRoads = []testEdge{
+	{1, 2, 1}, {2, 3, 1}, {3, 4, 1},
+	{1, 5, 10}, {5, 4, 1},
+	{4, 6, 1}, {6, 1, 1},
+}
+
+func TestMeetSearches(t *testing.T) {
+	fwd, bwd := testSearches(testRoads, 1, 4, math.MaxInt32)
+	meet, err := meetSearches(context.Background(), fwd, bwd, 1)
+	require.NoError(t, err)
+	result := joinPaths(fwd, bwd, meet, 4)
+	require.Equal(t, []uint64{1, 2, 3, 4}, pathUids(result))
+	for _, p := range result[1:] {
+		require.Equal(t, "road", p.attr)
+	}
+
+	// The cheapest path within two hops is the one through 5.
+	fwd, bwd = testSearches(testRoads, 1, 4, 2)
+	meet, err = meetSearches(context.Background(), fwd, bwd, 1)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 5, 4}, pathUids(joinPaths(fwd, bwd, meet, 4)))
+
+	fwd, bwd = testSearches(testRoads, 6, 2, math.MaxInt32)
+	meet, err = meetSearches(context.Background(), fwd, bwd, 6)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{6, 1, 2}, pathUids(joinPaths(fwd, bwd, meet, 2)))
+
+	fwd, bwd = testSearches(testRoads, 3, 3, math.MaxInt32)
+	meet, err = meetSearches(context.Background(), fwd, bwd, 3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, pathUids(joinPaths(fwd, bwd, meet, 3)))
+
+	fwd, bwd = testSearches(testRoads, 1, 7, math.MaxInt32)
+	meet, err = meetSearches(context.Background(), fwd, bwd, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, meet)
+}
+
+func TestAStarSearch(t *testing.T) {
+	point := func(lon float64) *geom.Point {
+		return geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{lon, 0})
+	}
+	// The nodes are a degree (about 111km) apart on the equator, and 6 is behind 1.
+	roads := []testEdge{
+		{1, 2, 120000}, {2, 3, 120000}, {3, 4, 120000},
+		{1, 6, 120000}, {6, 4, 1000000},
+	}
+	locs := map[uint64]*geom.Point{
+		1: point(0), 2: point(1), 3: point(2), 4: point(3), 6: point(-1),
+	}
+
+	s, _ := testSearches(roads, 1, 4, math.MaxInt32)
+	found, err := s.search(context.Background())
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []uint64{1, 2, 3, 4}, pathUids(s.path(4)))
+	// Without a heuristic, 6 is reached before the path through 2 is longer than it.
+	require.False(t, inQueue(s, 6))
+
+	s, _ = testSearches(roads, 1, 4, math.MaxInt32)
+	s.heuristic = "loc"
+	s.locs = locs
+	s.pq = s.pq[:0]
+	s.push(&Item{uid: 1}, nodeInfo{})
+	found, err = s.search(context.Background())
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []uint64{1, 2, 3, 4}, pathUids(s.path(4)))
+	require.True(t, inQueue(s, 6))
+}
+
+func TestSearchThrough(t *testing.T) {
+	through := func(roads []testEdge, matches ...uint64) (*pathSearch, *pathSearch) {
+		s, _ := testSearches(roads, 1, 4, math.MaxInt32)
+		s.matches = make(map[uint64]bool)
+		for _, uid := range matches {
+			s.matches[uid] = true
+		}
+		return s, newPathSearch(s.pathGraph, 4, math.MaxInt32)
+	}
+
+	s, after := through(testRoads, 5)
+	found, err := searchThrough(context.Background(), s, after)
+	require.NoError(t, err)
+	require.True(t, found)
+	rest := after.path(4)
+	require.Equal(t, []uint64{5, 4}, pathUids(rest))
+	require.Equal(t, []uint64{1, 5}, pathUids(s.path(rest[0].uid)))
+
+	// The path goes back over 2 after reaching 7.
+	roads := append([]testEdge{{2, 7, 1}, {7, 2, 1}}, testRoads...)
+	s, after = through(roads, 7)
+	found, err = searchThrough(context.Background(), s, after)
+	require.NoError(t, err)
+	require.True(t, found)
+	rest = after.path(4)
+	require.Equal(t, []uint64{7, 2, 3, 4}, pathUids(rest))
+	require.Equal(t, []uint64{1, 2, 7}, pathUids(s.path(rest[0].uid)))
+
+	// The second half reaches 30 again from 20, cheaper than from 10. The item left from 10
+	// is skipped instead of ending the search before 40 is reached.
+	roads = []testEdge{{1, 10, 1}, {1, 20, 3}, {1, 30, 2}, {10, 30, 5}, {20, 30, 1},
+		{1, 40, 7}, {40, 4, 1}}
+	s, after = through(roads, 10, 20, 40)
+	found, err = searchThrough(context.Background(), s, after)
+	require.NoError(t, err)
+	require.True(t, found)
+	rest = after.path(4)
+	require.Equal(t, []uint64{40, 4}, pathUids(rest))
+	require.Equal(t, []uint64{1, 40}, pathUids(s.path(rest[0].uid)))
+
+	// Paths can't go on from the to node, so there is none through 6.
+	s, after = through(testRoads, 6)
+	found, err = searchThrough(context.Background(), s, after)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestWeightRange(t *testing.T) {
+	var all *weightRange
+	require.True(t, all.contains(-5))
+	w := &weightRange{min: 1, max: math.Inf(1)}
+	require.True(t, w.contains(1))
+	require.False(t, w.contains(0.5))
+
+	sg := &SubGraph{}
+	_, _, err := sg.getCost(0, 0, &weightRange{min: 2, max: 3})
+	require.Equal(t, ErrFacet, err)
+	cost, _, err := sg.getCost(0, 0, w)
+	require.NoError(t, err)
+	require.Equal(t, 1.0, cost)
+}
+
+func TestCheapest(t *testing.T) {
+	s := newPathSearch(&pathGraph{edges: make(map[uint64][]searchEdge)}, 10, math.MaxInt32)
+	for uid := uint64(1); uid <= 8; uid++ {
+		s.push(&Item{uid: uid}, nodeInfo{mapItem: mapItem{cost: float64(9 - uid)}})
+	}
+	// The edges of 7 are known, and 6 was reached again with a lower cost.
+	s.edges[7] = nil
+	s.push(&Item{uid: 6}, nodeInfo{mapItem: mapItem{cost: 0.5}})
+	require.Equal(t, []uint64{6, 8, 5}, s.cheapest(3))
+	require.Len(t, s.cheapest(20), 7)
+}
@@ -20,10 +20,15 @@ package query
 import (
 	"context"
 	"math"
+	"sort"
+	"strings"
 
 	"golang.org/x/net/trace"
 
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -62,70 +67,59 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 		return err
 	}
 
-	start.Children = start.Children[:0]
-	for _, child := range startChildren {
+	// depths has the depth at which every node was first reached, the root being at depth
+	// one. It's only kept if the directive needs it.
+	args := start.Params.recurseArgs
+	var depths map[uint64]uint64
+	if args.NoLoop || args.Annotate || args.Flat {
+		depths = make(map[uint64]uint64)
+		addDepths(depths, start.DestUIDs, 1)
+	}
+	if args.Annotate {
+		start.Params.nodeDepths = depths
+	}
+	// newLevel returns the child to be expanded from the nodes at the given depth.
+	newLevel := func(child *SubGraph, src *protos.List, depth uint64) *SubGraph {
 		temp := new(SubGraph)
 		temp.copyFiltersRecurse(child)
-		temp.SrcUIDs = start.DestUIDs
+		temp.SrcUIDs = src
 		temp.Params.Var = child.Params.Var
+		if !child.filtersDepth(depth + 1) {
+			temp.Filters = temp.Filters[:0]
+		}
+		// The nodes at depth+1 are only output if their children are processed.
+		if args.Annotate && depth < maxDepth {
+			temp.Params.nodeDepths = depths
+		}
+		return temp
+	}
+
+	start.Children = start.Children[:0]
+	for _, child := range startChildren {
+		temp := newLevel(child, start.DestUIDs, 1)
 		exec = append(exec, temp)
 		start.Children = append(start.Children, temp)
 	}
 
-	dummy := &SubGraph{}
 	var depth uint64
-	for {
-		if depth >= maxDepth {
-			return nil
-		}
+	for depth < maxDepth {
 		depth++
 
-		rrch := make(chan error, len(exec))
-		for _, sg := range exec {
-			go ProcessGraph(ctx, sg, dummy, rrch)
+		if err := expandLevel(ctx, exec); err != nil {
+			return err
 		}
-
-		var recurseErr error
-		for range exec {
-			select {
-			case err = <-rrch:
-				if err != nil {
-					if tr, ok := trace.FromContext(ctx); ok {
-						tr.LazyPrintf("Error while processing child task: %+v", err)
-					}
-					if recurseErr == nil {
-						recurseErr = err
-					}
-				}
-			case <-ctx.Done():
-				if tr, ok := trace.FromContext(ctx); ok {
-					tr.LazyPrintf("Context done before full execution: %+v", ctx.Err())
-				}
-				if recurseErr == nil {
-					recurseErr = ctx.Err()
-				}
+		if args.NoLoop {
+			// Drop the edges to the nodes reached at a lower depth.
+			for _, sg := range exec {
+				sg.dropReached(depths)
 			}
 		}
-
-		if recurseErr != nil {
-			return recurseErr
-		}
-
 		for _, sg := range exec {
-			if len(sg.Filters) > 0 {
-				// We need to do this in case we had some filters.
-				sg.updateUidMatrix()
-			}
 			for _, ul := range sg.uidMatrix {
-				for range ul.Uids {
-					numEdges++
-				}
+				numEdges += len(ul.Uids)
 			}
-			if len(sg.Params.Order) > 0 || len(sg.Params.FacetOrder) > 0 {
-				// Can't use merge sort if the UIDs are not sorted.
-				sg.updateDestUids()
-			} else {
-				sg.DestUIDs = algo.MergeSorted(sg.uidMatrix)
+			if depths != nil && depth < maxDepth {
+				addDepths(depths, sg.DestUIDs, depth+1)
 			}
 		}
 
@@ -136,10 +130,7 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 				continue
 			}
 			for _, child := range startChildren {
-				temp := new(SubGraph)
-				temp.copyFiltersRecurse(child)
-				temp.SrcUIDs = sg.DestUIDs
-				temp.Params.Var = child.Params.Var
+				temp := newLevel(child, sg.DestUIDs, depth+1)
 				sg.Children = append(sg.Children, temp)
 				out = append(out, temp)
 			}
@@ -151,10 +142,139 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 		}
 
 		if len(out) == 0 {
-			return nil
+			break
 		}
 		exec = out
 	}
+
+	if args.Flat {
+		return start.flatten(ctx, startChildren, depths)
+	}
+	return nil
+}
+
+// filtersDepth returns whether the filters of a predicate in a recurse block apply to the
+// nodes it reaches at the given depth.
+func (sg *SubGraph) filtersDepth(depth uint64) bool {
+	return depth >= sg.Params.minDepth && (sg.Params.maxDepth == 0 || depth <= sg.Params.maxDepth)
+}
+
+// addDepths records the depth of the nodes in the list which weren't reached before.
+func addDepths(depths map[uint64]uint64, l *protos.List, depth uint64) {
+	for _, uid := range l.Uids {
+		if _, ok := depths[uid]; !ok {
+			depths[uid] = depth
+		}
+	}
+}
+
+// dropReached removes the edges to the nodes in depths from an expanded subgraph.
+func (sg *SubGraph) dropReached(depths map[uint64]uint64) {
+	if len(sg.DestUIDs.Uids) == 0 {
+		return
+	}
+	algo.ApplyFilter(sg.DestUIDs, func(uid uint64, idx int) bool {
+		_, ok := depths[uid]
+		return !ok
+	})
+	sg.updateUidMatrix()
+}
+
+// flatten replaces the tree of a recurse block with the list of the nodes it reached. The
+// nodes only have the predicates which aren't edges to other nodes.
+func (start *SubGraph) flatten(ctx context.Context, startChildren []*SubGraph,
+	depths map[uint64]uint64) error {
+	uids := make([]uint64, 0, len(depths))
+	for uid := range depths {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		return uids[i] < uids[j]
+	})
+	sorted := &protos.List{Uids: uids}
+
+	var exec []*SubGraph
+	start.Children = start.Children[:0]
+	for _, child := range startChildren {
+		if isEdge(child) {
+			continue
+		}
+		temp := new(SubGraph)
+		temp.copyFiltersRecurse(child)
+		temp.SrcUIDs = sorted
+		exec = append(exec, temp)
+		start.Children = append(start.Children, temp)
+	}
+	if err := expandLevel(ctx, exec); err != nil {
+		return err
+	}
+	start.uidMatrix = []*protos.List{{Uids: append([]uint64{}, uids...)}}
+	start.DestUIDs = sorted
+	return nil
+}
+
+// isEdge returns whether the values of the predicate of a subgraph are other nodes.
+func isEdge(sg *SubGraph) bool {
+	if sg.Params.DoCount || sg.IsInternal() {
+		return false
+	}
+	typ, err := schema.State().TypeOf(strings.TrimPrefix(sg.Attr, "~"))
+	return err == nil && typ == types.UidID
+}
+
+// expandLevel processes the subgraphs of one level of an expansion in parallel. The
+// subgraphs only have their SrcUIDs set, after which their uidMatrix and DestUIDs are
+// populated, with the filters applied to both.
+func expandLevel(ctx context.Context, exec []*SubGraph) error {
+	if err := processLevel(ctx, exec); err != nil {
+		return err
+	}
+	for _, sg := range exec {
+		if len(sg.Filters) > 0 {
+			// We need to do this in case we had some filters.
+			sg.updateUidMatrix()
+		}
+		if len(sg.Params.Order) > 0 || len(sg.Params.FacetOrder) > 0 {
+			// Can't use merge sort if the UIDs are not sorted.
+			sg.updateDestUids()
+		} else {
+			sg.DestUIDs = algo.MergeSorted(sg.uidMatrix)
+		}
+	}
+	return nil
+}
+
+// processLevel runs ProcessGraph on the subgraphs of one level of an expansion in
+// parallel. The filters are only applied to their DestUIDs.
+func processLevel(ctx context.Context, exec []*SubGraph) error {
+	dummy := &SubGraph{}
+	rrch := make(chan error, len(exec))
+	for _, sg := range exec {
+		go ProcessGraph(ctx, sg, dummy, rrch)
+	}
+
+	var levelErr error
+	for range exec {
+		select {
+		case err := <-rrch:
+			if err != nil {
+				if tr, ok := trace.FromContext(ctx); ok {
+					tr.LazyPrintf("Error while processing child task: %+v", err)
+				}
+				if levelErr == nil {
+					levelErr = err
+				}
+			}
+		case <-ctx.Done():
+			if tr, ok := trace.FromContext(ctx); ok {
+				tr.LazyPrintf("Context done before full execution: %+v", ctx.Err())
+			}
+			if levelErr == nil {
+				levelErr = ctx.Err()
+			}
+		}
+	}
+	return levelErr
 }
 
 func Recurse(ctx context.Context, sg *SubGraph) error {
@@ -0,0 +1,64 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func TestEvalSetFn(t *testing.T) {
+	mp := map[string]varValue{
+		"a": {Uids: &protos.List{[]uint64{1, 2, 3, 4}}},
+		"b": {Uids: &protos.List{[]uint64{2, 4, 6}}},
+		"c": {Vals: map[uint64]types.Val{
+			4: {Tid: types.IntID, Value: int64(1)},
+			1: {Tid: types.IntID, Value: int64(2)},
+		}},
+	}
+	tests := []struct {
+		fn   string
+		vars []string
+		uids []uint64
+	}{
+		{"intersect", []string{"a", "b"}, []uint64{2, 4}},
+		{"intersect", []string{"a", "b", "c"}, []uint64{4}},
+		{"difference", []string{"a", "b"}, []uint64{1, 3}},
+		{"difference", []string{"b", "a"}, []uint64{6}},
+		{"difference", []string{"a", "b", "c"}, []uint64{3}},
+		{"union", []string{"b", "c"}, []uint64{1, 2, 4, 6}},
+		{"union", []string{"b", "undefined"}, []uint64{2, 4, 6}},
+		{"intersect", []string{"a", "undefined"}, []uint64{}},
+	}
+	for _, tc := range tests {
+		sg := &SubGraph{SrcFunc: &Function{Name: tc.fn}, DestUIDs: &protos.List{}}
+		for _, v := range tc.vars {
+			sg.SrcFunc.Args = append(sg.SrcFunc.Args, gql.Arg{Value: v})
+			sg.Params.NeedsVar = append(sg.Params.NeedsVar,
+				gql.VarContext{Name: v, Typ: gql.UID_VAR})
+		}
+		require.NoError(t, sg.fillVars(mp))
+		require.Equal(t, tc.uids, sg.DestUIDs.Uids, "%s%v", tc.fn, tc.vars)
+	}
+	// The uids of the variable itself are left untouched.
+	require.Equal(t, []uint64{1, 2, 3, 4}, mp["a"].Uids.Uids)
+}
@@ -26,6 +26,7 @@ import (
 	"golang.org/x/net/trace"
 
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
@@ -99,11 +100,52 @@ type nodeInfo struct {
 	node *Item
 }
 
-func (sg *SubGraph) getCost(matrix, list int) (cost float64,
+// weightRange bounds the cost of the edges which a shortest path can go through.
+type weightRange struct {
+	min, max float64
+}
+
+// contains returns true if the cost is in the range. A nil range contains every cost.
+func (w *weightRange) contains(cost float64) bool {
+	return w == nil || (cost >= w.min && cost <= w.max)
+}
+
+// pathFilter returns a subgraph which applies the filter to the uids given to matching. It
+// is used for the @avoid and @through filters of a shortest block.
+func pathFilter(ft *gql.FilterTree) (*SubGraph, error) {
+	f := &SubGraph{}
+	if err := filterCopy(f, ft); err != nil {
+		return nil, err
+	}
+	return &SubGraph{Filters: []*SubGraph{f}}, nil
+}
+
+// matching returns the uids which match the filters of a path filter.
+func (sg *SubGraph) matching(ctx context.Context, uids *protos.List) (*protos.List, error) {
+	if len(uids.Uids) == 0 {
+		return uids, nil
+	}
+	temp := new(SubGraph)
+	temp.copyFiltersRecurse(sg)
+	temp.SrcUIDs = uids
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, temp, &SubGraph{}, rch)
+	if err := <-rch; err != nil {
+		return nil, err
+	}
+	return temp.DestUIDs, nil
+}
+
+// getCost returns the cost of an edge, which is the value of its facet if the predicate
+// has one. Edges without it, or whose cost isn't in the weight range, return ErrFacet.
+func (sg *SubGraph) getCost(matrix, list int, weights *weightRange) (cost float64,
 	fcs *protos.Facets, rerr error) {
 
 	cost = 1.0
 	if sg.Params.Facet == nil {
+		if !weights.contains(cost) {
+			rerr = ErrFacet
+		}
 		return cost, fcs, rerr
 	}
 	fcsList := sg.facetsMatrix[matrix].FacetsList
@@ -128,6 +170,9 @@ func (sg *SubGraph) getCost(matrix, list int) (cost float64,
 	} else {
 		rerr = ErrFacet
 	}
+	if rerr == nil && !weights.contains(cost) {
+		rerr = ErrFacet
+	}
 	return cost, fcs, rerr
 }
 
@@ -176,6 +221,18 @@ func (start *SubGraph) expandOut(ctx context.Context,
 			}
 		}
 
+		var avoided *protos.List
+		if start.avoid != nil {
+			var lists []*protos.List
+			for _, sg := range exec {
+				lists = append(lists, sg.uidMatrix...)
+			}
+			if avoided, err = start.avoid.matching(ctx, algo.MergeSorted(lists)); err != nil {
+				rch <- err
+				return
+			}
+		}
+
 		for _, sg := range exec {
 			select {
 			case <-ctx.Done():
@@ -192,7 +249,10 @@ func (start *SubGraph) expandOut(ctx context.Context,
 							adjacencyMap[fromUID] = make(map[uint64]mapItem)
 						}
 						// The default cost we'd use is 1.
-						cost, facet, err := sg.getCost(mIdx, lIdx)
+						if start.isAvoided(toUID, avoided) {
+							continue
+						}
+						cost, facet, err := sg.getCost(mIdx, lIdx, start.Params.weights)
 						if err == ErrFacet {
 							// Ignore the edge and continue.
 							continue
@@ -240,7 +300,7 @@ func (start *SubGraph) expandOut(ctx context.Context,
 					// in the path again.
 					algo.ApplyFilter(temp.SrcUIDs, func(uid uint64, i int) bool {
 						_, ok := adjacencyMap[uid]
-						return !ok
+						return !ok && !start.isAvoided(uid, avoided)
 					})
 					if len(temp.SrcUIDs.Uids) == 0 {
 						continue
@@ -260,6 +320,12 @@ func (start *SubGraph) expandOut(ctx context.Context,
 	}
 }
 
+// isAvoided returns true if the uid matched the @avoid filter of the shortest block. Paths
+// can't go through such nodes, but they can end at one.
+func (start *SubGraph) isAvoided(uid uint64, avoided *protos.List) bool {
+	return avoided != nil && uid != start.Params.To && algo.IndexOf(avoided, uid) >= 0
+}
+
 func (temp *SubGraph) copyFiltersRecurse(sg *SubGraph) {
 	*temp = *sg
 	temp.Children = []*SubGraph{}
@@ -445,6 +511,27 @@ func ShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		numPaths = 1
 	}
 
+	if sg.Params.heuristic != "" && sg.Params.pathAlgo != pathAStar {
+		return nil, x.Errorf("heuristic can only be used with algo %s", pathAStar)
+	}
+	switch sg.Params.pathAlgo {
+	case "", pathDijkstra:
+		if sg.through != nil {
+			if numPaths > 1 {
+				return nil, x.Errorf("numpaths can't be used along with @through")
+			}
+			return SearchPath(ctx, sg)
+		}
+	case pathBidirectional, pathAStar:
+		if numPaths > 1 {
+			return nil, x.Errorf("numpaths can't be used with algo %s", sg.Params.pathAlgo)
+		}
+		if sg.Params.pathAlgo == pathAStar {
+			return SearchPath(ctx, sg)
+		}
+		return BidirectionalPath(ctx, sg)
+	}
+
 	if numPaths > 1 {
 		return KShortestPath(ctx, sg)
 	}
@@ -0,0 +1,194 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package query
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/dgraph/y"
+)
+
+// StreamPageSize is the number of root uids of a query block which are processed
+// and encoded at a time while streaming the results.
+var StreamPageSize = 1000
+
+// ProcessStream processes the query like ProcessQuery, but instead of building the
+// whole result it calls send with the JSON result of a page of root uids at a time.
+// Every chunk is a JSON object with the alias of the query block as the key and the
+// list of nodes as the value, chunks of the same block should be concatenated by the
+// caller. Queries which can't be split into pages, like the ones using variables,
+// shortest path, recurse or aggregations are processed as a whole and sent in one chunk.
+func (req *QueryRequest) ProcessStream(ctx context.Context, send func(js []byte) error) error {
+	if !req.canStream() {
+		if err := req.ProcessQuery(ctx); err != nil {
+			return err
+		}
+		js, err := ToJson(req.Latency, req.Subgraphs)
+		if err != nil {
+			return err
+		}
+		return send(js)
+	}
+
+	linRead := &protos.LinRead{}
+	for _, gq := range req.GqlQuery.Query {
+		if err := req.streamBlock(ctx, gq, linRead, send); err != nil {
+			return err
+		}
+	}
+	req.LinRead = linRead
+	return nil
+}
+
+// canStream returns true if every query block can be processed independently of the
+// others, one page of root uids at a time.
+func (req *QueryRequest) canStream() bool {
+	for i, gq := range req.GqlQuery.Query {
+		if gq == nil {
+			return false
+		}
+		if vars := req.GqlQuery.QueryVars[i]; len(vars.Defines) > 0 || len(vars.Needs) > 0 {
+			return false
+		}
+		if isPathAlias(gq.Alias) || gq.Alias == "var" || gq.Recurse || gq.IsGroupby ||
+			gq.IsEmpty || gq.UidCount != "" || gq.WithCount || hasAnalytics(gq) {
+			return false
+		}
+	}
+	return true
+}
+
+func (req *QueryRequest) newSubGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
+	start := time.Now()
+	defer func() {
+		req.Latency.Parsing += time.Since(start)
+	}()
+
+	sg, err := ToSubGraph(ctx, gq)
+	if err != nil {
+		return nil, err
+	}
+	sg.recurse(func(sg *SubGraph) {
+		sg.ReadTs = req.ReadTs
+		sg.LinRead = req.LinRead
+	})
+	return sg, nil
+}
+
+func (req *QueryRequest) processGraph(ctx context.Context, sg *SubGraph,
+	linRead *protos.LinRead) error {
+	start := time.Now()
+	defer func() {
+		req.Latency.Processing += time.Since(start)
+	}()
+
+	errCh := make(chan error, 1)
+	ProcessGraph(ctx, sg, nil, errCh)
+	if err := <-errCh; err != nil {
+		return err
+	}
+	sg.recurse(func(s *SubGraph) {
+		y.MergeLinReads(linRead, s.LinRead)
+	})
+	return nil
+}
+
+// streamBlock first finds the root uids of the query block, with the filters, order
+// and pagination at the root applied. It then processes the rest of the block a page
+// of root uids at a time and sends the result of each page.
+func (req *QueryRequest) streamBlock(ctx context.Context, gq *gql.GraphQuery,
+	linRead *protos.LinRead, send func(js []byte) error) error {
+	if len(gq.UID) == 0 && gq.Func == nil {
+		return x.Errorf("Invalid query, query internal id is zero and generator is nil")
+	}
+	root, err := req.newSubGraph(ctx, gq)
+	if err != nil {
+		return err
+	}
+	// Only the root is processed here, the children are processed for every page.
+	root.Children = nil
+	if err = req.processGraph(ctx, root, linRead); err != nil {
+		return err
+	}
+	if len(root.Filters) > 0 {
+		root.updateUidMatrix()
+	}
+
+	var uids []uint64
+	if len(root.uidMatrix) > 0 && len(root.DestUIDs.GetUids()) > 0 {
+		uids = root.uidMatrix[0].Uids
+	}
+	if len(uids) == 0 {
+		js, err := ToJson(req.Latency, []*SubGraph{root})
+		if err != nil {
+			return err
+		}
+		return send(js)
+	}
+
+	for start := 0; start < len(uids); start += StreamPageSize {
+		end := start + StreamPageSize
+		if end > len(uids) {
+			end = len(uids)
+		}
+		sg, err := req.newSubGraph(ctx, gq)
+		if err != nil {
+			return err
+		}
+		sg.setRootUids(uids[start:end])
+		if err = req.processGraph(ctx, sg, linRead); err != nil {
+			return err
+		}
+		js, err := ToJson(req.Latency, []*SubGraph{sg})
+		if err != nil {
+			return err
+		}
+		if err = send(js); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setRootUids turns the root of the SubGraph into a uid function over the given
+// uids, which have already been filtered, ordered and paginated.
+func (sg *SubGraph) setRootUids(uids []uint64) {
+	ordered := make([]uint64, len(uids))
+	copy(ordered, uids)
+	sorted := make([]uint64, len(uids))
+	copy(sorted, uids)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	sg.Attr = ""
+	sg.SrcFunc = &Function{Name: "uid"}
+	sg.Filters = nil
+	sg.facetsFilter = nil
+	sg.Params.Order = nil
+	sg.Params.FacetOrder = ""
+	sg.Params.Count = 0
+	sg.Params.Offset = 0
+	sg.Params.AfterUID = 0
+	sg.Params.Cursor = nil
+	sg.uidMatrix = []*protos.List{{Uids: ordered}}
+	sg.SrcUIDs = &protos.List{Uids: sorted}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/gql"
+)
+
+func TestCanStream(t *testing.T) {
+	tests := []struct {
+		query  string
+		stream bool
+	}{
+		{`{ me(func: uid(1, 2)) { name friend { name } } }`, true},
+		{`{ me(func: anyofterms(name, "a")) @filter(has(age)) { name } you(func: uid(1)) { age } }`, true},
+		{`{ var(func: uid(1)) { f as friend } me(func: uid(f)) { name } }`, false},
+		{`{ me(func: uid(1)) @recurse { friend } }`, false},
+		{`{ me(func: uid(1)) { count(uid) } }`, false},
+		{`{ me(func: uid(1)) @groupby(age) { count(uid) } }`, false},
+	}
+	for _, tc := range tests {
+		res, err := gql.Parse(gql.Request{Str: tc.query})
+		require.NoError(t, err)
+		req := QueryRequest{GqlQuery: &res}
+		require.Equal(t, tc.stream, req.canStream(), tc.query)
+	}
+}
+
+func TestSetRootUids(t *testing.T) {
+	res, err := gql.Parse(gql.Request{
+		Str: `{ me(func: anyofterms(name, "a"), orderasc: name, first: 10) @filter(has(age)) { name } }`,
+	})
+	require.NoError(t, err)
+	sg, err := ToSubGraph(context.Background(), res.Query[0])
+	require.NoError(t, err)
+
+	uids := []uint64{5, 1, 3}
+	sg.setRootUids(uids)
+	require.Equal(t, "", sg.Attr)
+	require.True(t, isUidOrSetFn(sg.SrcFunc))
+	require.Empty(t, sg.Filters)
+	require.Empty(t, sg.Params.Order)
+	require.Equal(t, 0, sg.Params.Count)
+	require.Equal(t, []uint64{5, 1, 3}, sg.uidMatrix[0].Uids)
+	require.Equal(t, []uint64{1, 3, 5}, sg.SrcUIDs.Uids)
+	// The uids passed in are left as they were.
+	require.Equal(t, []uint64{5, 1, 3}, uids)
+	require.Len(t, sg.Children, 1)
+}
@@ -76,6 +76,7 @@ L:
 		case itemSubject:
 			rnq.Subject = strings.Trim(item.Val, " ")
 		case itemVarKeyword:
+			keyword := item.Val
 			it.Next()
 			if item = it.Item(); item.Typ != itemLeftRound {
 				return rnq, x.Errorf("Expected '(', found: %s", item.Val)
@@ -84,6 +85,18 @@ L:
 			if item = it.Item(); item.Typ != itemVarName {
 				return rnq, x.Errorf("Expected variable name, found: %s", item.Val)
 			}
+			name := strings.TrimSpace(item.Val)
+			if len(name) == 0 {
+				return rnq, x.Errorf("Empty variable name in %s()", keyword)
+			}
+			switch {
+			case keyword == "val":
+				rnq.ValueVar = name
+			case len(rnq.Predicate) == 0:
+				rnq.SubjectVar = name
+			default:
+				rnq.ObjectVar = name
+			}
 
 			it.Next() // parse ')'
 
@@ -94,7 +107,7 @@ L:
 			rnq.ObjectId = strings.Trim(item.Val, " ")
 
 		case itemStar:
-			if rnq.Subject == "" {
+			if rnq.Subject == "" && rnq.SubjectVar == "" {
 				rnq.Subject = x.Star
 			} else if rnq.Predicate == "" {
 				rnq.Predicate = x.Star
@@ -196,10 +209,11 @@ L:
 	if len(oval) > 0 {
 		rnq.ObjectValue = &protos.Value{&protos.Value_DefaultVal{oval}}
 	}
-	if len(rnq.Subject) == 0 || len(rnq.Predicate) == 0 {
+	if (len(rnq.Subject) == 0 && len(rnq.SubjectVar) == 0) || len(rnq.Predicate) == 0 {
 		return rnq, x.Errorf("Empty required fields in NQuad. Input: [%s]", line)
 	}
-	if len(rnq.ObjectId) == 0 && rnq.ObjectValue == nil {
+	if len(rnq.ObjectId) == 0 && rnq.ObjectValue == nil &&
+		len(rnq.ObjectVar) == 0 && len(rnq.ValueVar) == 0 {
 		return rnq, x.Errorf("No Object in NQuad. Input: [%s]", line)
 	}
 	if !sane(rnq.Subject) || !sane(rnq.Predicate) ||
@@ -817,6 +817,58 @@ var testNQuads = []struct {
 		input:       `<alice> <age> "13"^^<xs:double> (salary=NaN) .`,
 		expectedErr: true,
 	},
+	{
+		input: `uid(v) <friend> <bob> .`,
+		nq: protos.NQuad{
+			SubjectVar: "v",
+			Predicate:  "friend",
+			ObjectId:   "bob",
+		},
+	},
+	{
+		input: `<alice> <friend> uid( v ) .`,
+		nq: protos.NQuad{
+			Subject:   "alice",
+			Predicate: "friend",
+			ObjectVar: "v",
+		},
+	},
+	{
+		input: `uid(u) <friend> uid(v) .`,
+		nq: protos.NQuad{
+			SubjectVar: "u",
+			Predicate:  "friend",
+			ObjectVar:  "v",
+		},
+	},
+	{
+		input: `uid(u) <name> val(n) .`,
+		nq: protos.NQuad{
+			SubjectVar: "u",
+			Predicate:  "name",
+			ValueVar:   "n",
+		},
+	},
+	{
+		input: `uid(u) <name> * .`,
+		nq: protos.NQuad{
+			SubjectVar:  "u",
+			Predicate:   "name",
+			ObjectValue: &protos.Value{&protos.Value_DefaultVal{x.Star}},
+		},
+	},
+	{
+		input:       `val(n) <name> "alice" .`,
+		expectedErr: true,
+	},
+	{
+		input:       `uid() <name> "alice" .`,
+		expectedErr: true,
+	},
+	{
+		input:       `<alice> <name> uid(v .`,
+		expectedErr: true,
+	},
 }
 
 func TestLex(t *testing.T) {
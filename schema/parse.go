@@ -18,6 +18,8 @@
 package schema
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/lex"
@@ -35,14 +37,23 @@ func ParseBytes(s []byte, gid uint32) (rerr error) {
 		reset()
 	}
 	pstate.DeleteAll()
-	updates, err := Parse(string(s))
+	updates, typeUpdates, analyzers, err := ParseDefinitions(string(s))
 	if err != nil {
 		return err
 	}
 
+	// The analyzers are defined first, as the tokenizers of the predicates can use them.
+	for _, analyzer := range analyzers {
+		if err := State().SetAnalyzer(analyzer.Name, *analyzer); err != nil {
+			return err
+		}
+	}
 	for _, update := range updates {
 		State().Set(update.Predicate, *update)
 	}
+	for _, update := range typeUpdates {
+		State().SetType(update.TypeName, *update)
+	}
 	State().Set("_predicate_", protos.SchemaUpdate{
 		ValueType: protos.Posting_STRING,
 		List:      true,
@@ -165,7 +176,8 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 	typ types.TypeID) ([]string, error) {
 	var tokenizers []string
 	var seen = make(map[string]bool)
-	var seenSortableTok bool
+	var seenIds = make(map[byte]bool)
+	var seenSortableTok, seenAnalyzer bool
 
 	if typ == types.UidID || typ == types.DefaultID || typ == types.PasswordID {
 		return tokenizers, x.Errorf("Indexing not allowed on predicate %s of type %s",
@@ -203,10 +215,22 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 		if !expectArg {
 			return tokenizers, x.Errorf("Expected a comma but got: %v", next)
 		}
+		name := strings.ToLower(next.Val)
+		if it.Next() {
+			if it.Item().Typ == itemLeftRound {
+				args, err := parseTokenizerArgs(it, name)
+				if err != nil {
+					return tokenizers, err
+				}
+				name = fmt.Sprintf("%s(%s)", name, strings.Join(args, ","))
+			} else {
+				it.Prev()
+			}
+		}
 		// Look for custom tokenizer.
-		tokenizer, has := tok.GetTokenizer(strings.ToLower(next.Val))
+		tokenizer, has := getTokenizer(name)
 		if !has {
-			return tokenizers, x.Errorf("Invalid tokenizer %s", next.Val)
+			return tokenizers, x.Errorf("Invalid tokenizer %s", name)
 		}
 		tokenizerType, ok := types.TypeForName(tokenizer.Type())
 		x.AssertTrue(ok) // Type is validated during tokenizer loading.
@@ -219,6 +243,18 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 			return tokenizers, x.Errorf("Duplicate tokenizers defined for pred %v",
 				predicate)
 		}
+		if _, ok := tokenizer.(tok.NGramTokenizer); ok && seenIds[tokenizer.Identifier()] {
+			// The grams of both would be stored under the same index keys.
+			return tokenizers, x.Errorf("Duplicate tokenizers defined for pred %v",
+				predicate)
+		}
+		_, hasAnalyzer := tok.AnalyzerOf(tokenizer.Name())
+		if (hasAnalyzer || seenAnalyzer) && seenIds[tokenizer.Identifier()] {
+			// The terms of both would be stored under the same index keys.
+			return tokenizers, x.Errorf("Full-text index with an analyzer can't be combined "+
+				"with another full-text index for pred %v", predicate)
+		}
+		seenAnalyzer = seenAnalyzer || hasAnalyzer
 		if tokenizer.IsSortable() {
 			if seenSortableTok {
 				return nil, x.Errorf("More than one sortable index encountered for: %v",
@@ -228,13 +264,52 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 		}
 		tokenizers = append(tokenizers, tokenizer.Name())
 		seen[tokenizer.Name()] = true
+		seenIds[tokenizer.Identifier()] = true
 		expectArg = false
 	}
 	return tokenizers, nil
 }
 
-// resolveTokenizers resolves default tokenizers and verifies tokenizers definitions.
-func resolveTokenizers(updates []*protos.SchemaUpdate) error {
+// parseTokenizerArgs returns the args given to a tokenizer, as the gram lengths in
+// edgengram(2, 5) or the analyzer in fulltext(myanalyzer).
+func parseTokenizerArgs(it *lex.ItemIterator, tokenizer string) ([]string, error) {
+	var args []string
+	for it.Next() {
+		next := it.Item()
+		switch {
+		case next.Typ == itemRightRound && len(args) > 0:
+			return args, nil
+		case next.Typ == itemComma && len(args) > 0:
+			if !it.Next() || it.Item().Typ != itemNumber {
+				return nil, x.Errorf("Expected a number after comma in args of tokenizer %s",
+					tokenizer)
+			}
+			args = append(args, it.Item().Val)
+		case (next.Typ == itemNumber || next.Typ == itemText) && len(args) == 0:
+			args = append(args, next.Val)
+		default:
+			return nil, x.Errorf("Invalid args of tokenizer %s: %v", tokenizer, next.Val)
+		}
+	}
+	return nil, x.Errorf("Invalid ending in args of tokenizer %s", tokenizer)
+}
+
+// getTokenizer returns the tokenizer with the given name. The full-text tokenizers with an
+// analyzer, as in fulltext(myanalyzer), are returned even if the analyzer isn't defined, as
+// it can be defined further on in the schema. resolveTokenizers checks that it is.
+func getTokenizer(name string) (tok.Tokenizer, bool) {
+	if t, has := tok.GetTokenizer(name); has {
+		return t, true
+	}
+	if analyzer, ok := tok.AnalyzerOf(name); ok {
+		return tok.FullTextTokenizer{Analyzer: analyzer}, true
+	}
+	return nil, false
+}
+
+// resolveTokenizers resolves default tokenizers and verifies tokenizers definitions. The
+// analyzers used by the tokenizers must be defined already or in the given ones.
+func resolveTokenizers(updates []*protos.SchemaUpdate, analyzers map[string]bool) error {
 	for _, schema := range updates {
 		typ := types.TypeID(schema.ValueType)
 
@@ -258,7 +333,11 @@ func resolveTokenizers(updates []*protos.SchemaUpdate) error {
 		var seen = make(map[string]bool)
 		var seenSortableTok bool
 		for _, t := range schema.Tokenizer {
-			tokenizer, has := tok.GetTokenizer(t)
+			if name, ok := tok.AnalyzerOf(t); ok && !analyzers[name] && !tok.HasAnalyzer(name) {
+				return x.Errorf("Analyzer %s isn't defined, used by attr %s", name,
+					schema.Predicate)
+			}
+			tokenizer, has := getTokenizer(t)
 			if !has {
 				return x.Errorf("Invalid tokenizer %s", t)
 			}
@@ -285,9 +364,207 @@ func resolveTokenizers(updates []*protos.SchemaUpdate) error {
 	return nil
 }
 
+// parseTypeDeclaration parses a type definition of the form
+// type Person { name age friend }. The type keyword has already been consumed.
+func parseTypeDeclaration(it *lex.ItemIterator) (*protos.TypeUpdate, error) {
+	it.Next()
+	next := it.Item()
+	if next.Typ != itemText {
+		return nil, x.Errorf("Missing type name")
+	}
+	typ := &protos.TypeUpdate{TypeName: next.Val}
+
+	it.Next()
+	if next = it.Item(); next.Typ != itemLeftCurl {
+		return nil, x.Errorf("Expected { after type name: %s", typ.TypeName)
+	}
+	seen := make(map[string]bool)
+	for it.Next() {
+		next = it.Item()
+		switch next.Typ {
+		case itemRightCurl:
+			return typ, nil
+		case lex.ItemEOF:
+			return nil, x.Errorf("Unclosed type definition: %s", typ.TypeName)
+		case itemNewLine, itemComma:
+			// Fields can be separated by spaces, commas or new lines.
+		case itemText:
+			if seen[next.Val] {
+				return nil, x.Errorf("Duplicate field %s in type %s", next.Val, typ.TypeName)
+			}
+			seen[next.Val] = true
+			typ.Fields = append(typ.Fields, next.Val)
+		default:
+			return nil, x.Errorf("Unexpected token: %v in type %s", next.Val, typ.TypeName)
+		}
+	}
+	return nil, x.Errorf("Unclosed type definition: %s", typ.TypeName)
+}
+
+// parseAnalyzerDeclaration parses the definition of a full-text analyzer of the form
+// analyzer recipes { token_filters: [lowercase, stop, stem(en)] stop_words: ["with"] },
+// with the options usually on lines of their own. The analyzer keyword has already been
+// consumed.
+func parseAnalyzerDeclaration(it *lex.ItemIterator) (*protos.AnalyzerUpdate, error) {
+	it.Next()
+	next := it.Item()
+	if next.Typ != itemText {
+		return nil, x.Errorf("Missing analyzer name")
+	}
+	analyzer := &protos.AnalyzerUpdate{Name: next.Val}
+
+	it.Next()
+	if next = it.Item(); next.Typ != itemLeftCurl {
+		return nil, x.Errorf("Expected { after analyzer name: %s", analyzer.Name)
+	}
+	seen := make(map[string]bool)
+	for it.Next() {
+		next = it.Item()
+		switch next.Typ {
+		case itemRightCurl:
+			if err := tok.CheckAnalyzer(analyzer); err != nil {
+				return nil, err
+			}
+			return analyzer, nil
+		case lex.ItemEOF:
+			return nil, x.Errorf("Unclosed analyzer definition: %s", analyzer.Name)
+		case itemNewLine, itemComma:
+			// Options can be separated by commas or new lines.
+		case itemText:
+			if seen[next.Val] {
+				return nil, x.Errorf("Duplicate option %s in analyzer %s", next.Val,
+					analyzer.Name)
+			}
+			seen[next.Val] = true
+			if err := parseAnalyzerOption(it, analyzer, next.Val); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, x.Errorf("Unexpected token: %v in analyzer %s", next.Val, analyzer.Name)
+		}
+	}
+	return nil, x.Errorf("Unclosed analyzer definition: %s", analyzer.Name)
+}
+
+// parseAnalyzerOption parses the value of an option of an analyzer, after its name.
+func parseAnalyzerOption(it *lex.ItemIterator, analyzer *protos.AnalyzerUpdate,
+	option string) error {
+	if !it.Next() || it.Item().Typ != itemColon {
+		return x.Errorf("Missing colon after %s in analyzer %s", option, analyzer.Name)
+	}
+	var list *[]string
+	quoted := false
+	switch option {
+	case "tokenizer":
+		if !it.Next() || it.Item().Typ != itemText {
+			return x.Errorf("Missing tokenizer in analyzer %s", analyzer.Name)
+		}
+		analyzer.Tokenizer = it.Item().Val
+		return nil
+	case "char_filters":
+		list = &analyzer.CharFilters
+	case "token_filters":
+		list = &analyzer.TokenFilters
+	case "stop_words":
+		list, quoted = &analyzer.StopWords, true
+	case "synonyms":
+		list, quoted = &analyzer.Synonyms, true
+	case "mappings":
+		list, quoted = &analyzer.Mappings, true
+	default:
+		return x.Errorf("Unknown option %s in analyzer %s", option, analyzer.Name)
+	}
+
+	if !it.Next() || it.Item().Typ != itemLeftSquare {
+		return x.Errorf("Expected [ after %s in analyzer %s", option, analyzer.Name)
+	}
+	for it.Next() {
+		next := it.Item()
+		switch {
+		case next.Typ == itemRightSquare:
+			return nil
+		case next.Typ == lex.ItemEOF:
+			return x.Errorf("Unclosed [ in %s of analyzer %s", option, analyzer.Name)
+		case next.Typ == itemComma || next.Typ == itemNewLine:
+		case next.Typ == itemQuotedText && quoted:
+			val, err := strconv.Unquote(next.Val)
+			if err != nil {
+				return x.Wrapf(err, "in %s of analyzer %s", option, analyzer.Name)
+			}
+			*list = append(*list, val)
+		case next.Typ == itemText && !quoted:
+			// Filters can take a language, as in stem(en).
+			name := next.Val
+			if peek, ok := it.PeekOne(); ok && peek.Typ == itemLeftRound {
+				it.Next()
+				if !it.Next() || it.Item().Typ != itemText {
+					return x.Errorf("Expected a language for %s in analyzer %s", name,
+						analyzer.Name)
+				}
+				name = fmt.Sprintf("%s(%s)", name, it.Item().Val)
+				if !it.Next() || it.Item().Typ != itemRightRound {
+					return x.Errorf("Unclosed ( after %s in analyzer %s", name, analyzer.Name)
+				}
+			}
+			*list = append(*list, name)
+		default:
+			return x.Errorf("Unexpected token: %v in %s of analyzer %s", next.Val, option,
+				analyzer.Name)
+		}
+	}
+	return x.Errorf("Unclosed [ in %s of analyzer %s", option, analyzer.Name)
+}
+
+// isDeclaration returns true if the text item being parsed starts a definition with the
+// given keyword, like type or analyzer, rather than the schema of a predicate called so.
+func isDeclaration(keyword string, item lex.Item, it *lex.ItemIterator) bool {
+	if item.Val != keyword {
+		return false
+	}
+	next, ok := it.PeekOne()
+	return ok && next.Typ == itemText
+}
+
 // Parse parses a schema string and returns the schema representation for it.
+// Type definitions aren't allowed, use ParseWithTypes to parse them as well.
 func Parse(s string) ([]*protos.SchemaUpdate, error) {
+	schemas, types, err := ParseWithTypes(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) > 0 {
+		return nil, x.Errorf("Type definitions aren't supported here. Got type: %s",
+			types[0].TypeName)
+	}
+	return schemas, nil
+}
+
+// ParseWithTypes parses a schema string which can contain both predicate and type
+// definitions. If types are defined, the schema of the reserved type predicate is
+// returned as well, unless it was given explicitly. Analyzer definitions aren't allowed,
+// use ParseDefinitions to parse them as well.
+func ParseWithTypes(s string) ([]*protos.SchemaUpdate, []*protos.TypeUpdate, error) {
+	schemas, types, analyzers, err := ParseDefinitions(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(analyzers) > 0 {
+		return nil, nil, x.Errorf("Analyzer definitions aren't supported here. Got analyzer: %s",
+			analyzers[0].Name)
+	}
+	return schemas, types, nil
+}
+
+// ParseDefinitions parses a schema string which can contain predicate, type and full-text
+// analyzer definitions, as ParseWithTypes does. The analyzers can be used by the full-text
+// indexes of the predicates, as in @index(fulltext(myanalyzer)).
+func ParseDefinitions(s string) ([]*protos.SchemaUpdate, []*protos.TypeUpdate,
+	[]*protos.AnalyzerUpdate, error) {
 	var schemas []*protos.SchemaUpdate
+	var types []*protos.TypeUpdate
+	var analyzers []*protos.AnalyzerUpdate
+	seenTypes := make(map[string]bool)
+	seenAnalyzers := make(map[string]bool)
 	l := lex.Lexer{Input: s}
 	l.Run(lexText)
 	it := l.NewIterator()
@@ -295,23 +572,67 @@ func Parse(s string) ([]*protos.SchemaUpdate, error) {
 		item := it.Item()
 		switch item.Typ {
 		case lex.ItemEOF:
-			if err := resolveTokenizers(schemas); err != nil {
-				return nil, x.Wrapf(err, "failed to enrich schema")
+			if err := resolveTokenizers(schemas, seenAnalyzers); err != nil {
+				return nil, nil, nil, x.Wrapf(err, "failed to enrich schema")
 			}
-			return schemas, nil
+			return addTypeAttrSchema(schemas, types), types, analyzers, nil
 		case itemText:
-			if schema, err := parseScalarPair(it, item.Val); err != nil {
-				return nil, err
+			if isDeclaration("type", item, it) {
+				typ, err := parseTypeDeclaration(it)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				if seenTypes[typ.TypeName] {
+					return nil, nil, nil, x.Errorf("Type %s defined more than once",
+						typ.TypeName)
+				}
+				seenTypes[typ.TypeName] = true
+				types = append(types, typ)
+			} else if isDeclaration("analyzer", item, it) {
+				analyzer, err := parseAnalyzerDeclaration(it)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				if seenAnalyzers[analyzer.Name] {
+					return nil, nil, nil, x.Errorf("Analyzer %s defined more than once",
+						analyzer.Name)
+				}
+				seenAnalyzers[analyzer.Name] = true
+				analyzers = append(analyzers, analyzer)
+			} else if schema, err := parseScalarPair(it, item.Val); err != nil {
+				return nil, nil, nil, err
 			} else {
 				schemas = append(schemas, schema)
 			}
 		case lex.ItemError:
-			return nil, x.Errorf(item.Val)
+			return nil, nil, nil, x.Errorf(item.Val)
 		case itemNewLine:
 			// pass empty line
 		default:
-			return nil, x.Errorf("Unexpected token: %v while parsing schema", item)
+			return nil, nil, nil, x.Errorf("Unexpected token: %v while parsing schema", item)
 		}
 	}
-	return nil, x.Errorf("Shouldn't reach here")
+	return nil, nil, nil, x.Errorf("Shouldn't reach here")
+}
+
+// addTypeAttrSchema adds the schema for the type predicate, which is indexed so that
+// nodes can be looked up by their type.
+func addTypeAttrSchema(schemas []*protos.SchemaUpdate,
+	types []*protos.TypeUpdate) []*protos.SchemaUpdate {
+	if len(types) == 0 {
+		return schemas
+	}
+	for _, s := range schemas {
+		if s.Predicate == x.TypeAttr {
+			return schemas
+		}
+	}
+	return append(schemas, &protos.SchemaUpdate{
+		Predicate: x.TypeAttr,
+		ValueType: protos.Posting_STRING,
+		Directive: protos.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		List:      true,
+		Explicit:  true,
+	})
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
@@ -217,6 +218,33 @@ func TestSchemaIndexCustom(t *testing.T) {
 	require.Equal(t, 3, len(State().IndexedFields()))
 }
 
+var schemaIndexNGram = `
+name    : string @index(exact, edgengram(2, 15)) .
+address : string @index(ngram) .
+`
+
+func TestSchemaIndexNGram(t *testing.T) {
+	require.NoError(t, ParseBytes([]byte(schemaIndexNGram), 1))
+	require.Equal(t, []string{"exact", "edgengram(2,15)"}, State().TokenizerNames("name"))
+	require.Equal(t, tok.NGramTokenizer{Min: 2, Max: 15, Edge: true},
+		State().Tokenizer("name")[1])
+	require.Equal(t, []string{"ngram"}, State().TokenizerNames("address"))
+}
+
+func TestSchemaIndexNGram_Error(t *testing.T) {
+	for _, s := range []string{
+		"name: string @index(edgengram(5, 2)) .",
+		"name: string @index(edgengram(2)) .",
+		"name: string @index(edgengram(2,)) .",
+		"name: string @index(edgengram(2, 3) .",
+		"name: string @index(term(2, 3)) .",
+		"name: string @index(ngram, ngram(2, 4)) .",
+		"2name: string .",
+	} {
+		require.Error(t, ParseBytes([]byte(s), 1), s)
+	}
+}
+
 func TestParse(t *testing.T) {
 	reset()
 	_, err := Parse("age:int @index . name:string")
@@ -378,3 +406,164 @@ func TestParseUnderscore(t *testing.T) {
 	_, err := Parse("_share_:string @index(term) .")
 	require.NoError(t, err)
 }
+
+func TestParseTypes(t *testing.T) {
+	reset()
+	schemas, types, err := ParseWithTypes(`
+		name: string @index(exact) .
+		type Person {
+			name
+			age, friend
+		}
+		type Animal { name }
+	`)
+	require.NoError(t, err)
+	require.Equal(t, []*protos.TypeUpdate{
+		{TypeName: "Person", Fields: []string{"name", "age", "friend"}},
+		{TypeName: "Animal", Fields: []string{"name"}},
+	}, types)
+	require.Equal(t, 2, len(schemas))
+	require.EqualValues(t, &protos.SchemaUpdate{
+		Predicate: x.TypeAttr,
+		ValueType: protos.Posting_STRING,
+		Directive: protos.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		List:      true,
+		Explicit:  true,
+	}, schemas[1])
+}
+
+func TestParseTypePredicate(t *testing.T) {
+	reset()
+	schemas, types, err := ParseWithTypes(`
+		type: string .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(types))
+	require.Equal(t, 1, len(schemas))
+	require.Equal(t, "type", schemas[0].Predicate)
+}
+
+func TestParseTypeErrors(t *testing.T) {
+	tests := []struct {
+		in  string
+		err string
+	}{
+		{"type Person { name name }", "Duplicate field name in type Person"},
+		{"type Person { name", "Unclosed type definition: Person"},
+		{"type Person name", "Expected { after type name: Person"},
+		{"type Person { name: string }", "Unexpected token: : in type Person"},
+		{"type Person { name }\ntype Person { age }", "Type Person defined more than once"},
+	}
+	for _, tc := range tests {
+		reset()
+		_, _, err := ParseWithTypes(tc.in)
+		require.Error(t, err, tc.in)
+		require.Contains(t, err.Error(), tc.err, tc.in)
+	}
+
+	_, err := Parse("type Person { name }")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Type definitions aren't supported here")
+}
+
+func TestParseBytesTypes(t *testing.T) {
+	require.NoError(t, ParseBytes([]byte(`
+		name: string .
+		type Person { name }
+	`), 1))
+	typ, ok := State().GetType("Person")
+	require.True(t, ok)
+	require.Equal(t, []string{"name"}, typ.Fields)
+	require.Equal(t, []string{"Person"}, State().Types())
+	require.True(t, State().IsIndexed(x.TypeAttr))
+
+	require.NoError(t, ParseBytes([]byte("name: string ."), 1))
+	_, ok = State().GetType("Person")
+	require.False(t, ok)
+}
+
+func TestParseAnalyzers(t *testing.T) {
+	reset()
+	schemas, _, analyzers, err := ParseDefinitions(`
+		recipe: string @index(fulltext(recipes), term) .
+		analyzer recipes {
+			tokenizer: unicode
+			char_filters: [html]
+			token_filters: [lowercase, stop, synonyms, stem(en)]
+			stop_words: ["with", "and"]
+			synonyms: [
+				"aubergine, eggplant"
+				"courgette => zucchini"
+			]
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, []*protos.AnalyzerUpdate{{
+		Name:         "recipes",
+		Tokenizer:    "unicode",
+		CharFilters:  []string{"html"},
+		TokenFilters: []string{"lowercase", "stop", "synonyms", "stem(en)"},
+		StopWords:    []string{"with", "and"},
+		Synonyms:     []string{"aubergine, eggplant", "courgette => zucchini"},
+	}}, analyzers)
+	require.Equal(t, 1, len(schemas))
+	require.Equal(t, []string{"fulltext(recipes)", "term"}, schemas[0].Tokenizer)
+
+	_, _, err = ParseWithTypes("analyzer recipes { token_filters: [lowercase] }")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Analyzer definitions aren't supported here")
+}
+
+func TestParseAnalyzerErrors(t *testing.T) {
+	tests := []struct {
+		in  string
+		err string
+	}{
+		{"name: string @index(fulltext(recipes)) .", "Analyzer recipes isn't defined"},
+		{"analyzer recipes { token_filters: [lowercase", "Unclosed [ in token_filters"},
+		{"analyzer recipes { tokenizer: unicode", "Unclosed analyzer definition: recipes"},
+		{"analyzer recipes { stemmer: [porter] }", "Unknown option stemmer"},
+		{"analyzer recipes { stop_words: [with] }", "Unexpected token: with in stop_words"},
+		{"analyzer recipes { token_filters: [stem(en] }", "Unclosed ( after stem(en)"},
+		{"analyzer recipes { token_filters: [stem] }", "needs a language"},
+		{"analyzer recipes { tokenizer: unicode\n tokenizer: whitespace }",
+			"Duplicate option tokenizer"},
+		{"analyzer recipes { }\nanalyzer recipes { }", "Analyzer recipes defined more than once"},
+		{"analyzer recipes { }\nname: string @index(fulltext, fulltext(recipes)) .",
+			"can't be combined with another full-text index"},
+	}
+	for _, tc := range tests {
+		reset()
+		_, _, _, err := ParseDefinitions(tc.in)
+		require.Error(t, err, tc.in)
+		require.Contains(t, err.Error(), tc.err, tc.in)
+	}
+
+	// A predicate can still be called analyzer.
+	reset()
+	schemas, _, _, err := ParseDefinitions("analyzer: string @index(exact) .")
+	require.NoError(t, err)
+	require.Equal(t, "analyzer", schemas[0].Predicate)
+}
+
+func TestParseBytesAnalyzers(t *testing.T) {
+	require.NoError(t, ParseBytes([]byte(`
+		analyzer recipes { token_filters: [lowercase, stop(en)] }
+		recipe: string @index(fulltext(recipes)) .
+	`), 1))
+	analyzer, ok := State().GetAnalyzer("recipes")
+	require.True(t, ok)
+	require.Equal(t, []string{"lowercase", "stop(en)"}, analyzer.TokenFilters)
+	tokenizers := State().Tokenizer("recipe")
+	require.Equal(t, []tok.Tokenizer{tok.FullTextTokenizer{Analyzer: "recipes"}}, tokenizers)
+
+	// Analyzers defined before can be used.
+	_, _, _, err := ParseDefinitions("name: string @index(fulltext(recipes)) .")
+	require.NoError(t, err)
+
+	require.NoError(t, ParseBytes([]byte("name: string ."), 1))
+	_, ok = State().GetAnalyzer("recipes")
+	require.False(t, ok)
+	require.False(t, tok.HasAnalyzer("recipes"))
+}
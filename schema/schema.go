@@ -38,6 +38,8 @@ var (
 
 func (s *state) init() {
 	s.predicate = make(map[string]*protos.SchemaUpdate)
+	s.types = make(map[string]*protos.TypeUpdate)
+	s.analyzers = make(map[string]*protos.AnalyzerUpdate)
 	s.elog = trace.NewEventLog("Dgraph", "Schema")
 }
 
@@ -45,6 +47,10 @@ type state struct {
 	sync.RWMutex
 	// Map containing predicate to type information.
 	predicate map[string]*protos.SchemaUpdate
+	// Map containing type name to the fields of the type.
+	types map[string]*protos.TypeUpdate
+	// Map containing analyzer name to the definition of the full-text analyzer.
+	analyzers map[string]*protos.AnalyzerUpdate
 	elog      trace.EventLog
 }
 
@@ -63,6 +69,13 @@ func (s *state) DeleteAll() {
 			delete(s.predicate, pred)
 		}
 	}
+	for typ := range s.types {
+		delete(s.types, typ)
+	}
+	for name := range s.analyzers {
+		tok.RemoveAnalyzer(name)
+		delete(s.analyzers, name)
+	}
 }
 
 // Delete updates the schema in memory and disk
@@ -98,6 +111,60 @@ func (s *state) Set(pred string, schema protos.SchemaUpdate) {
 	s.elog.Printf(logUpdate(schema, pred))
 }
 
+// SetType sets the definition of given type in memory.
+func (s *state) SetType(typeName string, typ protos.TypeUpdate) {
+	s.Lock()
+	defer s.Unlock()
+	s.types[typeName] = &typ
+	s.elog.Printf("Setting type %s: %v\n", typeName, typ.Fields)
+}
+
+// GetType gets the definition of given type.
+func (s *state) GetType(typeName string) (protos.TypeUpdate, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	typ, has := s.types[typeName]
+	if !has {
+		return protos.TypeUpdate{}, false
+	}
+	return *typ, true
+}
+
+// SetAnalyzer sets the definition of given full-text analyzer in memory, and makes it
+// available to the tokenizers.
+func (s *state) SetAnalyzer(name string, analyzer protos.AnalyzerUpdate) error {
+	s.Lock()
+	defer s.Unlock()
+	if err := tok.DefineAnalyzer(&analyzer); err != nil {
+		return err
+	}
+	s.analyzers[name] = &analyzer
+	s.elog.Printf("Setting analyzer %s: %v\n", name, analyzer)
+	return nil
+}
+
+// GetAnalyzer gets the definition of given full-text analyzer.
+func (s *state) GetAnalyzer(name string) (protos.AnalyzerUpdate, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	analyzer, has := s.analyzers[name]
+	if !has {
+		return protos.AnalyzerUpdate{}, false
+	}
+	return *analyzer, true
+}
+
+// Types returns the names of all the defined types.
+func (s *state) Types() []string {
+	s.RLock()
+	defer s.RUnlock()
+	out := make([]string, 0, len(s.types))
+	for k := range s.types {
+		out = append(out, k)
+	}
+	return out
+}
+
 // Get gets the schema for given predicate
 func (s *state) Get(pred string) (protos.SchemaUpdate, bool) {
 	s.Lock()
@@ -183,6 +250,21 @@ func (s *state) TokenizerNames(pred string) []string {
 	return tokenizers
 }
 
+// TextAnalyzer returns the analyzer defined in the schema which the full-text index of the
+// predicate uses, or "" if it uses the built-in ones of the languages.
+func (s *state) TextAnalyzer(pred string) string {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		for _, name := range schema.Tokenizer {
+			if analyzer, ok := tok.AnalyzerOf(name); ok {
+				return analyzer
+			}
+		}
+	}
+	return ""
+}
+
 // IsReversed returns whether the predicate has reverse edge or not
 func (s *state) IsReversed(pred string) bool {
 	s.RLock()
@@ -246,6 +328,10 @@ func Load(predicate string) error {
 
 // LoadFromDb reads schema information from db and stores it in memory
 func LoadFromDb() error {
+	// The analyzers are loaded first, as the tokenizers of the predicates can use them.
+	if err := loadAnalyzersFromDb(); err != nil {
+		return err
+	}
 	prefix := x.SchemaPrefix()
 	txn := pstore.NewTransactionAt(1, false)
 	defer txn.Discard()
@@ -274,6 +360,65 @@ func LoadFromDb() error {
 		x.Checkf(s.Unmarshal(val), "Error while loading schema from db")
 		State().Set(attr, s)
 	}
+	return loadTypesFromDb()
+}
+
+// loadTypesFromDb reads the type definitions from db and stores them in memory.
+func loadTypesFromDb() error {
+	prefix := x.TypePrefix()
+	txn := pstore.NewTransactionAt(1, false)
+	defer txn.Discard()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		item := itr.Item()
+		typeName, err := x.TypeName(item.Key())
+		if err != nil {
+			continue
+		}
+		val, err := item.Value()
+		if err != nil {
+			return err
+		}
+		if len(val) == 0 {
+			continue
+		}
+		var t protos.TypeUpdate
+		x.Checkf(t.Unmarshal(val), "Error while loading type from db")
+		State().SetType(typeName, t)
+	}
+	return nil
+}
+
+// loadAnalyzersFromDb reads the full-text analyzer definitions from db and stores them in
+// memory.
+func loadAnalyzersFromDb() error {
+	prefix := x.AnalyzerPrefix()
+	txn := pstore.NewTransactionAt(1, false)
+	defer txn.Discard()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		item := itr.Item()
+		name, err := x.AnalyzerName(item.Key())
+		if err != nil {
+			continue
+		}
+		val, err := item.Value()
+		if err != nil {
+			return err
+		}
+		if len(val) == 0 {
+			continue
+		}
+		var a protos.AnalyzerUpdate
+		x.Checkf(a.Unmarshal(val), "Error while loading analyzer from db")
+		if err := State().SetAnalyzer(name, a); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -36,6 +36,8 @@ const (
 	itemUnderscore
 	itemLeftSquare
 	itemRightSquare
+	itemNumber     // unsigned number, as in the gram lengths of an index
+	itemQuotedText // quoted string, as in the stop words of an analyzer
 )
 
 func lexText(l *lex.Lexer) lex.StateFn {
@@ -47,6 +49,9 @@ Loop:
 		case isNameBegin(r):
 			l.Backup()
 			return lexWord
+		case isDigit(r):
+			l.Backup()
+			return lexNumber
 		case isSpace(r):
 			l.Ignore()
 		case isEndOfLine(r):
@@ -78,6 +83,11 @@ Loop:
 		case r == '_':
 			// Predicates can start with _.
 			return lexWord
+		case r == '"':
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf("Invalid schema: %v", err)
+			}
+			l.Emit(itemQuotedText)
 		default:
 			return l.Errorf("Invalid schema. Unexpected %s", l.Input[l.Start:l.Pos])
 		}
@@ -103,6 +113,14 @@ func lexWord(l *lex.Lexer) lex.StateFn {
 	return lexText
 }
 
+func lexNumber(l *lex.Lexer) lex.StateFn {
+	for isDigit(l.Next()) {
+	}
+	l.Backup()
+	l.Emit(itemNumber)
+	return lexText
+}
+
 // isNameBegin returns true if the rune is an alphabet.
 func isNameBegin(r rune) bool {
 	switch {
@@ -115,11 +133,16 @@ func isNameBegin(r rune) bool {
 	}
 }
 
+// isDigit returns true if the rune is a decimal digit.
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
 func isNameSuffix(r rune) bool {
 	if isNameBegin(r) {
 		return true
 	}
-	if r >= '0' && r <= '9' {
+	if isDigit(r) {
 		return true
 	}
 	if r == '_' || r == '.' || r == '-' { // Use by freebase.
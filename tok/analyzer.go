@@ -0,0 +1,406 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tok
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/analysis/token/porter"
+	"github.com/blevesearch/bleve/analysis/token/stop"
+	bleveunicode "github.com/blevesearch/bleve/analysis/tokenizer/unicode"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// analyzers holds the analyzers defined in the schema, by name. They are used by the
+// full-text tokenizers named like fulltext(name).
+var analyzers = struct {
+	sync.RWMutex
+	m map[string]*analysis.Analyzer
+}{m: make(map[string]*analysis.Analyzer)}
+
+// DefineAnalyzer builds the analyzer defined in the schema, replacing any previous
+// definition with the same name.
+func DefineAnalyzer(def *protos.AnalyzerUpdate) error {
+	a, err := buildAnalyzer(def)
+	if err != nil {
+		return err
+	}
+	analyzers.Lock()
+	defer analyzers.Unlock()
+	analyzers.m[def.Name] = a
+	return nil
+}
+
+// CheckAnalyzer returns an error if the analyzer can't be built from its definition.
+func CheckAnalyzer(def *protos.AnalyzerUpdate) error {
+	_, err := buildAnalyzer(def)
+	return err
+}
+
+// RemoveAnalyzer removes the analyzer defined with the given name.
+func RemoveAnalyzer(name string) {
+	analyzers.Lock()
+	defer analyzers.Unlock()
+	delete(analyzers.m, name)
+}
+
+// HasAnalyzer returns true if an analyzer is defined with the given name.
+func HasAnalyzer(name string) bool {
+	analyzers.RLock()
+	defer analyzers.RUnlock()
+	_, ok := analyzers.m[name]
+	return ok
+}
+
+// AnalyzerOf returns the name of the analyzer used by the full-text tokenizer with the
+// given name, as myanalyzer for fulltext(myanalyzer).
+func AnalyzerOf(tokenizer string) (string, bool) {
+	if !strings.HasPrefix(tokenizer, FTSTokenizerName+"(") || !strings.HasSuffix(tokenizer, ")") {
+		return "", false
+	}
+	name := tokenizer[len(FTSTokenizerName)+1 : len(tokenizer)-1]
+	return name, len(name) > 0
+}
+
+// getAnalyzerTokenizer returns the full-text tokenizer for names like fulltext(myanalyzer),
+// if the analyzer is defined.
+func getAnalyzerTokenizer(name string) (Tokenizer, bool) {
+	analyzer, ok := AnalyzerOf(name)
+	if !ok || !HasAnalyzer(analyzer) {
+		return nil, false
+	}
+	return FullTextTokenizer{Analyzer: analyzer}, true
+}
+
+// analyzerNamed returns the analyzer of the full-text tokenizer with the given name, be it
+// a built-in one or one defined in the schema.
+func analyzerNamed(name string) (*analysis.Analyzer, error) {
+	analyzer, ok := AnalyzerOf(name)
+	if !ok {
+		return bleveCache.AnalyzerNamed(name)
+	}
+	analyzers.RLock()
+	defer analyzers.RUnlock()
+	a, ok := analyzers.m[analyzer]
+	if !ok {
+		return nil, x.Errorf("Analyzer %s isn't defined", analyzer)
+	}
+	return a, nil
+}
+
+func buildAnalyzer(def *protos.AnalyzerUpdate) (*analysis.Analyzer, error) {
+	a := &analysis.Analyzer{}
+	switch def.Tokenizer {
+	case "", "unicode":
+		a.Tokenizer = bleveunicode.NewUnicodeTokenizer()
+	case "whitespace":
+		a.Tokenizer = whitespaceTokenizer{}
+	default:
+		return nil, x.Errorf("Unknown tokenizer %s in analyzer %s", def.Tokenizer, def.Name)
+	}
+
+	for _, name := range def.CharFilters {
+		switch name {
+		case "html":
+			a.CharFilters = append(a.CharFilters, htmlCharFilter{})
+		case "mapping":
+			f, err := newMappingCharFilter(def.Mappings)
+			if err != nil {
+				return nil, x.Wrapf(err, "in analyzer %s", def.Name)
+			}
+			a.CharFilters = append(a.CharFilters, f)
+		default:
+			return nil, x.Errorf("Unknown char filter %s in analyzer %s", name, def.Name)
+		}
+	}
+
+	for _, name := range def.TokenFilters {
+		f, err := buildTokenFilter(def, name)
+		if err != nil {
+			return nil, x.Wrapf(err, "in analyzer %s", def.Name)
+		}
+		a.TokenFilters = append(a.TokenFilters, f)
+	}
+	return a, nil
+}
+
+// buildTokenFilter returns the token filter with the given name. The language of the
+// filters that take one is given as an argument, as in stem(en).
+func buildTokenFilter(def *protos.AnalyzerUpdate, name string) (analysis.TokenFilter, error) {
+	filter, lang := name, ""
+	if i := strings.IndexByte(name, '('); i >= 0 && strings.HasSuffix(name, ")") {
+		filter, lang = name[:i], name[i+1:len(name)-1]
+		if len(lang) == 0 {
+			return nil, x.Errorf("Missing language in token filter %s", name)
+		}
+	}
+	if len(lang) > 0 && filter != "stem" && filter != "stop" {
+		return nil, x.Errorf("Token filter %s doesn't take a language", filter)
+	}
+
+	switch filter {
+	case "lowercase":
+		return lowercase.NewLowerCaseFilter(), nil
+	case "nfkc":
+		return bleveCache.TokenFilterNamed(normalizerName)
+	case "porter":
+		return porter.NewPorterStemmer(), nil
+	case "cjk_bigram":
+		return cjk.NewCJKBigramFilter(false), nil
+	case "stem":
+		if len(lang) == 0 {
+			return nil, x.Errorf("Token filter stem needs a language, as in stem(en)")
+		}
+		f, err := bleveCache.TokenFilterNamed(stemmerName(lang))
+		if err != nil {
+			return nil, x.Errorf("Stemming isn't supported for language %s", lang)
+		}
+		return f, nil
+	case "stop":
+		if len(lang) > 0 {
+			f, err := bleveCache.TokenFilterNamed(stopWordsListName(lang))
+			if err != nil {
+				return nil, x.Errorf("Stop words aren't known for language %s", lang)
+			}
+			return f, nil
+		}
+		if len(def.StopWords) == 0 {
+			return nil, x.Errorf("Token filter stop needs stop_words or a language")
+		}
+		words := analysis.NewTokenMap()
+		for _, w := range def.StopWords {
+			words.AddToken(w)
+		}
+		return stop.NewStopTokensFilter(words), nil
+	case "synonyms":
+		return newSynonymFilter(def.Synonyms)
+	default:
+		return nil, x.Errorf("Unknown token filter %s", name)
+	}
+}
+
+// whitespaceTokenizer splits the text on white space only, so that punctuation is kept in
+// the terms.
+type whitespaceTokenizer struct{}
+
+func (whitespaceTokenizer) Tokenize(input []byte) analysis.TokenStream {
+	var out analysis.TokenStream
+	start := -1
+	for i := 0; i <= len(input); {
+		r, size := utf8.RuneError, 1
+		if i < len(input) {
+			r, size = utf8.DecodeRune(input[i:])
+		}
+		if i == len(input) || unicode.IsSpace(r) {
+			if start >= 0 {
+				out = append(out, &analysis.Token{
+					Start:    start,
+					End:      i,
+					Term:     input[start:i],
+					Position: len(out) + 1,
+					Type:     analysis.AlphaNumeric,
+				})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+		i += size
+	}
+	return out
+}
+
+// htmlCharFilter blanks out the HTML tags of the text. Their bytes are replaced by spaces,
+// so that the offsets of the words are those of the original text.
+type htmlCharFilter struct{}
+
+func (f htmlCharFilter) Filter(input []byte) []byte {
+	out, _ := f.filterSpans(input)
+	return out
+}
+
+func (htmlCharFilter) filterSpans(input []byte) ([]byte, []span) {
+	out := make([]byte, len(input))
+	copy(out, input)
+	spans := make([]span, len(input))
+	inTag := false
+	for i, c := range out {
+		spans[i] = span{i, i + 1}
+		if !inTag && c == '<' && i+1 < len(out) && isTagStart(out[i+1]) {
+			inTag = true
+		}
+		if inTag {
+			inTag = c != '>'
+			out[i] = ' '
+		}
+	}
+	return out, spans
+}
+
+func isTagStart(c byte) bool {
+	return c == '/' || c == '!' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// mappingCharFilter replaces strings of the text before it's tokenized, as given by
+// mappings like "& => and".
+type mappingCharFilter struct {
+	// pairs holds the strings to replace, each followed by its replacement.
+	pairs [][]byte
+}
+
+func newMappingCharFilter(mappings []string) (*mappingCharFilter, error) {
+	if len(mappings) == 0 {
+		return nil, x.Errorf("Char filter mapping needs mappings")
+	}
+	f := new(mappingCharFilter)
+	for _, m := range mappings {
+		parts := strings.Split(m, "=>")
+		if len(parts) != 2 || len(strings.TrimSpace(parts[0])) == 0 {
+			return nil, x.Errorf("Invalid mapping %q, expected \"from => to\"", m)
+		}
+		f.pairs = append(f.pairs, []byte(strings.TrimSpace(parts[0])),
+			[]byte(strings.TrimSpace(parts[1])))
+	}
+	return f, nil
+}
+
+func (f *mappingCharFilter) Filter(input []byte) []byte {
+	out, _ := f.filterSpans(input)
+	return out
+}
+
+// filterSpans replaces the strings as strings.Replacer does: from left to right, without
+// overlapping, and with the first mapping given winning among those matching at a position.
+func (f *mappingCharFilter) filterSpans(input []byte) ([]byte, []span) {
+	out := make([]byte, 0, len(input))
+	spans := make([]span, 0, len(input))
+	for i := 0; i < len(input); {
+		replaced := false
+		for j := 0; j < len(f.pairs); j += 2 {
+			from, to := f.pairs[j], f.pairs[j+1]
+			if !bytes.HasPrefix(input[i:], from) {
+				continue
+			}
+			out = append(out, to...)
+			for range to {
+				spans = append(spans, span{i, i + len(from)})
+			}
+			i += len(from)
+			replaced = true
+			break
+		}
+		if !replaced {
+			out = append(out, input[i])
+			spans = append(spans, span{i, i + 1})
+			i++
+		}
+	}
+	return out, spans
+}
+
+// span is the range of the input of a char filter an output byte was made from.
+type span struct {
+	start, end int
+}
+
+// spanCharFilter is implemented by the char filters of the analyzers, so that the words
+// found in the filtered text can be mapped back to the original one.
+type spanCharFilter interface {
+	filterSpans(input []byte) ([]byte, []span)
+}
+
+// filterText applies the char filters to the text. It returns the filtered text along
+// with the span of the original text each of its bytes comes from.
+func filterText(filters []analysis.CharFilter, text []byte) ([]byte, []span, error) {
+	spans := make([]span, len(text))
+	for i := range spans {
+		spans[i] = span{i, i + 1}
+	}
+	for _, cf := range filters {
+		sf, ok := cf.(spanCharFilter)
+		if !ok {
+			return nil, nil, x.Errorf("Char filter %T doesn't keep the offsets of the text", cf)
+		}
+		var fspans []span
+		text, fspans = sf.filterSpans(text)
+		for i, s := range fspans {
+			fspans[i] = span{spans[s.start].start, spans[s.end-1].end}
+		}
+		spans = fspans
+	}
+	return text, spans, nil
+}
+
+// synonymFilter replaces the terms with a synonym, so that all the synonyms of a term are
+// indexed and looked up as the same term. The synonyms are given as equivalent terms,
+// like "tv, television", which are all replaced by the first one, or as terms replaced
+// by another one, like "telly, tv => television".
+type synonymFilter map[string]string
+
+func newSynonymFilter(rules []string) (synonymFilter, error) {
+	if len(rules) == 0 {
+		return nil, x.Errorf("Token filter synonyms needs synonyms")
+	}
+	f := make(synonymFilter)
+	for _, rule := range rules {
+		parts := strings.Split(rule, "=>")
+		if len(parts) > 2 {
+			return nil, x.Errorf("Invalid synonyms %q", rule)
+		}
+		terms := strings.Split(parts[0], ",")
+		for i := range terms {
+			terms[i] = strings.TrimSpace(terms[i])
+		}
+		to := terms[0]
+		if len(parts) == 2 {
+			to = strings.TrimSpace(parts[1])
+		} else if len(terms) < 2 {
+			return nil, x.Errorf("Invalid synonyms %q, expected at least two terms", rule)
+		}
+		for _, term := range append(terms, to) {
+			if len(term) == 0 || strings.IndexFunc(term, unicode.IsSpace) >= 0 {
+				return nil, x.Errorf("Invalid synonyms %q, expected single terms", rule)
+			}
+		}
+		for _, term := range terms {
+			if prev, ok := f[term]; ok && prev != to {
+				return nil, x.Errorf("Term %s has more than one synonym", term)
+			}
+			f[term] = to
+		}
+	}
+	return f, nil
+}
+
+func (f synonymFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	for _, token := range input {
+		if to, ok := f[string(token.Term)]; ok {
+			token.Term = []byte(to)
+		}
+	}
+	return input
+}
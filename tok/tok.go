@@ -19,7 +19,9 @@ package tok
 
 import (
 	"encoding/binary"
+	"fmt"
 	"plugin"
+	"strconv"
 	"strings"
 	"time"
 
@@ -86,6 +88,8 @@ func init() {
 	registerTokenizer(BoolTokenizer{})
 	registerTokenizer(TrigramTokenizer{})
 	registerTokenizer(HashTokenizer{})
+	registerTokenizer(NGramTokenizer{Min: DefaultMinGram, Max: DefaultMaxGram})
+	registerTokenizer(NGramTokenizer{Min: DefaultMinGram, Max: DefaultMaxGram, Edge: true})
 	initFullTextTokenizers()
 }
 
@@ -111,9 +115,43 @@ func LoadCustomTokenizer(soFile string) {
 // GetTokenizer returns tokenizer given unique name.
 func GetTokenizer(name string) (Tokenizer, bool) {
 	t, found := tokenizers[name]
+	if !found {
+		t, found = getNGramTokenizer(name)
+	}
+	if !found {
+		t, found = getAnalyzerTokenizer(name)
+	}
 	return t, found
 }
 
+// getNGramTokenizer returns the n-gram tokenizer for names carrying the gram lengths,
+// like edgengram(2,5).
+func getNGramTokenizer(name string) (Tokenizer, bool) {
+	i := strings.IndexByte(name, '(')
+	if i < 0 || !strings.HasSuffix(name, ")") {
+		return nil, false
+	}
+	t, ok := tokenizers[name[:i]].(NGramTokenizer)
+	if !ok {
+		return nil, false
+	}
+	args := strings.Split(name[i+1:len(name)-1], ",")
+	if len(args) != 2 {
+		return nil, false
+	}
+	var err error
+	if t.Min, err = strconv.Atoi(strings.TrimSpace(args[0])); err != nil {
+		return nil, false
+	}
+	if t.Max, err = strconv.Atoi(strings.TrimSpace(args[1])); err != nil {
+		return nil, false
+	}
+	if t.Min < 1 || t.Min > t.Max || t.Max > maxGram {
+		return nil, false
+	}
+	return t, true
+}
+
 func registerTokenizer(t Tokenizer) {
 	if tokenizers == nil {
 		tokenizers = make(map[string]Tokenizer)
@@ -256,12 +294,19 @@ func (t ExactTokenizer) Identifier() byte { return 0x2 }
 func (t ExactTokenizer) IsSortable() bool { return true }
 func (t ExactTokenizer) IsLossy() bool    { return false }
 
-// Full text tokenizer, with language support
+// Full text tokenizer, with language support. With an analyzer defined in the schema, it's
+// used instead of the one of the language.
 type FullTextTokenizer struct {
-	Lang string
+	Lang     string
+	Analyzer string
 }
 
-func (t FullTextTokenizer) Name() string { return FtsTokenizerName(t.Lang) }
+func (t FullTextTokenizer) Name() string {
+	if len(t.Analyzer) > 0 {
+		return FTSTokenizerName + "(" + t.Analyzer + ")"
+	}
+	return FtsTokenizerName(t.Lang)
+}
 func (t FullTextTokenizer) Type() string { return "string" }
 func (t FullTextTokenizer) Tokens(v interface{}) ([]string, error) {
 	return getBleveTokens(t.Name(), v.(string))
@@ -270,8 +315,49 @@ func (t FullTextTokenizer) Identifier() byte { return 0x8 }
 func (t FullTextTokenizer) IsSortable() bool { return false }
 func (t FullTextTokenizer) IsLossy() bool    { return true }
 
+// TermFrequencies returns the number of times each token occurs in the value, keyed by the
+// encoded token, along with the number of terms in the value. They are used to score
+// full-text matches.
+func (t FullTextTokenizer) TermFrequencies(v interface{}) (map[string]int, int, error) {
+	terms, err := analyze(t.Name(), v.(string))
+	if err != nil {
+		return nil, 0, err
+	}
+	freqs := make(map[string]int)
+	for _, term := range terms {
+		freqs[encodeToken(term, t.Identifier())]++
+	}
+	return freqs, len(terms), nil
+}
+
+// DocLengthToken returns the full-text index token under which the number of terms in the
+// values of the uid is kept. Terms never start with a NUL byte, so it can't be the token of
+// a word.
+func DocLengthToken(uid uint64) string {
+	b := make([]byte, 10)
+	b[1] = 'l'
+	binary.BigEndian.PutUint64(b[2:], uid)
+	return encodeToken(string(b), FullTextTokenizer{}.Identifier())
+}
+
+// DocStatsToken returns the full-text index token under which the number of values and
+// their total length are kept for a shard of the uids of a predicate.
+func DocStatsToken(shard int) string {
+	return encodeToken(string([]byte{0, 's', byte(shard)}), FullTextTokenizer{}.Identifier())
+}
+
 func getBleveTokens(name string, str string) ([]string, error) {
-	analyzer, err := bleveCache.AnalyzerNamed(name)
+	terms, err := analyze(name, str)
+	if err != nil {
+		return nil, err
+	}
+	terms = x.RemoveDuplicates(terms)
+	return terms, nil
+}
+
+// analyze returns the terms of the string in order, as given by the named analyzer.
+func analyze(name string, str string) ([]string, error) {
+	analyzer, err := analyzerNamed(name)
 	if err != nil {
 		return nil, err
 	}
@@ -281,7 +367,6 @@ func getBleveTokens(name string, str string) ([]string, error) {
 	for i, token := range tokenStream {
 		terms[i] = string(token.Term)
 	}
-	terms = x.RemoveDuplicates(terms)
 	return terms, nil
 }
 
@@ -368,6 +453,86 @@ func (t HashTokenizer) Identifier() byte { return 0xB }
 func (t HashTokenizer) IsSortable() bool { return false }
 func (t HashTokenizer) IsLossy() bool    { return true }
 
+// Gram lengths used by the ngram and edgengram tokenizers when the schema doesn't give any.
+const (
+	DefaultMinGram = 2
+	DefaultMaxGram = 10
+	maxGram        = 50
+)
+
+// NGramTokenizer indexes the grams of Min to Max runes of every term of a string. The edge
+// variant only indexes the grams at the start of the terms, which is all prefix search needs.
+type NGramTokenizer struct {
+	Min, Max int
+	Edge     bool
+}
+
+func (t NGramTokenizer) Name() string {
+	name := "ngram"
+	if t.Edge {
+		name = "edgengram"
+	}
+	if t.Min == DefaultMinGram && t.Max == DefaultMaxGram {
+		return name
+	}
+	return fmt.Sprintf("%s(%d,%d)", name, t.Min, t.Max)
+}
+func (t NGramTokenizer) Type() string { return "string" }
+func (t NGramTokenizer) Tokens(v interface{}) ([]string, error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, x.Errorf("N-gram indices only supported for string types")
+	}
+	terms, err := getBleveTokens(TermTokenizer{}.Name(), value)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []string
+	for _, term := range terms {
+		runes := []rune(term)
+		for n := t.Min; n <= t.Max && n <= len(runes); n++ {
+			if t.Edge {
+				tokens = append(tokens, string(runes[:n]))
+				continue
+			}
+			for i := 0; i+n <= len(runes); i++ {
+				tokens = append(tokens, string(runes[i:i+n]))
+			}
+		}
+	}
+	return x.RemoveDuplicates(tokens), nil
+}
+func (t NGramTokenizer) Identifier() byte {
+	if t.Edge {
+		return 0xD
+	}
+	return 0xC
+}
+func (t NGramTokenizer) IsSortable() bool { return false }
+func (t NGramTokenizer) IsLossy() bool    { return true }
+
+// PrefixTokens returns the encoded grams to look up for the terms of a prefix search. Every
+// term is cut to the longest indexed gram, so the values found still have to be checked.
+func (t NGramTokenizer) PrefixTokens(prefix string) ([]string, error) {
+	terms, err := getBleveTokens(TermTokenizer{}.Name(), prefix)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]string, 0, len(terms))
+	for _, term := range terms {
+		runes := []rune(term)
+		if len(runes) < t.Min {
+			return nil, x.Errorf("Prefix %q is shorter than the %d runes indexed by %s",
+				term, t.Min, t.Name())
+		}
+		if len(runes) > t.Max {
+			runes = runes[:t.Max]
+		}
+		tokens = append(tokens, encodeToken(string(runes), t.Identifier()))
+	}
+	return x.RemoveDuplicates(tokens), nil
+}
+
 // PluginTokenizer is implemented by external plugins loaded dynamically via
 // *.so files. It follows the implementation semantics of the Tokenizer
 // interface.
@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos"
 )
 
 type encL struct {
@@ -74,6 +76,24 @@ func TestFullTextTokenizer(t *testing.T) {
 	require.Equal(t, []string{encodeToken("stem", id), encodeToken("work", id)}, tokens)
 }
 
+func TestFullTextTermFrequencies(t *testing.T) {
+	tokenizer := FullTextTokenizer{}
+	freqs, length, err := tokenizer.TermFrequencies("The cat works, the cats worked and a dog watched.")
+	require.NoError(t, err)
+	// Stop words aren't counted.
+	require.Equal(t, 6, length)
+	id := tokenizer.Identifier()
+	require.Equal(t, map[string]int{
+		encodeToken("cat", id):   2,
+		encodeToken("work", id):  2,
+		encodeToken("dog", id):   1,
+		encodeToken("watch", id): 1,
+	}, freqs)
+	require.NotContains(t, freqs, DocLengthToken(1))
+	require.NotContains(t, freqs, DocStatsToken(1))
+	require.NotEqual(t, DocLengthToken(1), DocLengthToken(2))
+}
+
 func TestHourTokenizer(t *testing.T) {
 	var err error
 	tokenizer, has := GetTokenizer("hour")
@@ -180,6 +200,70 @@ func TestTrigramTokenizer(t *testing.T) {
 	require.Equal(t, expected, tokens)
 }
 
+func TestNGramTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("ngram(2,3)")
+	require.True(t, has)
+	require.Equal(t, "ngram(2,3)", tokenizer.Name())
+	tokens, err := BuildTokens("Dgraph go!", tokenizer)
+	require.NoError(t, err)
+	id := tokenizer.Identifier()
+	expected := []string{
+		encodeToken("dg", id),
+		encodeToken("gr", id),
+		encodeToken("ra", id),
+		encodeToken("ap", id),
+		encodeToken("ph", id),
+		encodeToken("dgr", id),
+		encodeToken("gra", id),
+		encodeToken("rap", id),
+		encodeToken("aph", id),
+		encodeToken("go", id),
+	}
+	sort.Strings(expected)
+	require.Equal(t, expected, tokens)
+}
+
+func TestEdgeNGramTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("edgengram")
+	require.True(t, has)
+	require.Equal(t, NGramTokenizer{Min: DefaultMinGram, Max: DefaultMaxGram, Edge: true},
+		tokenizer)
+
+	tokenizer, has = GetTokenizer("edgengram(1, 3)")
+	require.True(t, has)
+	tokens, err := BuildTokens("Árvíztűrő Go", tokenizer)
+	require.NoError(t, err)
+	id := tokenizer.Identifier()
+	expected := []string{
+		encodeToken("á", id),
+		encodeToken("ár", id),
+		encodeToken("árv", id),
+		encodeToken("g", id),
+		encodeToken("go", id),
+	}
+	sort.Strings(expected)
+	require.Equal(t, expected, tokens)
+
+	nt := tokenizer.(NGramTokenizer)
+	tokens, err = nt.PrefixTokens("Go árvíz")
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken("go", id), encodeToken("árv", id)}, tokens)
+
+	_, has = GetTokenizer("edgengram(3,2)")
+	require.False(t, has)
+	_, has = GetTokenizer("edgengram(0,2)")
+	require.False(t, has)
+	_, has = GetTokenizer("term(1,2)")
+	require.False(t, has)
+}
+
+func TestEdgeNGramPrefixTooShort(t *testing.T) {
+	tokenizer, has := GetTokenizer("edgengram(3,5)")
+	require.True(t, has)
+	_, err := tokenizer.(NGramTokenizer).PrefixTokens("john sm")
+	require.Error(t, err)
+}
+
 func TestGetBleveTokens(t *testing.T) {
 	val := "Our chief weapon is surprise...surprise and fear...fear and surprise...." +
 		"Our two weapons are fear and surprise...and ruthless efficiency.... " +
@@ -195,14 +279,14 @@ func TestGetBleveTokens(t *testing.T) {
 }
 
 func TestGetTextTokens1(t *testing.T) {
-	tokens, err := GetTextTokens([]string{"Quick brown fox"}, "en")
+	tokens, err := GetTextTokens([]string{"Quick brown fox"}, "en", "")
 	require.NoError(t, err)
 	require.NotNil(t, tokens)
 	require.Equal(t, 3, len(tokens))
 }
 
 func TestGetTextTokensInvalidLang(t *testing.T) {
-	tokens, err := GetTextTokens([]string{"Quick brown fox"}, "no_such_language")
+	tokens, err := GetTextTokens([]string{"Quick brown fox"}, "no_such_language", "")
 	require.Error(t, err)
 	require.Nil(t, tokens)
 }
@@ -299,3 +383,152 @@ func checkSortedAndUnique(t *testing.T, tokens []string) {
 		set[tok] = struct{}{}
 	}
 }
+
+func TestHighlight(t *testing.T) {
+	opts := HighlightOptions{Pre: "<em>", Post: "</em>"}
+	text := "The runner runs, and the man was running."
+	got, err := Highlight(FullTextTokenizer{}, text, "the running", opts)
+	require.NoError(t, err)
+	// Stop words aren't matched, and the words are matched by their stem.
+	require.Equal(t, "The runner <em>runs</em>, and the man was <em>running</em>.", got)
+
+	got, err = Highlight(TermTokenizer{}, text, "RUNS", opts)
+	require.NoError(t, err)
+	require.Equal(t, "The runner <em>runs</em>, and the man was running.", got)
+
+	got, err = Highlight(FullTextTokenizer{}, text, "dog", opts)
+	require.NoError(t, err)
+	require.Equal(t, text, got)
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	opts := HighlightOptions{Pre: "[", Post: "]", Snippet: 3}
+	text := "one two three four five six seven"
+	got, err := Highlight(TermTokenizer{}, text, "four", opts)
+	require.NoError(t, err)
+	require.Equal(t, "...three [four] five...", got)
+
+	got, err = Highlight(TermTokenizer{}, text, "two", opts)
+	require.NoError(t, err)
+	require.Equal(t, "one [two] three...", got)
+
+	got, err = Highlight(TermTokenizer{}, text, "seven", opts)
+	require.NoError(t, err)
+	require.Equal(t, "...five six [seven]", got)
+}
+
+func TestHighlightCJK(t *testing.T) {
+	tokenizer, has := GetTokenizer(FtsTokenizerName("zh"))
+	require.True(t, has)
+	// The overlapping bigrams of the match are marked once.
+	got, err := Highlight(tokenizer, "他是一个薪水很高的商人", "薪水很高",
+		HighlightOptions{Pre: "<em>", Post: "</em>"})
+	require.NoError(t, err)
+	require.Equal(t, "他是一个<em>薪水很高</em>的商人", got)
+}
+
+func TestDefineAnalyzer(t *testing.T) {
+	_, has := GetTokenizer("fulltext(recipes)")
+	require.False(t, has)
+
+	require.NoError(t, DefineAnalyzer(&protos.AnalyzerUpdate{
+		Name:         "recipes",
+		CharFilters:  []string{"html", "mapping"},
+		TokenFilters: []string{"lowercase", "stop", "synonyms", "stem(en)"},
+		StopWords:    []string{"with", "and"},
+		Synonyms:     []string{"aubergine, eggplant", "courgette => zucchini"},
+		Mappings:     []string{"& => and"},
+	}))
+	defer RemoveAnalyzer("recipes")
+	tokenizer, has := GetTokenizer("fulltext(recipes)")
+	require.True(t, has)
+	require.Equal(t, "fulltext(recipes)", tokenizer.Name())
+	require.Equal(t, FullTextTokenizer{}.Identifier(), tokenizer.Identifier())
+
+	tokens, err := tokenizer.Tokens("<b>Roasted</b> Eggplant & Courgettes with <i>the</i> herbs")
+	require.NoError(t, err)
+	// The built-in stop words are kept, and synonyms are replaced before stemming.
+	require.Equal(t, []string{"aubergin", "courgett", "herb", "roast", "the"}, tokens)
+
+	tokens, err = GetTextTokens([]string{"courgette"}, "de", "recipes")
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken("zucchini", tokenizer.Identifier())}, tokens)
+
+	// Redefining the analyzer replaces it.
+	require.NoError(t, DefineAnalyzer(&protos.AnalyzerUpdate{
+		Name:      "recipes",
+		Tokenizer: "whitespace",
+	}))
+	tokens, err = tokenizer.Tokens("Eggplant, roasted.")
+	require.NoError(t, err)
+	require.Equal(t, []string{"Eggplant,", "roasted."}, tokens)
+}
+
+func TestDefineAnalyzerError(t *testing.T) {
+	tests := []struct {
+		def *protos.AnalyzerUpdate
+		err string
+	}{
+		{&protos.AnalyzerUpdate{Tokenizer: "letter"}, "Unknown tokenizer letter"},
+		{&protos.AnalyzerUpdate{CharFilters: []string{"pattern"}}, "Unknown char filter pattern"},
+		{&protos.AnalyzerUpdate{CharFilters: []string{"mapping"}}, "needs mappings"},
+		{&protos.AnalyzerUpdate{CharFilters: []string{"mapping"}, Mappings: []string{"a -> b"}},
+			"Invalid mapping"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"uppercase"}}, "Unknown token filter"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"stem"}}, "needs a language"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"stem(xx)"}}, "isn't supported"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"lowercase(en)"}}, "doesn't take"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"stop"}}, "needs stop_words"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"synonyms"}}, "needs synonyms"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"synonyms"}, Synonyms: []string{"tv"}},
+			"at least two terms"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"synonyms"},
+			Synonyms: []string{"tv, flat screen"}}, "single terms"},
+		{&protos.AnalyzerUpdate{TokenFilters: []string{"synonyms"},
+			Synonyms: []string{"tv => television", "tv => telly"}}, "more than one synonym"},
+	}
+	for _, tc := range tests {
+		tc.def.Name = "broken"
+		err := CheckAnalyzer(tc.def)
+		require.Error(t, err, "%+v", tc.def)
+		require.Contains(t, err.Error(), tc.err)
+	}
+	require.Error(t, DefineAnalyzer(tests[0].def))
+	require.False(t, HasAnalyzer("broken"))
+}
+
+func TestHighlightAnalyzer(t *testing.T) {
+	require.NoError(t, DefineAnalyzer(&protos.AnalyzerUpdate{
+		Name:         "markup",
+		CharFilters:  []string{"html"},
+		TokenFilters: []string{"lowercase"},
+	}))
+	defer RemoveAnalyzer("markup")
+	// The words are found once the tags are blanked out, but marked in the original text.
+	got, err := Highlight(FullTextTokenizer{Analyzer: "markup"}, "<p>Fresh <b>basil</b></p>",
+		"<i>BASIL</i>", HighlightOptions{Pre: "[", Post: "]"})
+	require.NoError(t, err)
+	require.Equal(t, "<p>Fresh <b>[basil]</b></p>", got)
+
+	require.NoError(t, DefineAnalyzer(&protos.AnalyzerUpdate{
+		Name:         "symbols",
+		CharFilters:  []string{"html", "mapping"},
+		Mappings:     []string{"& => and", "C++ => cplusplus"},
+		TokenFilters: []string{"lowercase"},
+	}))
+	defer RemoveAnalyzer("symbols")
+	// The mapped strings are marked as they were written.
+	got, err = Highlight(FullTextTokenizer{Analyzer: "symbols"}, "Rock & roll, <b>C++</b>",
+		"and cplusplus", HighlightOptions{Pre: "[", Post: "]"})
+	require.NoError(t, err)
+	require.Equal(t, "Rock [&] roll, <b>[C++]</b>", got)
+}
+
+func TestMappingCharFilter(t *testing.T) {
+	f, err := newMappingCharFilter([]string{"ph => f", "p => b", "& =>"})
+	require.NoError(t, err)
+	out, spans := f.filterSpans([]byte("phone & pen"))
+	require.Equal(t, "fone  ben", string(out))
+	require.Equal(t, []span{{0, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {7, 8}, {8, 9}, {9, 10},
+		{10, 11}}, spans)
+}
@@ -18,6 +18,10 @@
 package tok
 
 import (
+	"bytes"
+
+	"github.com/blevesearch/bleve/analysis"
+
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -29,12 +33,15 @@ func GetTokens(funcArgs []string) ([]string, error) {
 	return tokenize(funcArgs, termTokenizer)
 }
 
-func GetTextTokens(funcArgs []string, lang string) ([]string, error) {
-	t, found := GetTokenizer("fulltext" + lang)
+// GetTextTokens returns the full-text tokens of the function argument, as given by the
+// analyzer defined in the schema or, without one, by the analyzer of the language.
+func GetTextTokens(funcArgs []string, lang, analyzer string) ([]string, error) {
+	name := FullTextTokenizer{Lang: lang, Analyzer: analyzer}.Name()
+	t, found := GetTokenizer(name)
 	if found {
 		return tokenize(funcArgs, t)
 	}
-	return nil, x.Errorf("Tokenizer not found for %s", "fulltext"+lang)
+	return nil, x.Errorf("Tokenizer not found for %s", name)
 }
 
 func tokenize(funcArgs []string, tokenizer Tokenizer) ([]string, error) {
@@ -44,3 +51,112 @@ func tokenize(funcArgs []string, tokenizer Tokenizer) ([]string, error) {
 	}
 	return BuildTokens(funcArgs[0], tokenizer)
 }
+
+// HighlightOptions says how the matches are marked by Highlight.
+type HighlightOptions struct {
+	// Pre and Post are put before and after every match.
+	Pre, Post string
+	// Snippet, if above 0, is the number of terms kept around the first match. The text
+	// is cut before and after them, which is shown with an ellipsis.
+	Snippet int
+}
+
+// Highlight marks the words of the text whose term matches one of those of the query. The
+// text and the query are analyzed by the analyzer of the tokenizer, the same one used to
+// index the text and to look up the query, so that the words found by stemming match too
+// and stop words never do. The text isn't escaped. If the analyzer has char filters, the
+// words are marked in the text as it was given, not in the filtered one.
+func Highlight(t Tokenizer, text, query string, opts HighlightOptions) (string, error) {
+	analyzer, err := analyzerNamed(t.Name())
+	if err != nil {
+		return "", err
+	}
+	terms := make(map[string]bool)
+	for _, token := range analyzer.Analyze([]byte(query)) {
+		terms[string(token.Term)] = true
+	}
+	var tokens analysis.TokenStream
+	if len(analyzer.CharFilters) == 0 {
+		tokens = analyzer.Analyze([]byte(text))
+	} else {
+		// The words are found in the filtered text, and their offsets are mapped back to
+		// the text as it was given.
+		filtered, spans, err := filterText(analyzer.CharFilters, []byte(text))
+		if err != nil {
+			return "", err
+		}
+		unfiltered := &analysis.Analyzer{
+			Tokenizer:    analyzer.Tokenizer,
+			TokenFilters: analyzer.TokenFilters,
+		}
+		tokens = unfiltered.Analyze(filtered)
+		for _, token := range tokens {
+			token.Start, token.End = spans[token.Start].start, spans[token.End-1].end
+		}
+	}
+
+	start, end := 0, len(text)
+	if opts.Snippet > 0 && len(tokens) > opts.Snippet {
+		first := 0
+		for i, token := range tokens {
+			if terms[string(token.Term)] {
+				first = i
+				break
+			}
+		}
+		// The first match is kept in the middle of the snippet, unless it's near an end.
+		lo := first - (opts.Snippet-1)/2
+		if lo < 0 {
+			lo = 0
+		}
+		hi := lo + opts.Snippet - 1
+		if hi >= len(tokens) {
+			hi = len(tokens) - 1
+			lo = hi - opts.Snippet + 1
+		}
+		if lo > 0 {
+			start = tokens[lo].Start
+		}
+		if hi < len(tokens)-1 {
+			end = tokens[hi].End
+		}
+		tokens = tokens[lo : hi+1]
+	}
+
+	var buf bytes.Buffer
+	if start > 0 {
+		buf.WriteString("...")
+	}
+	prev := start
+	for _, m := range matchedRanges(tokens, terms) {
+		buf.WriteString(text[prev:m.Start])
+		buf.WriteString(opts.Pre)
+		buf.WriteString(text[m.Start:m.End])
+		buf.WriteString(opts.Post)
+		prev = m.End
+	}
+	buf.WriteString(text[prev:end])
+	if end < len(text) {
+		buf.WriteString("...")
+	}
+	return buf.String(), nil
+}
+
+// matchedRanges returns the ranges of the text covered by the tokens with one of the terms.
+// Overlapping ranges, as those of the bigrams of CJK text, are merged.
+func matchedRanges(tokens analysis.TokenStream, terms map[string]bool) []*analysis.Token {
+	var out []*analysis.Token
+	for _, token := range tokens {
+		if !terms[string(token.Term)] {
+			continue
+		}
+		if n := len(out); n > 0 && token.Start <= out[n-1].End {
+			if token.End > out[n-1].End {
+				out[n-1].End = token.End
+			}
+			continue
+		}
+		out = append(out, &analysis.Token{Start: token.Start, End: token.End})
+	}
+	return out
+}
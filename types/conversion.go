@@ -520,6 +520,12 @@ func ObjectValue(id TypeID, value interface{}) (*protos.Value, error) {
 			return def, x.Errorf("Expected value of type password. Got : %v", value)
 		}
 		return &protos.Value{&protos.Value_PasswordVal{v}}, nil
+	case UidID:
+		var v uint64
+		if v, ok = value.(uint64); !ok {
+			return def, x.Errorf("Expected value of type uint64. Got : %v", value)
+		}
+		return &protos.Value{&protos.Value_UidVal{v}}, nil
 	default:
 		return def, x.Errorf("ObjectValue not available for: %v", id)
 	}
@@ -396,3 +396,13 @@ func TestMatchesFilterNearPoint(t *testing.T) {
 	})
 	require.True(t, qd.MatchesFilter(poly))
 }
+
+func TestPointDistance(t *testing.T) {
+	a := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 0})
+	b := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 0})
+	c := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 1})
+	// A degree on a great circle of the earth is about 111.2 km.
+	require.InDelta(t, 111195, float64(PointDistance(a, b)), 1)
+	require.InDelta(t, 111195, float64(PointDistance(a, c)), 1)
+	require.InDelta(t, 0, float64(PointDistance(b, b)), 1e-6)
+}
@@ -26,10 +26,11 @@ import (
 )
 
 type sortBase struct {
-	values [][]Val // Each uid could have multiple values which we need to sort it by.
-	desc   []bool  // Sort orders for different values.
-	ul     *protos.List
-	o      []*protos.Facets
+	values     [][]Val // Each uid could have multiple values which we need to sort it by.
+	desc       []bool  // Sort orders for different values.
+	nullsFirst []bool  // Whether missing values come before the others.
+	ul         *protos.List
+	o          []*protos.Facets
 }
 
 // Len returns size of vector.
@@ -54,14 +55,13 @@ func (s byValue) Less(i, j int) bool {
 		return false
 	}
 	for vidx, _ := range first {
-		// Null value is considered greatest hence comes at first place while doing descending sort
-		// and at last place while doing ascending sort.
-		if first[vidx].Value == nil {
-			return s.desc[vidx]
-		}
-
-		if second[vidx].Value == nil {
-			return !s.desc[vidx]
+		switch {
+		case first[vidx].Value == nil && second[vidx].Value == nil:
+			continue
+		case first[vidx].Value == nil:
+			return s.nullsFirst[vidx]
+		case second[vidx].Value == nil:
+			return !s.nullsFirst[vidx]
 		}
 
 		// We have to look at next value to decide.
@@ -81,11 +81,31 @@ func (s byValue) Less(i, j int) bool {
 
 // Sort sorts the given array in-place.
 func SortWithFacet(v [][]Val, ul *protos.List, l []*protos.Facets, desc []bool) error {
+	// Null value is considered greatest hence comes at first place while doing descending sort
+	// and at last place while doing ascending sort.
+	return sortWithNulls(v, ul, l, desc, desc)
+}
+
+// SortWithNulls sorts the given array in-place, with the missing values before or after
+// the others as given by nullsFirst.
+func SortWithNulls(v [][]Val, ul *protos.List, desc, nullsFirst []bool) error {
+	return sortWithNulls(v, ul, nil, desc, nullsFirst)
+}
+
+func sortWithNulls(v [][]Val, ul *protos.List, l []*protos.Facets, desc,
+	nullsFirst []bool) error {
 	if len(v) == 0 || len(v[0]) == 0 {
 		return nil
 	}
 
 	typ := v[0][0].Tid
+	for _, vals := range v {
+		// Missing values don't have a type to sort by.
+		if vals[0].Value != nil {
+			typ = vals[0].Tid
+			break
+		}
+	}
 	switch typ {
 	case DateTimeID, IntID, FloatID, StringID, DefaultID:
 		// Don't do anything, we can sort values of this type.
@@ -93,9 +113,11 @@ func SortWithFacet(v [][]Val, ul *protos.List, l []*protos.Facets, desc []bool)
 		return fmt.Errorf("Value of type: %s isn't sortable.", typ.Name())
 	}
 	var toBeSorted sort.Interface
-	b := sortBase{v, desc, ul, l}
+	b := sortBase{v, desc, nullsFirst, ul, l}
 	toBeSorted = byValue{b}
-	sort.Sort(toBeSorted)
+	// The sort is stable, so that elements with equal values keep their order, which is
+	// by uid. Cursors used for pagination depend on it.
+	sort.Stable(toBeSorted)
 	return nil
 }
 
@@ -159,3 +159,28 @@ func TestSortMismatchedTypes(t *testing.T) {
 	require.True(t, idx21 < idx33)
 	require.True(t, idx33 < idx55)
 }
+
+func TestSortWithNulls(t *testing.T) {
+	vals := func(in ...interface{}) [][]Val {
+		list := make([][]Val, len(in))
+		for i, v := range in {
+			list[i] = []Val{{Tid: IntID, Value: v}}
+		}
+		return list
+	}
+
+	ul := getUIDList(4)
+	require.NoError(t, SortWithNulls(vals(nil, int64(2), nil, int64(1)), ul,
+		[]bool{false}, []bool{true}))
+	require.EqualValues(t, []uint64{100, 300, 400, 200}, ul.Uids)
+
+	ul = getUIDList(4)
+	require.NoError(t, SortWithNulls(vals(nil, int64(2), nil, int64(1)), ul,
+		[]bool{true}, []bool{false}))
+	require.EqualValues(t, []uint64{200, 400, 100, 300}, ul.Uids)
+
+	// By default, a missing value is greater than the others.
+	ul = getUIDList(3)
+	require.NoError(t, Sort(vals(nil, int64(2), int64(1)), ul, []bool{true}))
+	require.EqualValues(t, []uint64{100, 200, 300}, ul.Uids)
+}
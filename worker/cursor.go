@@ -0,0 +1,190 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"bytes"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// needVals returns true if the sort values of the uids have to be kept while sorting.
+func needVals(ts *protos.SortMessage) bool {
+	return len(ts.Order) > 1 || ts.Cursor != nil || ts.ReturnCursors
+}
+
+// sortCursor is the position in the sort order after which results are returned.
+// Elements with equal sort values are ordered by their uid.
+type sortCursor struct {
+	vals []types.Val
+	uid  uint64
+	desc []bool
+	// nullsFirst is whether the missing values come first for every sort key, when
+	// they don't come as if they were greater than the others.
+	nullsFirst []bool
+	// key is the index key of the bucket of the first value, set while sorting with the
+	// index.
+	key []byte
+}
+
+func newSortCursor(ts *protos.SortMessage) (*sortCursor, error) {
+	c := ts.Cursor
+	if c == nil {
+		return nil, nil
+	}
+	if ts.Offset != 0 {
+		return nil, x.Errorf("Offset can't be used along with a cursor")
+	}
+	if len(c.Values) != len(ts.Order) {
+		return nil, x.Errorf("Cursor doesn't match the sort order. Expected %d values, got %d",
+			len(ts.Order), len(c.Values))
+	}
+
+	cur := &sortCursor{uid: c.Uid}
+	if ts.Nulls != "" {
+		cur.nullsFirst = nullsFirst(ts)
+	}
+	for i, o := range ts.Order {
+		cur.desc = append(cur.desc, o.Desc)
+		tv := c.Values[i]
+		typ := types.TypeID(tv.ValType)
+		if bytes.Equal(tv.Val, x.Nilbyte) {
+			cur.vals = append(cur.vals, types.Val{Tid: typ})
+			continue
+		}
+		val := types.ValueForType(typ)
+		val.Value = tv.Val
+		sv, err := types.Convert(val, typ)
+		if err != nil {
+			return nil, x.Wrapf(err, "Invalid cursor")
+		}
+		cur.vals = append(cur.vals, sv)
+	}
+	return cur, nil
+}
+
+// clone returns a copy of the cursor, so that it can be used by concurrent sorts.
+func (c *sortCursor) clone() *sortCursor {
+	if c == nil {
+		return nil
+	}
+	cc := *c
+	return &cc
+}
+
+// cmp compares the position of an element with the one of the cursor. It returns 1 if
+// the element comes after the cursor and -1 if it doesn't. If only some of the sort
+// values of the element are given and they are equal to the ones of the cursor, it
+// returns 0.
+func (c *sortCursor) cmp(vals []types.Val, uid uint64) (int, error) {
+	for i, v := range vals {
+		cv := c.vals[i]
+		var after bool
+		switch {
+		case v.Value == nil && cv.Value == nil:
+			continue
+		case v.Value == nil:
+			after = !c.nullFirst(i)
+		case cv.Value == nil:
+			after = c.nullFirst(i)
+		default:
+			eq, err := types.Equal(v, cv)
+			if err != nil {
+				return 0, err
+			}
+			if eq {
+				continue
+			}
+			less, err := types.Less(v, cv)
+			if err != nil {
+				return 0, err
+			}
+			after = less == c.desc[i]
+		}
+		if after {
+			return 1, nil
+		}
+		return -1, nil
+	}
+
+	if len(vals) < len(c.vals) {
+		return 0, nil
+	}
+	if uid > c.uid {
+		return 1, nil
+	}
+	return -1, nil
+}
+
+// nullFirst returns true if the missing values of the i-th sort key come first. By default
+// a missing value is greater than all the others, like in types.Sort.
+func (c *sortCursor) nullFirst(i int) bool {
+	if c.nullsFirst != nil {
+		return c.nullsFirst[i]
+	}
+	return c.desc[i]
+}
+
+// apply removes the uids of the sorted list, along with their values, which don't come
+// after the cursor. With multiple sort attributes, the uids with a first value equal to
+// the one of the cursor are kept for multiSort to decide and their number is returned.
+func (c *sortCursor) apply(ul *protos.List, vals []types.Val) ([]types.Val, int, error) {
+	uids := ul.Uids[:0]
+	kept := vals[:0]
+	var ties int
+	for i, uid := range ul.Uids {
+		r, err := c.cmp(vals[i:i+1], uid)
+		if err != nil {
+			return nil, 0, err
+		}
+		if r < 0 {
+			continue
+		}
+		if r == 0 {
+			ties++
+		}
+		uids = append(uids, uid)
+		kept = append(kept, vals[i])
+	}
+	ul.Uids = uids
+	return kept, ties, nil
+}
+
+// toCursor returns the cursor for the last uid of the list, given its sort values. The
+// cursor of an empty list is empty.
+func toCursor(uids []uint64, vals []types.Val) (*protos.Cursor, error) {
+	c := &protos.Cursor{}
+	if len(uids) == 0 {
+		return c, nil
+	}
+	c.Uid = uids[len(uids)-1]
+	for _, v := range vals {
+		tv := &protos.TaskValue{ValType: int32(v.Tid), Val: x.Nilbyte}
+		if v.Value != nil {
+			b := types.ValueForType(types.BinaryID)
+			if err := types.Marshal(v, &b); err != nil {
+				return nil, err
+			}
+			tv.Val = b.Value.([]byte)
+		}
+		c.Values = append(c.Values, tv)
+	}
+	return c, nil
+}
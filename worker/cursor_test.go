@@ -0,0 +1,128 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func intVal(i int64) types.Val {
+	return types.Val{Tid: types.IntID, Value: i}
+}
+
+func strVal(s string) types.Val {
+	return types.Val{Tid: types.StringID, Value: s}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	c, err := toCursor([]uint64{3, 7}, []types.Val{strVal("alice"), {Tid: types.IntID}})
+	require.NoError(t, err)
+	require.EqualValues(t, 7, c.Uid)
+
+	ts := &protos.SortMessage{
+		Order:  []*protos.Order{{Attr: "name"}, {Attr: "age", Desc: true}},
+		Cursor: c,
+	}
+	cur, err := newSortCursor(ts)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, cur.uid)
+	require.Equal(t, []bool{false, true}, cur.desc)
+	require.Equal(t, strVal("alice"), cur.vals[0])
+	require.Nil(t, cur.vals[1].Value)
+
+	ts.Offset = 2
+	_, err = newSortCursor(ts)
+	require.Error(t, err)
+
+	ts.Offset = 0
+	ts.Order = ts.Order[:1]
+	_, err = newSortCursor(ts)
+	require.Error(t, err)
+
+	empty, err := toCursor(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, &protos.Cursor{}, empty)
+}
+
+func TestCursorCmp(t *testing.T) {
+	asc := &sortCursor{vals: []types.Val{intVal(10)}, uid: 5, desc: []bool{false}}
+	for _, tc := range []struct {
+		val int64
+		uid uint64
+		cmp int
+	}{
+		{9, 9, -1},
+		{10, 4, -1},
+		{10, 5, -1},
+		{10, 6, 1},
+		{11, 1, 1},
+	} {
+		c, err := asc.cmp([]types.Val{intVal(tc.val)}, tc.uid)
+		require.NoError(t, err)
+		require.Equal(t, tc.cmp, c, "%+v", tc)
+	}
+	// Missing values come last in ascending order.
+	c, err := asc.cmp([]types.Val{{Tid: types.IntID}}, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, c)
+
+	desc := &sortCursor{vals: []types.Val{intVal(10), strVal("b")}, uid: 5,
+		desc: []bool{true, false}}
+	c, err = desc.cmp([]types.Val{intVal(11)}, 1)
+	require.NoError(t, err)
+	require.Equal(t, -1, c)
+	c, err = desc.cmp([]types.Val{intVal(9)}, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, c)
+	// Undecided with only the first value.
+	c, err = desc.cmp([]types.Val{intVal(10)}, 1)
+	require.NoError(t, err)
+	require.Equal(t, 0, c)
+	c, err = desc.cmp([]types.Val{intVal(10), strVal("a")}, 9)
+	require.NoError(t, err)
+	require.Equal(t, -1, c)
+	c, err = desc.cmp([]types.Val{intVal(10), strVal("c")}, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, c)
+}
+
+func TestCursorApply(t *testing.T) {
+	cur := &sortCursor{vals: []types.Val{intVal(2)}, uid: 4, desc: []bool{false}}
+	ul := &protos.List{Uids: []uint64{1, 3, 4, 6, 2}}
+	vals := []types.Val{intVal(1), intVal(2), intVal(2), intVal(2), intVal(3)}
+	vals, ties, err := cur.apply(ul, vals)
+	require.NoError(t, err)
+	require.Equal(t, 0, ties)
+	require.Equal(t, []uint64{6, 2}, ul.Uids)
+	require.Equal(t, []types.Val{intVal(2), intVal(3)}, vals)
+
+	// With two sort values, the uids equal on the first one are kept.
+	cur = &sortCursor{vals: []types.Val{intVal(2), strVal("x")}, uid: 4,
+		desc: []bool{false, false}}
+	ul = &protos.List{Uids: []uint64{1, 3, 4, 6, 2}}
+	vals = []types.Val{intVal(1), intVal(2), intVal(2), intVal(2), intVal(3)}
+	_, ties, err = cur.apply(ul, vals)
+	require.NoError(t, err)
+	require.Equal(t, 3, ties)
+	require.Equal(t, []uint64{3, 4, 6, 2}, ul.Uids)
+}
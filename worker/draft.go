@@ -294,6 +294,20 @@ func (n *node) processSchemaMutations(pid uint32, index uint64,
 	return nil
 }
 
+func (n *node) processAnalyzerUpdate(pid uint32, index uint64, startTs uint64,
+	a *protos.AnalyzerUpdate, reindex []string) error {
+	ctx, _ := n.props.CtxAndTxn(pid)
+	rv := x.RaftValue{Group: n.gid, Index: index}
+	ctx = context.WithValue(ctx, "raft", rv)
+	if err := runAnalyzerUpdate(ctx, a, reindex, startTs); err != nil {
+		if tr, ok := trace.FromContext(n.ctx); ok {
+			tr.LazyPrintf(err.Error())
+		}
+		return err
+	}
+	return nil
+}
+
 func (n *node) applyConfChange(e raftpb.Entry) {
 	var cc raftpb.ConfChange
 	cc.Unmarshal(e.Data)
@@ -83,21 +83,17 @@ func toRDF(buf *bytes.Buffer, item kv, readTs uint64) {
 				str.Value = ""
 			}
 			x.Check(err)
-			buf.WriteString(strconv.Quote(str.Value.(string)))
+			var lang string
 			if p.PostingType == protos.Posting_VALUE_LANG {
-				buf.WriteByte('@')
-				buf.WriteString(string(p.Metadata))
-			} else if vID != types.DefaultID {
-				rdfType, ok := rdfTypeMap[vID]
-				x.AssertTruef(ok, "Didn't find RDF type for dgraph type: %+v", vID.Name())
-				buf.WriteString("^^<")
-				buf.WriteString(rdfType)
-				buf.WriteByte('>')
+				lang = string(p.Metadata)
+			} else {
+				_, ok := rdfTypeMap[vID]
+				x.AssertTruef(ok || vID == types.DefaultID,
+					"Didn't find RDF type for dgraph type: %+v", vID.Name())
 			}
+			WriteRDFValue(buf, str.Value.(string), vID, lang)
 		} else {
-			buf.WriteString("<_:uid")
-			buf.WriteString(strconv.FormatUint(p.Uid, 16))
-			buf.WriteByte('>')
+			WriteRDFUid(buf, p.Uid)
 		}
 		// Label
 		if len(p.Label) > 0 {
@@ -136,6 +132,27 @@ func toRDF(buf *bytes.Buffer, item kv, readTs uint64) {
 	}
 }
 
+// WriteRDFValue writes the object of an N-Quad for a value: the quoted value followed
+// by its language, or by its RDF type if it has one.
+func WriteRDFValue(buf *bytes.Buffer, val string, tid types.TypeID, lang string) {
+	buf.WriteString(strconv.Quote(val))
+	if lang != "" {
+		buf.WriteByte('@')
+		buf.WriteString(lang)
+	} else if rdfType, ok := rdfTypeMap[tid]; ok {
+		buf.WriteString("^^<")
+		buf.WriteString(rdfType)
+		buf.WriteByte('>')
+	}
+}
+
+// WriteRDFUid writes the uid as the blank node used for it in N-Quads.
+func WriteRDFUid(buf *bytes.Buffer, uid uint64) {
+	buf.WriteString("<_:uid")
+	buf.WriteString(strconv.FormatUint(uid, 16))
+	buf.WriteByte('>')
+}
+
 func toSchema(buf *bytes.Buffer, s *skv) {
 	if strings.ContainsRune(s.attr, ':') {
 		buf.WriteRune('<')
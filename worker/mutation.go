@@ -22,6 +22,7 @@ import (
 	"errors"
 	"math"
 	"math/rand"
+	"reflect"
 	"time"
 
 	"golang.org/x/net/context"
@@ -32,6 +33,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/dgraph/y"
@@ -41,6 +43,10 @@ var (
 	errUnservedTablet  = x.Errorf("Tablet isn't being served by this instance.")
 	errPredicateMoving = x.Errorf("Predicate is being moved, please retry later")
 	errAborted         = x.Errorf("Transaction aborted")
+
+	// ErrBudgetExceeded is returned when a query fetches more uids and values than its
+	// result budget allows.
+	ErrBudgetExceeded = x.Errorf("Query exceeded budget. Please modify the query")
 )
 
 func deletePredicateEdge(edge *protos.DirectedEdge) bool {
@@ -210,6 +216,104 @@ func updateSchema(attr string, s protos.SchemaUpdate, index uint64) error {
 	return txn.CommitAt(1, nil)
 }
 
+// updateType writes the definition of a type to memory and disk. Type definitions are
+// stored by every group, so that queries can expand nodes by their types locally.
+func updateType(typeName string, t protos.TypeUpdate, index uint64) error {
+	schema.State().SetType(typeName, t)
+	txn := pstore.NewTransactionAt(1, true)
+	defer txn.Discard()
+	data, err := t.Marshal()
+	x.Check(err)
+	if err := txn.Set(x.TypeKey(typeName), data); err != nil {
+		return err
+	}
+	return txn.CommitAt(1, nil)
+}
+
+// analyzerReindexes returns the predicates served here whose full-text index has to be
+// rebuilt for the analyzer, as it was defined otherwise before.
+func analyzerReindexes(a *protos.AnalyzerUpdate) []string {
+	old, ok := schema.State().GetAnalyzer(a.Name)
+	if !ok || reflect.DeepEqual(old, *a) {
+		return nil
+	}
+	var attrs []string
+	tokenizer := tok.FullTextTokenizer{Analyzer: a.Name}.Name()
+	for _, attr := range schema.State().IndexedFields() {
+		if !groups().ServesTablet(attr) {
+			continue
+		}
+		for _, name := range schema.State().TokenizerNames(attr) {
+			if name == tokenizer {
+				attrs = append(attrs, attr)
+				break
+			}
+		}
+	}
+	return attrs
+}
+
+// runAnalyzerUpdate writes the definition of a full-text analyzer to memory and disk, and
+// rebuilds the full-text index of the given predicates with it. Like type definitions,
+// analyzer definitions are stored by every group.
+func runAnalyzerUpdate(ctx context.Context, a *protos.AnalyzerUpdate, reindex []string,
+	startTs uint64) error {
+	if err := schema.State().SetAnalyzer(a.Name, *a); err != nil {
+		return err
+	}
+	txn := pstore.NewTransactionAt(1, true)
+	defer txn.Discard()
+	data, err := a.Marshal()
+	x.Check(err)
+	if err := txn.Set(x.AnalyzerKey(a.Name), data); err != nil {
+		return err
+	}
+	if err := txn.CommitAt(1, nil); err != nil {
+		return err
+	}
+
+	n := groups().Node
+	for _, attr := range reindex {
+		if err := n.rebuildOrDelIndex(ctx, attr, true, startTs); err != nil {
+			return err
+		}
+		posting.CommitLists(func(key []byte) bool {
+			pk := x.Parse(key)
+			return pk != nil && pk.Attr == attr
+		})
+	}
+	return nil
+}
+
+// addSchemaAnalyzers adds to the analyzers those used by the full-text indexes of the
+// schema updates, as the group of a predicate might not have been known when they were
+// defined. They are taken from the defined ones, or else from the local schema.
+func addSchemaAnalyzers(analyzers []*protos.AnalyzerUpdate, updates []*protos.SchemaUpdate,
+	defined []*protos.AnalyzerUpdate) []*protos.AnalyzerUpdate {
+	find := func(list []*protos.AnalyzerUpdate, name string) *protos.AnalyzerUpdate {
+		for _, a := range list {
+			if a.Name == name {
+				return a
+			}
+		}
+		return nil
+	}
+	for _, su := range updates {
+		for _, t := range su.Tokenizer {
+			name, ok := tok.AnalyzerOf(t)
+			if !ok || find(analyzers, name) != nil {
+				continue
+			}
+			if a := find(defined, name); a != nil {
+				analyzers = append(analyzers, a)
+			} else if a, ok := schema.State().GetAnalyzer(name); ok {
+				analyzers = append(analyzers, &a)
+			}
+		}
+	}
+	return analyzers
+}
+
 func updateSchemaType(attr string, typ types.TypeID, index uint64) {
 	// Don't overwrite schema blindly, acl's might have been set even though
 	// type is not present
@@ -438,6 +542,24 @@ func addToMutationMap(mutationMap map[uint32]*protos.Mutations, src *protos.Muta
 		mu.Schema = append(mu.Schema, schema)
 	}
 
+	if len(src.Types) > 0 || len(src.Analyzers) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			if gid == 0 {
+				continue
+			}
+			mu := mutationMap[gid]
+			if mu == nil {
+				mu = &protos.Mutations{GroupId: gid}
+				mutationMap[gid] = mu
+			}
+			mu.Types = src.Types
+			mu.Analyzers = src.Analyzers
+		}
+	}
+	for _, mu := range mutationMap {
+		mu.Analyzers = addSchemaAnalyzers(mu.Analyzers, mu.Schema, src.Analyzers)
+	}
+
 	if src.DropAll {
 		for _, gid := range groups().KnownGroups() {
 			mu := mutationMap[gid]
@@ -130,6 +130,28 @@ func TestAddToMutationArray(t *testing.T) {
 	require.NotNil(t, mu.Edges)
 }
 
+func TestAddSchemaAnalyzers(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		analyzer recipes {
+			token_filters: [lowercase]
+		}
+		text: string @index(fulltext(recipes)) .
+	`), 1))
+	defined := []*protos.AnalyzerUpdate{{Name: "notes", TokenFilters: []string{"lowercase"}}}
+	updates := []*protos.SchemaUpdate{
+		{Predicate: "text", Tokenizer: []string{"fulltext(recipes)"}},
+		{Predicate: "note", Tokenizer: []string{"term", "fulltext(notes)"}},
+		{Predicate: "name", Tokenizer: []string{"fulltext"}},
+	}
+	analyzers := addSchemaAnalyzers(nil, updates, defined)
+	require.Len(t, analyzers, 2)
+	require.Equal(t, "recipes", analyzers[0].Name)
+	require.Equal(t, defined[0], analyzers[1])
+
+	// The analyzers sent to the group already aren't added again.
+	require.Equal(t, analyzers, addSchemaAnalyzers(analyzers, updates, defined))
+}
+
 func TestCheckSchema(t *testing.T) {
 	dir, _ := initTest(t, "name:string @index(term) .")
 	defer os.RemoveAll(dir)
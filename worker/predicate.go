@@ -197,13 +197,15 @@ func sendKV(stream protos.Worker_PredicateAndSchemaDataServer, it *badger.Iterat
 	item := it.Item()
 	key := item.Key()
 	pk := x.Parse(key)
-	if pk == nil {
+	_, terr := x.TypeName(key)
+	if pk == nil && terr != nil {
 		it.Next()
 		return nil
 	}
 
 	var kv *protos.KV
-	if pk.IsSchema() {
+	if pk == nil || pk.IsSchema() {
+		// Schema and type keys are sent as they are.
 		val, err := item.Value()
 		if err != nil {
 			return err
@@ -32,6 +32,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -49,6 +50,21 @@ func populateKeyValues(ctx context.Context, kvs []*protos.KV) error {
 	groups().waitForBackgroundDeletion()
 	x.Printf("Writing %d keys\n", len(kvs))
 
+	// The analyzers sent with a predicate are needed before its schema is loaded.
+	for _, kv := range kvs {
+		name, err := x.AnalyzerName(kv.Key)
+		if err != nil {
+			continue
+		}
+		var a protos.AnalyzerUpdate
+		if err := a.Unmarshal(kv.Val); err != nil {
+			return err
+		}
+		if err := schema.State().SetAnalyzer(name, a); err != nil {
+			return err
+		}
+	}
+
 	var hasError uint32
 	var wg sync.WaitGroup
 	wg.Add(len(kvs))
@@ -139,6 +155,35 @@ func movePredicateHelper(ctx context.Context, predicate string, gid uint32) erro
 		return err
 	}
 	count++
+
+	// send the analyzers of the full-text index, which the group might not know
+	su, _ := schema.State().Get(predicate)
+	for _, t := range su.Tokenizer {
+		name, ok := tok.AnalyzerOf(t)
+		if !ok {
+			continue
+		}
+		item, err := txn.Get(x.AnalyzerKey(name))
+		if err == badger.ErrKeyNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+		val, err := item.Value()
+		if err != nil {
+			return err
+		}
+		kv := &protos.KV{
+			Key:      x.AnalyzerKey(name),
+			Val:      val,
+			Version:  1,
+			UserMeta: []byte{item.UserMeta()},
+		}
+		if err := stream.Send(kv); err != nil {
+			return err
+		}
+		count++
+	}
 	x.Printf("Sent %d number of keys for predicate %v\n", count, predicate)
 
 	payload, err := stream.CloseAndRecv()
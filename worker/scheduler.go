@@ -125,7 +125,8 @@ func (s *scheduler) schedule(proposal *protos.Proposal, index uint64) (err error
 		return
 	}
 
-	if len(proposal.Mutations.Schema) > 0 {
+	if len(proposal.Mutations.Schema) > 0 || len(proposal.Mutations.Types) > 0 ||
+		len(proposal.Mutations.Analyzers) > 0 {
 		if err = s.n.Applied.WaitForMark(s.n.ctx, index-1); err != nil {
 			return err
 		}
@@ -133,7 +134,28 @@ func (s *scheduler) schedule(proposal *protos.Proposal, index uint64) (err error
 		if startTs == 0 {
 			return errors.New("StartTs must be provided.")
 		}
+		// Analyzers are updated first, as the tokenizers of the predicates can use them.
+		for _, aupdate := range proposal.Mutations.Analyzers {
+			attrs := analyzerReindexes(aupdate)
+			for _, attr := range attrs {
+				if tablet := groups().Tablet(attr); tablet != nil && tablet.ReadOnly {
+					err = errPredicateMoving
+					break
+				}
+				s.waitForConflictResolution(attr)
+			}
+			if err != nil {
+				break
+			}
+			err = s.n.processAnalyzerUpdate(proposal.Id, index, startTs, aupdate, attrs)
+			if err != nil {
+				break
+			}
+		}
 		for _, supdate := range proposal.Mutations.Schema {
+			if err != nil {
+				break
+			}
 			// This is neceassry to ensure that there is no race between when we start reading
 			// from badger and new mutation getting commited via raft and getting applied.
 			// Before Moving the predicate we would flush all and wait for watermark to catch up
@@ -151,6 +173,12 @@ func (s *scheduler) schedule(proposal *protos.Proposal, index uint64) (err error
 				break
 			}
 		}
+		for _, tupdate := range proposal.Mutations.Types {
+			if err != nil {
+				break
+			}
+			err = updateType(tupdate.TypeName, *tupdate, index)
+		}
 		posting.TxnMarks().Done(index)
 		return
 	}
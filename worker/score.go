@@ -0,0 +1,136 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The usual BM25 parameters. k1 bounds how much a term occurring again raises the score,
+// and b is how much longer values are penalized.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// handleScoreFunction scores the uids of the query by how well their value matches the
+// tokens of the function, with BM25. The term frequencies and the value lengths are read
+// from the full-text index. The scores are returned as float values, in the order of the
+// uids.
+func handleScoreFunction(ctx context.Context, arg funcArgs) error {
+	q := arg.q
+	attr := q.Attr
+	uids := q.UidList.GetUids()
+	index := make(map[uint64]int, len(uids))
+	for i, uid := range uids {
+		index[uid] = i
+	}
+	opts := posting.ListOptions{ReadTs: q.ReadTs}
+
+	// The number of values and their average length are over the whole predicate.
+	var numDocs, totalLength int
+	for shard := 0; shard < posting.DocStatsShards; shard++ {
+		pl := posting.Get(x.IndexKey(attr, tok.DocStatsToken(shard)))
+		err := pl.Postings(opts, func(p *protos.Posting) bool {
+			numDocs += posting.FacetInt(p, posting.NumDocsFacet)
+			totalLength += posting.FacetInt(p, posting.DocLengthFacet)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+	lengths := make([]int, len(uids))
+	for i, uid := range uids {
+		pl := posting.Get(x.IndexKey(attr, tok.DocLengthToken(uid)))
+		err := pl.Postings(opts, func(p *protos.Posting) bool {
+			lengths[i] = posting.FacetInt(p, posting.DocLengthFacet)
+			return false
+		})
+		if err != nil {
+			return err
+		}
+	}
+	var avgLength float64
+	if numDocs > 0 {
+		avgLength = float64(totalLength) / float64(numDocs)
+	}
+
+	scores := make([]float64, len(uids))
+	for _, token := range arg.srcFn.tokens {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		var docFreq int
+		freqs := make(map[int]int)
+		pl := posting.Get(x.IndexKey(attr, token))
+		err := pl.Postings(opts, func(p *protos.Posting) bool {
+			docFreq++
+			if i, ok := index[p.Uid]; ok {
+				freqs[i] = posting.FacetInt(p, posting.TermFrequencyFacet)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for i, tf := range freqs {
+			// Values indexed before the frequencies were kept have the term once.
+			if tf == 0 {
+				tf = 1
+			}
+			scores[i] += bm25(tf, lengths[i], docFreq, numDocs, avgLength)
+		}
+	}
+
+	for _, s := range scores {
+		v := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(types.Val{Tid: types.FloatID, Value: s}, &v); err != nil {
+			return err
+		}
+		arg.out.ValueMatrix = append(arg.out.ValueMatrix, &protos.ValueList{
+			Values: []*protos.TaskValue{{ValType: int32(types.FloatID), Val: v.Value.([]byte)}},
+		})
+	}
+	return nil
+}
+
+// bm25 returns the score of a term occurring tf times in a value with length terms. The
+// term is in docFreq of the numDocs values of the predicate, whose average length is
+// avgLength. Without the lengths, as for values indexed before they were kept, the length
+// of the value isn't taken into account.
+func bm25(tf, length, docFreq, numDocs int, avgLength float64) float64 {
+	if numDocs < docFreq {
+		numDocs = docFreq
+	}
+	idf := math.Log(1 + (float64(numDocs-docFreq)+0.5)/(float64(docFreq)+0.5))
+	norm := 1.0
+	if length > 0 && avgLength > 0 {
+		norm = 1 - bm25B + bm25B*float64(length)/avgLength
+	}
+	return idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + bm25K1*norm)
+}
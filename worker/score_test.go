@@ -0,0 +1,38 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBM25(t *testing.T) {
+	// Rarer terms score higher.
+	require.True(t, bm25(1, 10, 1, 100, 10) > bm25(1, 10, 50, 100, 10))
+	// More occurrences score higher, up to k1 + 1 times the idf.
+	require.True(t, bm25(2, 10, 1, 100, 10) > bm25(1, 10, 1, 100, 10))
+	idf := math.Log(1 + 99.5/1.5)
+	require.True(t, bm25(1000, 10, 1, 100, 10) < idf*(bm25K1+1))
+	// Longer values score lower.
+	require.True(t, bm25(1, 20, 1, 100, 10) < bm25(1, 5, 1, 100, 10))
+	// Without lengths, the value is taken to be of the average length.
+	require.Equal(t, bm25(1, 10, 1, 100, 10), bm25(1, 0, 1, 100, 0))
+}
@@ -20,6 +20,7 @@ package worker
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -48,7 +49,7 @@ type sortresult struct {
 
 // SortOverNetwork sends sort query over the network.
 func SortOverNetwork(ctx context.Context, q *protos.SortMessage) (*protos.SortResult, error) {
-	gid := groups().BelongsTo(q.Order[0].Attr)
+	gid := groups().BelongsTo(strings.TrimPrefix(q.Order[0].Attr, "~"))
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf("worker.Sort attr: %v groupId: %v", q.Order[0].Attr, gid)
 	}
@@ -76,7 +77,7 @@ func (w *grpcWorker) Sort(ctx context.Context, s *protos.SortMessage) (*protos.S
 		return &emptySortResult, ctx.Err()
 	}
 
-	gid := groups().BelongsTo(s.Order[0].Attr)
+	gid := groups().BelongsTo(strings.TrimPrefix(s.Order[0].Attr, "~"))
 	if tr, ok := trace.FromContext(ctx); ok {
 		tr.LazyPrintf("Sorting: Attribute: %q groupId: %v Sort", s.Order[0].Attr, gid)
 	}
@@ -105,16 +106,15 @@ var (
 	errDone     = x.Errorf("Done processing buckets")
 )
 
-func sortWithoutIndex(ctx context.Context, ts *protos.SortMessage) *sortresult {
+func sortWithoutIndex(ctx context.Context, ts *protos.SortMessage, cur *sortCursor) *sortresult {
 	n := len(ts.UidMatrix)
 	r := new(protos.SortResult)
 	multiSortVals := make([][]types.Val, n)
 	// Sort and paginate directly as it'd be expensive to iterate over the index which
 	// might have millions of keys just for retrieving some values.
-	sType, err := schema.State().TypeOf(ts.Order[0].Attr)
-	if err != nil || !sType.IsScalar() {
-		return &sortresult{&emptySortResult, nil,
-			x.Errorf("Cannot sort attribute %s of type object.", ts.Order[0].Attr)}
+	sType, err := sortType(ts.Order[0])
+	if err != nil {
+		return &sortresult{&emptySortResult, nil, err}
 	}
 
 	for i := 0; i < n; i++ {
@@ -128,12 +128,20 @@ func sortWithoutIndex(ctx context.Context, ts *protos.SortMessage) *sortresult {
 			if vals, err = sortByValue(ctx, ts, tempList, sType); err != nil {
 				return &sortresult{&emptySortResult, nil, err}
 			}
-			start, end, err := paginate(ts, tempList, vals)
+			var ties int
+			if cur != nil {
+				if vals, ties, err = cur.apply(tempList, vals); err != nil {
+					return &sortresult{&emptySortResult, nil, err}
+				}
+			}
+			start, end, err := paginate(ts, tempList, vals, ties)
 			if err != nil {
 				return &sortresult{&emptySortResult, nil, err}
 			}
 			tempList.Uids = tempList.Uids[start:end]
-			vals = vals[start:end]
+			if needVals(ts) {
+				vals = vals[start:end]
+			}
 			r.UidMatrix = append(r.UidMatrix, tempList)
 			multiSortVals[i] = vals
 		}
@@ -141,20 +149,12 @@ func sortWithoutIndex(ctx context.Context, ts *protos.SortMessage) *sortresult {
 	return &sortresult{r, multiSortVals, nil}
 }
 
-func sortWithIndex(ctx context.Context, ts *protos.SortMessage) *sortresult {
-	n := len(ts.UidMatrix)
-	out := make([]intersectedList, n)
-	values := make([][]types.Val, 0, n) // Values corresponding to uids in the uid matrix.
-	for i := 0; i < n; i++ {
-		// offsets[i] is the offset for i-th posting list. It gets decremented as we
-		// iterate over buckets.
-		out[i].offset = int(ts.Offset)
-		var emptyList protos.List
-		out[i].ulist = &emptyList
+func sortWithIndex(ctx context.Context, ts *protos.SortMessage, cur *sortCursor) *sortresult {
+	if ts.Order[0].Count {
+		return sortWithCountIndex(ctx, ts, cur)
 	}
-
+	out := newIntersectedLists(ts)
 	order := ts.Order[0]
-	r := new(protos.SortResult)
 	// Iterate over every bucket / token.
 	iterOpt := badger.DefaultIteratorOptions
 	iterOpt.PrefetchValues = false
@@ -198,10 +198,37 @@ func sortWithIndex(ctx context.Context, ts *protos.SortMessage) *sortresult {
 
 	indexPrefix := x.IndexKey(order.Attr, string(tokenizer.Identifier()))
 	var seekKey []byte
-	if !order.Desc {
+	switch {
+	case cur != nil && cur.vals[0].Value == nil:
+		// Uids without a value aren't part of the index, so the cursor is among the nulls
+		// and only the index comes after it with nulls first.
+		if ts.Nulls == "first" || ts.Nulls == "last" {
+			if err := addNulls(ctx, ts, out, typ, cur); err != nil {
+				return &sortresult{&emptySortResult, nil, err}
+			}
+		}
+		if ts.Nulls != "first" {
+			return intersectedResult(ctx, ts, out)
+		}
+		cur = nil
+	case cur != nil:
+		tokens, err := tok.BuildTokens(cur.vals[0].Value, tokenizer)
+		if err != nil {
+			return &sortresult{&emptySortResult, nil, err}
+		}
+		x.AssertTrue(len(tokens) == 1)
+		// Start from the bucket of the cursor, in either direction.
+		cur.key = x.IndexKey(order.Attr, tokens[0])
+		seekKey = cur.key
+	case ts.Nulls == "first":
+		if err := addNulls(ctx, ts, out, typ, nil); err != nil {
+			return &sortresult{&emptySortResult, nil, err}
+		}
+	}
+	if seekKey == nil && !order.Desc {
 		// We need to seek to the first key of this index type.
 		seekKey = indexPrefix
-	} else {
+	} else if seekKey == nil {
 		// We need to reach the last key of this index type.
 		seekKey = x.IndexKey(order.Attr, string(tokenizer.Identifier()+1))
 	}
@@ -232,7 +259,7 @@ BUCKETS:
 			}
 			// Intersect every UID list with the index bucket, and update their
 			// results (in out).
-			err := intersectBucket(ctx, ts, token, out)
+			err := intersectBucket(ctx, ts, x.IndexKey(order.Attr, token), out, cur)
 			switch err {
 			case errDone:
 				break BUCKETS
@@ -245,20 +272,131 @@ BUCKETS:
 		}
 	}
 
-	for _, il := range out {
-		r.UidMatrix = append(r.UidMatrix, il.ulist)
-		if len(ts.Order) > 1 {
-			// TODO - For lossy tokenizer, no need to pick all values.
-			values = append(values, il.values)
+	if ts.Nulls == "last" {
+		if err := addNulls(ctx, ts, out, typ, nil); err != nil {
+			return &sortresult{&emptySortResult, nil, err}
 		}
 	}
+	return intersectedResult(ctx, ts, out)
+}
 
-	select {
-	case <-ctx.Done():
-		return &sortresult{&emptySortResult, nil, ctx.Err()}
-	default:
-		return &sortresult{r, values, nil}
+// addNulls adds the uids without a value, which aren't part of the index, to the pages of
+// the lists. cur is the cursor if it's among them.
+func addNulls(ctx context.Context, ts *protos.SortMessage, out []intersectedList,
+	typ types.TypeID, cur *sortCursor) error {
+	order := ts.Order[0]
+	for i, ul := range ts.UidMatrix {
+		il := &out[i]
+		if ts.Count > 0 && len(il.ulist.Uids)-il.ties >= int(ts.Count) {
+			continue
+		}
+		nulls := &protos.List{}
+		for _, uid := range ul.Uids {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			if _, err := fetchValue(uid, order.Attr, order.Langs, typ, ts.ReadTs); err != nil {
+				nulls.Uids = append(nulls.Uids, uid)
+			}
+		}
+		vals := func() ([]types.Val, error) {
+			vals := make([]types.Val, len(nulls.Uids))
+			for i := range vals {
+				vals[i].Tid = typ
+			}
+			return vals, nil
+		}
+		if err := il.add(ts, nulls, vals, cur); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// sortWithCountIndex sorts by the number of edges of the uids, iterating over the buckets
+// of the count index from the highest count.
+func sortWithCountIndex(ctx context.Context, ts *protos.SortMessage,
+	cur *sortCursor) *sortresult {
+	order := ts.Order[0]
+	attr, reverse := countAttr(order)
+	if !schema.State().HasCount(attr) {
+		return &sortresult{&emptySortResult, nil,
+			x.Errorf("Attribute %s doesn't have a count index.", attr)}
+	}
+	if !order.Desc {
+		// Uids without any edge aren't part of the count index and come first in ascending
+		// order, so the edges of every uid have to be counted anyway.
+		return &sortresult{&emptySortResult, nil,
+			x.Errorf("Count index of %s can only be used for orderdesc.", attr)}
+	}
+
+	out := newIntersectedLists(ts)
+	seekKey := x.CountKey(attr, math.MaxUint32, reverse)
+	if cur != nil {
+		c, ok := cur.vals[0].Value.(int64)
+		if !ok || c < 0 || c > math.MaxUint32 {
+			return &sortresult{&emptySortResult, nil, x.Errorf("Invalid cursor for count(%s)", attr)}
+		}
+		// Start from the bucket of the cursor.
+		cur.key = x.CountKey(attr, uint32(c), reverse)
+		seekKey = cur.key
+	}
+
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	iterOpt.Reverse = true
+	txn := pstore.NewTransactionAt(ts.ReadTs, false)
+	defer txn.Discard()
+	it := txn.NewIterator(iterOpt)
+	defer it.Close()
+
+	pk := x.ParsedKey{Attr: attr}
+	countPrefix := pk.CountPrefix(reverse)
+	for it.Seek(seekKey); it.ValidForPrefix(countPrefix); it.Next() {
+		select {
+		case <-ctx.Done():
+			return &sortresult{&emptySortResult, nil, ctx.Err()}
+		default:
+		}
+		key := make([]byte, len(it.Item().Key()))
+		copy(key, it.Item().Key())
+		switch err := intersectBucket(ctx, ts, key, out, cur); err {
+		case errDone:
+			return intersectedResult(ctx, ts, out)
+		case errContinue:
+		default:
+			return &sortresult{&emptySortResult, nil, err}
+		}
+	}
+
+	// The uids without any edge come last.
+	for i, ul := range ts.UidMatrix {
+		il := &out[i]
+		if ts.Count > 0 && len(il.ulist.Uids)-il.ties >= int(ts.Count) {
+			continue
+		}
+		zero := &protos.List{}
+		for _, uid := range ul.Uids {
+			v, err := fetchCount(uid, order, ts.ReadTs)
+			if err != nil {
+				return &sortresult{&emptySortResult, nil, err}
+			}
+			if v.Value.(int64) == 0 {
+				zero.Uids = append(zero.Uids, uid)
+			}
+		}
+		zcur := cur
+		if cur != nil && cur.vals[0].Value.(int64) != 0 {
+			zcur = nil
+		}
+		vals := func() ([]types.Val, error) { return countVals(len(zero.Uids), 0), nil }
+		if err := il.add(ts, zero, vals, zcur); err != nil {
+			return &sortresult{&emptySortResult, nil, err}
+		}
+	}
+	return intersectedResult(ctx, ts, out)
 }
 
 type orderResult struct {
@@ -267,7 +405,7 @@ type orderResult struct {
 	err error
 }
 
-func multiSort(ctx context.Context, r *sortresult, ts *protos.SortMessage) error {
+func multiSort(ctx context.Context, r *sortresult, ts *protos.SortMessage, cur *sortCursor) error {
 	// SrcUids for other queries are all the uids present in the response of the first sort.
 	dest := destUids(r.reply.UidMatrix)
 
@@ -303,6 +441,7 @@ func multiSort(ctx context.Context, r *sortresult, ts *protos.SortMessage) error
 			Attr:    ts.Order[i].Attr,
 			UidList: dest,
 			Langs:   ts.Order[i].Langs,
+			DoCount: ts.Order[i].Count,
 			LinRead: ts.LinRead,
 			ReadTs:  ts.ReadTs,
 		}
@@ -321,13 +460,21 @@ func multiSort(ctx context.Context, r *sortresult, ts *protos.SortMessage) error
 		}
 
 		result := or.r
+		if ts.Order[or.idx].Count {
+			x.AssertTrue(len(result.Counts) == len(dest.Uids))
+			for i, c := range result.Counts {
+				sortVals[i][or.idx] = types.Val{Tid: types.IntID, Value: int64(c)}
+			}
+			y.MergeLinReads(r.reply.LinRead, result.LinRead)
+			continue
+		}
 		x.AssertTrue(len(result.ValueMatrix) == len(dest.Uids))
 		for i, _ := range dest.Uids {
 			v := result.ValueMatrix[i].Values[0]
 			val := types.ValueForType(types.TypeID(v.ValType))
 			var sv types.Val
 			if bytes.Equal(v.Val, x.Nilbyte) {
-				// Assign nil value which is sorted as greater than all other values.
+				// Assign nil value which is sorted as given by the nulls of the sort.
 				sv.Value = nil
 				sv.Tid = val.Tid
 			} else {
@@ -360,14 +507,41 @@ func multiSort(ctx context.Context, r *sortresult, ts *protos.SortMessage) error
 			x.AssertTrue(idx >= 0)
 			vals[j] = sortVals[idx]
 		}
-		if err := types.Sort(vals, ul, desc); err != nil {
+		if err := types.SortWithNulls(vals, ul, desc, nullsFirst(ts)); err != nil {
 			return err
 		}
-		// Paginate
-		if len(ul.Uids) > int(ts.Count) {
-			ul.Uids = ul.Uids[:ts.Count]
+		if cur != nil {
+			// Now that all the values are known, drop the ones which were equal to the
+			// cursor on the first value but come before it.
+			uids := ul.Uids[:0]
+			filtered := vals[:0]
+			for j, uid := range ul.Uids {
+				c, err := cur.cmp(vals[j], uid)
+				if err != nil {
+					return err
+				}
+				if c > 0 {
+					uids = append(uids, uid)
+					filtered = append(filtered, vals[j])
+				}
+			}
+			ul.Uids, vals = uids, filtered
 		}
+		// Paginate
+		start, end := x.PageRange(int(ts.Count), int(ts.Offset), len(ul.Uids))
+		ul.Uids, vals = ul.Uids[start:end], vals[start:end]
 		r.reply.UidMatrix[i] = ul
+		if ts.ReturnCursors {
+			var last []types.Val
+			if len(ul.Uids) > 0 {
+				last = vals[len(ul.Uids)-1]
+			}
+			c, err := toCursor(ul.Uids, last)
+			if err != nil {
+				return err
+			}
+			r.reply.Cursors = append(r.reply.Cursors, c)
+		}
 	}
 
 	return nil
@@ -389,9 +563,24 @@ func processSort(ctx context.Context, ts *protos.SortMessage) (*protos.SortResul
 		return nil, x.Errorf("We do not yet support negative or infinite count with sorting: %s %d. "+
 			"Try flipping order and return first few elements instead.", ts.Order[0].Attr, ts.Count)
 	}
-	if schema.State().IsList(ts.Order[0].Attr) {
+	if !ts.Order[0].Count && schema.State().IsList(ts.Order[0].Attr) {
 		return nil, x.Errorf("Sorting not supported on attr: %s of type: [scalar]", ts.Order[0].Attr)
 	}
+	cur, err := newSortCursor(ts)
+	if err != nil {
+		return nil, err
+	}
+	// The uids equal on the first sort value are only ordered by multiSort, so the offset
+	// is applied after it and the first sort returns the uids before the page too.
+	first := ts
+	if len(ts.Order) > 1 && ts.Offset > 0 {
+		first = &protos.SortMessage{}
+		*first = *ts
+		if ts.Count > 0 {
+			first.Count += ts.Offset
+		}
+		first.Offset = 0
+	}
 
 	cctx, cancel := context.WithCancel(ctx)
 	resCh := make(chan *sortresult, 2)
@@ -403,12 +592,12 @@ func processSort(ctx context.Context, ts *protos.SortMessage) (*protos.SortResul
 			resCh <- &sortresult{err: ctx.Err()}
 			return
 		}
-		r := sortWithoutIndex(cctx, ts)
+		r := sortWithoutIndex(cctx, first, cur.clone())
 		resCh <- r
 	}()
 
 	go func() {
-		sr := sortWithIndex(cctx, ts)
+		sr := sortWithIndex(cctx, first, cur.clone())
 		resCh <- sr
 	}()
 
@@ -435,10 +624,23 @@ func processSort(ctx context.Context, ts *protos.SortMessage) (*protos.SortResul
 	r.reply.LinRead.Ids[n.RaftContext.Group] = n.Applied.DoneUntil()
 	// If request didn't have multiple attributes we return.
 	if len(ts.Order) <= 1 {
+		if ts.ReturnCursors {
+			for i, ul := range r.reply.UidMatrix {
+				var last []types.Val
+				if len(ul.Uids) > 0 {
+					last = r.vals[i][len(ul.Uids)-1 : len(ul.Uids)]
+				}
+				c, err := toCursor(ul.Uids, last)
+				if err != nil {
+					return nil, err
+				}
+				r.reply.Cursors = append(r.reply.Cursors, c)
+			}
+		}
 		return r.reply, nil
 	}
 
-	err := multiSort(ctx, r, ts)
+	err = multiSort(ctx, r, ts, cur)
 	return r.reply, err
 }
 
@@ -476,29 +678,66 @@ type intersectedList struct {
 	offset int
 	ulist  *protos.List
 	values []types.Val
+	// ties is the number of uids in ulist which are equal to the cursor on the first
+	// sort value, multiSort decides if they come after the cursor.
+	ties int
+}
+
+func newIntersectedLists(ts *protos.SortMessage) []intersectedList {
+	out := make([]intersectedList, len(ts.UidMatrix))
+	for i := range out {
+		// offsets[i] is the offset for i-th posting list. It gets decremented as we
+		// iterate over buckets.
+		out[i].offset = int(ts.Offset)
+		var emptyList protos.List
+		out[i].ulist = &emptyList
+	}
+	return out
+}
+
+// intersectedResult returns the result of a sort over the buckets of an index.
+func intersectedResult(ctx context.Context, ts *protos.SortMessage,
+	out []intersectedList) *sortresult {
+	r := new(protos.SortResult)
+	values := make([][]types.Val, 0, len(out)) // Values corresponding to uids in the uid matrix.
+	for _, il := range out {
+		r.UidMatrix = append(r.UidMatrix, il.ulist)
+		if needVals(ts) {
+			// TODO - For lossy tokenizer, no need to pick all values.
+			values = append(values, il.values)
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return &sortresult{&emptySortResult, nil, ctx.Err()}
+	default:
+		return &sortresult{r, values, nil}
+	}
 }
 
 // intersectBucket intersects every UID list in the UID matrix with the
 // indexed bucket.
-func intersectBucket(ctx context.Context, ts *protos.SortMessage, token string,
-	out []intersectedList) error {
+func intersectBucket(ctx context.Context, ts *protos.SortMessage, key []byte,
+	out []intersectedList, cur *sortCursor) error {
 	count := int(ts.Count)
 	order := ts.Order[0]
-	sType, err := schema.State().TypeOf(order.Attr)
-	if err != nil || !sType.IsScalar() {
-		return x.Errorf("Cannot sort attribute %s of type object.", order.Attr)
+	scalar, err := sortType(order)
+	if err != nil {
+		return err
+	}
+	if cur != nil && !bytes.Equal(key, cur.key) {
+		// Only the bucket of the cursor can have uids which come before it.
+		cur = nil
 	}
-	scalar := sType
 
-	key := x.IndexKey(order.Attr, token)
 	// Don't put the Index keys in memory.
 	pl := posting.GetNoStore(key)
-	var vals []types.Val
 
 	// For each UID list, we need to intersect with the index bucket.
 	for i, ul := range ts.UidMatrix {
 		il := &out[i]
-		if count > 0 && len(il.ulist.Uids) >= count {
+		if count > 0 && len(il.ulist.Uids)-il.ties >= count {
 			continue
 		}
 
@@ -511,55 +750,22 @@ func intersectBucket(ctx context.Context, ts *protos.SortMessage, token string,
 		if err != nil {
 			return err
 		}
-		n := len(result.Uids)
-
-		// Check offsets[i].
-		if il.offset >= n {
-			// We are going to skip the whole intersection. No need to do actual
-			// sorting. Just update offsets[i]. We now offset less.
-			il.offset -= n
-			continue
-		}
-
-		// We are within the page. We need to apply sorting.
-		// Sort results by value before applying offset.
-		if vals, err = sortByValue(ctx, ts, result, scalar); err != nil {
-			return err
-		}
-
-		// Result set might have reduced after sorting. As some uids might not have a
-		// value in the lang specified.
-		n = len(result.Uids)
-
-		if il.offset > 0 {
-			// Apply the offset.
-			result.Uids = result.Uids[il.offset:n]
-			if len(ts.Order) > 1 {
-				vals = vals[il.offset:n]
+		vals := func() ([]types.Val, error) {
+			if order.Count {
+				// All the uids of a bucket of the count index have the same count.
+				return countVals(len(result.Uids), int64(x.Parse(key).Count)), nil
 			}
-			il.offset = 0
-			n = len(result.Uids)
+			// Sort results by value before applying offset.
+			return sortByValue(ctx, ts, result, scalar)
 		}
-
-		// n is number of elements to copy from result to out.
-		// In case of multiple sort, we dont wan't to apply the count and copy all uids for the
-		// current bucket.
-		if count > 0 && (len(ts.Order) == 1) {
-			slack := count - len(il.ulist.Uids)
-			if slack < n {
-				n = slack
-			}
-		}
-
-		il.ulist.Uids = append(il.ulist.Uids, result.Uids[:n]...)
-		if len(ts.Order) > 1 {
-			il.values = append(il.values, vals[:n]...)
+		if err := il.add(ts, result, vals, cur); err != nil {
+			return err
 		}
 	} // end for loop over UID lists in UID matrix.
 
 	// Check out[i] sizes for all i.
 	for i := 0; i < len(ts.UidMatrix); i++ { // Iterate over UID lists.
-		if len(out[i].ulist.Uids) < count {
+		if len(out[i].ulist.Uids)-out[i].ties < count {
 			return errContinue
 		}
 
@@ -572,14 +778,79 @@ func intersectBucket(ctx context.Context, ts *protos.SortMessage, token string,
 	return errDone
 }
 
-func paginate(ts *protos.SortMessage, dest *protos.List, vals []types.Val) (int, int, error) {
+// add appends the uids of a bucket, intersected with the list, to the page. sortVals sorts
+// them and returns their sort values, it's only called if some of them are part of the
+// page. cur is the cursor if it's in the bucket.
+func (il *intersectedList) add(ts *protos.SortMessage, result *protos.List,
+	sortVals func() ([]types.Val, error), cur *sortCursor) error {
+	count := int(ts.Count)
+	n := len(result.Uids)
+
+	// Check offsets[i].
+	if il.offset >= n {
+		// We are going to skip the whole intersection. No need to do actual
+		// sorting. Just update offsets[i]. We now offset less.
+		il.offset -= n
+		return nil
+	}
+
+	// We are within the page. We need to apply sorting.
+	vals, err := sortVals()
+	if err != nil {
+		return err
+	}
+
+	if cur != nil {
+		var ties int
+		if vals, ties, err = cur.apply(result, vals); err != nil {
+			return err
+		}
+		il.ties += ties
+	}
+
+	// Result set might have reduced after sorting. As some uids might not have a
+	// value in the lang specified.
+	n = len(result.Uids)
+
+	if il.offset > 0 {
+		// Apply the offset.
+		result.Uids = result.Uids[il.offset:n]
+		if needVals(ts) {
+			vals = vals[il.offset:n]
+		}
+		il.offset = 0
+		n = len(result.Uids)
+	}
+
+	// n is number of elements to copy from result to out.
+	// In case of multiple sort, we dont wan't to apply the count and copy all uids for the
+	// current bucket.
+	if count > 0 && (len(ts.Order) == 1) {
+		slack := count - len(il.ulist.Uids)
+		if slack < n {
+			n = slack
+		}
+	}
+
+	il.ulist.Uids = append(il.ulist.Uids, result.Uids[:n]...)
+	if needVals(ts) {
+		il.values = append(il.values, vals[:n]...)
+	}
+	return nil
+}
+
+// paginate returns the range of the page in the sorted list. The first ties elements
+// are equal to the cursor and are always part of the page, see intersectedList.
+func paginate(ts *protos.SortMessage, dest *protos.List, vals []types.Val,
+	ties int) (int, int, error) {
 	count := int(ts.Count)
 	offset := int(ts.Offset)
-	start, end := x.PageRange(count, offset, len(dest.Uids))
+	start, end := x.PageRange(count, offset, len(dest.Uids)-ties)
+	end += ties
 
 	// For multiple sort, we need to take all equal values at the end. So we update end.
 	for len(ts.Order) > 1 && end < len(dest.Uids) {
-		eq, err := types.Equal(vals[end-1], vals[end])
+		eq, err := sameValue(vals[end-1], vals[end])
 		if err != nil {
 			return 0, 0, err
 		}
@@ -607,19 +878,36 @@ func sortByValue(ctx context.Context, ts *protos.SortMessage, ul *protos.List,
 		default:
 			uid := ul.Uids[i]
 			uids = append(uids, uid)
-			val, err := fetchValue(uid, order.Attr, order.Langs, typ, ts.ReadTs)
+			var val types.Val
+			var err error
+			if order.Count {
+				val, err = fetchCount(uid, order, ts.ReadTs)
+			} else {
+				val, err = fetchValue(uid, order.Attr, order.Langs, typ, ts.ReadTs)
+			}
 			if err != nil {
 				// Value couldn't be found or couldn't be converted to the sort
 				// type.  By using a nil Value, it will appear at the
-				// end (start) for orderasc (orderdesc).
-				val.Value = nil
+				// end (start) for orderasc (orderdesc), unless nulls say otherwise.
+				val = types.Val{Tid: typ}
 			}
 			values = append(values, []types.Val{val})
 		}
 	}
-	err := types.Sort(values, &protos.List{uids}, []bool{order.Desc})
+	err := types.SortWithNulls(values, &protos.List{uids}, []bool{order.Desc},
+		nullsFirst(ts)[:1])
+	if ts.Nulls == "exclude" {
+		kept := uids[:0]
+		for i, uid := range uids {
+			if values[i][0].Value != nil {
+				kept = append(kept, uid)
+				values[len(kept)-1] = values[i]
+			}
+		}
+		uids, values = kept, values[:len(kept)]
+	}
 	ul.Uids = uids
-	if len(ts.Order) > 1 {
+	if needVals(ts) {
 		for _, v := range values {
 			multiSortVals = append(multiSortVals, v[0])
 		}
@@ -645,3 +933,74 @@ func fetchValue(uid uint64, attr string, langs []string, scalar types.TypeID,
 
 	return dst, nil
 }
+
+// fetchCount gets the number of edges of a given UID for a sort by count.
+func fetchCount(uid uint64, order *protos.Order, readTs uint64) (types.Val, error) {
+	attr, reverse := countAttr(order)
+	key := x.DataKey(attr, uid)
+	if reverse {
+		key = x.ReverseKey(attr, uid)
+	}
+	// Don't put the posting lists in memory
+	n := posting.GetNoStore(key).Length(readTs, 0)
+	if n < 0 {
+		return types.Val{}, posting.ErrTsTooOld
+	}
+	return types.Val{Tid: types.IntID, Value: int64(n)}, nil
+}
+
+// countVals returns the sort values of n uids with the same number of edges.
+func countVals(n int, count int64) []types.Val {
+	vals := make([]types.Val, n)
+	for i := range vals {
+		vals[i] = types.Val{Tid: types.IntID, Value: count}
+	}
+	return vals
+}
+
+// nullsFirst returns for every sort key if the uids without a value come before the
+// others. They come last with nulls exclude, when they aren't left out, and by default a
+// missing value is greater than the others.
+func nullsFirst(ts *protos.SortMessage) []bool {
+	first := make([]bool, 0, len(ts.Order))
+	for _, o := range ts.Order {
+		switch ts.Nulls {
+		case "first":
+			first = append(first, true)
+		case "last", "exclude":
+			first = append(first, false)
+		default:
+			first = append(first, o.Desc)
+		}
+	}
+	return first
+}
+
+// sameValue returns true if two sort values are equal, or both missing.
+func sameValue(a, b types.Val) (bool, error) {
+	if a.Value == nil || b.Value == nil {
+		return a.Value == nil && b.Value == nil, nil
+	}
+	return types.Equal(a, b)
+}
+
+// countAttr returns the predicate of a sort by count and whether its reverse edges are
+// counted.
+func countAttr(order *protos.Order) (string, bool) {
+	if strings.HasPrefix(order.Attr, "~") {
+		return order.Attr[1:], true
+	}
+	return order.Attr, false
+}
+
+// sortType returns the type of the values the uids are sorted by.
+func sortType(order *protos.Order) (types.TypeID, error) {
+	if order.Count {
+		return types.IntID, nil
+	}
+	sType, err := schema.State().TypeOf(order.Attr)
+	if err != nil || !sType.IsScalar() {
+		return sType, x.Errorf("Cannot sort attribute %s of type object.", order.Attr)
+	}
+	return sType, nil
+}
@@ -32,10 +32,14 @@ type stringFilter struct {
 	funcName  string
 	funcType  FuncType
 	lang      string
+	analyzer  string // of the full-text index, if defined in the schema
 	tokens    []string
 	match     matchFn
 	ineqValue types.Val
 	eqVals    []types.Val
+
+	matchValue  string
+	maxDistance int
 }
 
 func matchStrings(uids *protos.List, values [][]types.Val, filter stringFilter) *protos.List {
@@ -83,6 +87,71 @@ func defaultMatch(value types.Val, filter stringFilter) bool {
 	}
 }
 
+// prefixMatch returns true if every term of the prefix starts some term of the value.
+func prefixMatch(value types.Val, filter stringFilter) bool {
+	tokens := tokenizeValue(value, filter)
+	for _, prefix := range filter.tokens {
+		var found bool
+		for _, token := range tokens {
+			if strings.HasPrefix(token, prefix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// fuzzyMatch returns true if the value is within the edit distance of the match string.
+func fuzzyMatch(value types.Val, filter stringFilter) bool {
+	return LevenshteinDistance(value.Value.(string), filter.matchValue, filter.maxDistance) <=
+		filter.maxDistance
+}
+
+// LevenshteinDistance returns the number of rune insertions, deletions and substitutions
+// needed to turn s into t. Once it is known to be over max, max+1 is returned.
+func LevenshteinDistance(s, t string, max int) int {
+	a, b := []rune(s), []rune(t)
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(b)-len(a) > max {
+		return max + 1
+	}
+	prev := make([]int, len(a)+1)
+	cur := make([]int, len(a)+1)
+	for i := range prev {
+		prev[i] = i
+	}
+	for j := 1; j <= len(b); j++ {
+		cur[0] = j
+		rowMin := cur[0]
+		for i := 1; i <= len(a); i++ {
+			cur[i] = prev[i-1]
+			if a[i-1] != b[j-1] {
+				cur[i]++
+			}
+			if prev[i]+1 < cur[i] {
+				cur[i] = prev[i] + 1
+			}
+			if cur[i-1]+1 < cur[i] {
+				cur[i] = cur[i-1] + 1
+			}
+			if cur[i] < rowMin {
+				rowMin = cur[i]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(a)]
+}
+
 func ineqMatch(value types.Val, filter stringFilter) bool {
 	if len(filter.eqVals) == 0 {
 		return types.CompareVals(filter.funcName, value, filter.ineqValue)
@@ -99,10 +168,10 @@ func ineqMatch(value types.Val, filter stringFilter) bool {
 func tokenizeValue(value types.Val, filter stringFilter) []string {
 	var tokName string
 	switch filter.funcType {
-	case StandardFn:
+	case StandardFn, PrefixFn:
 		tokName = "term"
 	case FullTextSearchFn:
-		tokName = tok.FtsTokenizerName(filter.lang)
+		tokName = tok.FullTextTokenizer{Lang: filter.lang, Analyzer: filter.analyzer}.Name()
 	}
 
 	tokenizer, found := tok.GetTokenizer(tokName)
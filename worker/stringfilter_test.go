@@ -0,0 +1,81 @@
+/*
+ * Copyright (C) 2017 Dgraph Labs, Inc. and Contributors
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package worker
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	cindex "github.com/google/codesearch/index"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		s, t string
+		d    int
+	}{
+		{"", "", 0},
+		{"john", "john", 0},
+		{"jonh", "john", 2},
+		{"john", "johnny", 2},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+		{"árvíz", "arviz", 2},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.d, LevenshteinDistance(tc.s, tc.t, math.MaxInt32), "%s %s", tc.s, tc.t)
+		require.Equal(t, tc.d, LevenshteinDistance(tc.t, tc.s, math.MaxInt32), "%s %s", tc.t, tc.s)
+	}
+	// The distance isn't computed past the maximum.
+	require.Equal(t, 2, LevenshteinDistance("kitten", "sitting", 1))
+	require.Equal(t, 2, LevenshteinDistance("a", "abcd", 1))
+}
+
+func TestMatchQuery(t *testing.T) {
+	q, err := matchQuery("Andreas", 1)
+	require.NoError(t, err)
+	require.Equal(t, cindex.QOr, q.Op)
+	require.Len(t, q.Sub, 2)
+	require.Equal(t, []string{"And"}, q.Sub[0].Trigram)
+	require.Equal(t, []string{"eas", "rea"}, q.Sub[1].Trigram)
+
+	// A typo at any place leaves one of the parts untouched.
+	for _, v := range []string{"Andrea", "Andeas", "Andreas Lee", "Xndreas"} {
+		var found bool
+		for _, sub := range q.Sub {
+			shared := 0
+			for _, tr := range sub.Trigram {
+				if strings.Contains(v, tr) {
+					shared++
+				}
+			}
+			found = found || shared == len(sub.Trigram)
+		}
+		require.True(t, found, v)
+	}
+
+	for _, tc := range []struct {
+		s string
+		d int
+	}{{"An", 0}, {"Andreas", 2}, {"abc", 5}} {
+		_, err := matchQuery(tc.s, tc.d)
+		require.Error(t, err, tc.s)
+	}
+}
@@ -224,6 +224,9 @@ const (
 	HasFn
 	UidInFn
 	CustomIndexFn
+	PrefixFn
+	MatchFn
+	ScoreFn
 	StandardFn = 100
 )
 
@@ -250,7 +253,7 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 	switch f {
 	case "le", "ge", "lt", "gt", "eq":
 		return CompareAttrFn, f
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance", "distinct":
 		return AggregatorFn, f
 	case "checkpwd":
 		return PasswordFn, f
@@ -264,6 +267,12 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return UidInFn, f
 	case "anyof", "allof":
 		return CustomIndexFn, f
+	case "prefix":
+		return PrefixFn, f
+	case "match":
+		return MatchFn, f
+	case "score":
+		return ScoreFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return GeoFn, f
@@ -274,7 +283,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 
 func needsIndex(fnType FuncType) bool {
 	switch fnType {
-	case CompareAttrFn, GeoFn, RegexFn, FullTextSearchFn, StandardFn:
+	case CompareAttrFn, GeoFn, RegexFn, FullTextSearchFn, StandardFn, PrefixFn, MatchFn,
+		ScoreFn:
 		return true
 	default:
 		return false
@@ -303,7 +313,8 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 			return false, nil
 		}
 		return true, nil
-	case GeoFn, RegexFn, FullTextSearchFn, StandardFn, HasFn, CustomIndexFn:
+	case GeoFn, RegexFn, FullTextSearchFn, StandardFn, HasFn, CustomIndexFn, PrefixFn,
+		MatchFn, ScoreFn:
 		// All of these require index, hence would require fetching uid postings.
 		return false, nil
 	case UidInFn, CompareScalarFn:
@@ -352,6 +363,9 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 		default:
 		}
 		key = x.DataKey(attr, q.UidList.Uids[i])
+		if q.ReadKeys {
+			out.Keys = append(out.Keys, string(key))
+		}
 
 		// Get or create the posting list for an entity, attribute combination.
 		pl := posting.Get(key)
@@ -467,6 +481,7 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 		return err
 	}
 
+	var numUids uint64
 	for i := 0; i < srcFn.n; i++ {
 		select {
 		case <-ctx.Done():
@@ -481,13 +496,16 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 			} else {
 				key = x.DataKey(attr, q.UidList.Uids[i])
 			}
-		case GeoFn, RegexFn, FullTextSearchFn, StandardFn, CustomIndexFn:
+		case GeoFn, RegexFn, FullTextSearchFn, StandardFn, CustomIndexFn, PrefixFn:
 			key = x.IndexKey(attr, srcFn.tokens[i])
 		case CompareAttrFn:
 			key = x.IndexKey(attr, srcFn.tokens[i])
 		default:
 			return x.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
 		}
+		if q.ReadKeys {
+			out.Keys = append(out.Keys, string(key))
+		}
 
 		// Get or create the posting list for an entity, attribute combination.
 		pl := posting.Get(key)
@@ -577,6 +595,10 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 				uidList.Uids = append(uidList.Uids, fres.uid)
 			}
 			out.UidMatrix = append(out.UidMatrix, uidList)
+			numUids += uint64(len(uidList.Uids))
+			if q.Budget > 0 && numUids > q.Budget {
+				return ErrBudgetExceeded
+			}
 		}
 	}
 	return nil
@@ -604,6 +626,8 @@ func processTask(ctx context.Context, q *protos.Query, gid uint32) (*protos.Resu
 	}
 	out.LinRead = &protos.LinRead{Ids: make(map[uint32]uint64)}
 	out.LinRead.Ids[n.RaftContext.Group] = n.Applied.DoneUntil()
+	out.GroupId = gid
+	out.NodeId = n.Id
 	return out, nil
 }
 
@@ -678,6 +702,20 @@ func helpProcessTask(ctx context.Context, q *protos.Query, gid uint32) (*protos.
 		}
 	}
 
+	if srcFn.fnType == MatchFn {
+		// Gather the uids sharing a trigram with the string and check their distance to it.
+		if err := handleMatchFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == ScoreFn {
+		// Score the uids by the full-text statistics of the terms kept in the index.
+		if err := handleScoreFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
 	// We fetch the actual value for the uids, compare them to the value in the
 	// request and filter the uids only if the tokenizer IsLossy.
 	if srcFn.fnType == CompareAttrFn && len(srcFn.tokens) > 0 {
@@ -697,13 +735,39 @@ func helpProcessTask(ctx context.Context, q *protos.Query, gid uint32) (*protos.
 	}
 
 	out.IntersectDest = srcFn.intersectDest
+	out.Access = srcFn.accessPath()
 	return out, nil
 }
 
+// accessPath describes how the posting lists for the function were read, so that
+// explain mode can tell an index lookup apart from a scan over the predicate.
+func (srcFn *functionContext) accessPath() string {
+	switch srcFn.fnType {
+	case CompareAttrFn:
+		if len(srcFn.tokens) > 0 {
+			return "index"
+		}
+	case GeoFn, RegexFn, FullTextSearchFn, StandardFn, CustomIndexFn, PrefixFn, MatchFn,
+		ScoreFn:
+		return "index"
+	case CompareScalarFn:
+		if srcFn.isFuncAtRoot {
+			return "count index"
+		}
+	case HasFn:
+		if srcFn.isFuncAtRoot {
+			return "scan"
+		}
+	}
+	// Posting lists are fetched directly for the uids sent in the query.
+	return "uid lookup"
+}
+
 func needsStringFiltering(srcFn *functionContext, langs []string) bool {
 	return srcFn.isStringFn && langForFunc(langs) != "." &&
 		(srcFn.fnType == StandardFn || srcFn.fnType == HasFn ||
-			srcFn.fnType == FullTextSearchFn || srcFn.fnType == CompareAttrFn)
+			srcFn.fnType == FullTextSearchFn || srcFn.fnType == CompareAttrFn ||
+			srcFn.fnType == PrefixFn)
 }
 
 func handleCompareScalarFunction(arg funcArgs) error {
@@ -724,14 +788,15 @@ func handleCompareScalarFunction(arg funcArgs) error {
 	return cp.evaluate(arg.out)
 }
 
-func handleRegexFunction(ctx context.Context, arg funcArgs) error {
-	attr := arg.q.Attr
+// checkTrigramIndex returns an error if attr isn't a string with a trigram index, which
+// regexp and match need.
+func checkTrigramIndex(attr, fname string) error {
 	typ, err := schema.State().TypeOf(attr)
 	if err != nil || !typ.IsScalar() {
 		return x.Errorf("Attribute not scalar: %s %v", attr, typ)
 	}
 	if typ != types.StringID {
-		return x.Errorf("Got non-string type. Regex match is allowed only on string type.")
+		return x.Errorf("Got non-string type. %s is allowed only on string type.", fname)
 	}
 	tokenizers := schema.State().TokenizerNames(attr)
 	var found bool
@@ -741,7 +806,15 @@ func handleRegexFunction(ctx context.Context, arg funcArgs) error {
 		}
 	}
 	if !found {
-		return x.Errorf("Attribute %v does not have trigram index for regex matching.", attr)
+		return x.Errorf("Attribute %v does not have trigram index for %s.", attr, fname)
+	}
+	return nil
+}
+
+func handleRegexFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	if err := checkTrigramIndex(attr, "regex matching"); err != nil {
+		return err
 	}
 
 	query := cindex.RegexpQuery(arg.srcFn.regex.Syntax)
@@ -789,6 +862,19 @@ func handleRegexFunction(ctx context.Context, arg funcArgs) error {
 	return nil
 }
 
+func handleMatchFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	if err := checkTrigramIndex(attr, "match"); err != nil {
+		return err
+	}
+	uids, err := uidsForMatch(attr, arg)
+	if err != nil {
+		return err
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, uids)
+	return filterStringFunction(arg)
+}
+
 func handleCompareFunction(ctx context.Context, arg funcArgs) error {
 	attr := arg.q.Attr
 	tokenizer, err := pickTokenizer(attr, arg.srcFn.fname)
@@ -937,8 +1023,18 @@ func filterStringFunction(arg funcArgs) error {
 		// done above.
 	case FullTextSearchFn, StandardFn:
 		filter.tokens = arg.srcFn.tokens
+		filter.analyzer = schema.State().TextAnalyzer(arg.q.Attr)
 		filter.match = defaultMatch
 		filtered = matchStrings(filtered, values, filter)
+	case PrefixFn:
+		filter.tokens = arg.srcFn.prefixes
+		filter.match = prefixMatch
+		filtered = matchStrings(filtered, values, filter)
+	case MatchFn:
+		filter.matchValue = arg.srcFn.matchValue
+		filter.maxDistance = arg.srcFn.maxDistance
+		filter.match = fuzzyMatch
+		filtered = matchStrings(filtered, values, filter)
 	case CompareAttrFn:
 		filter.ineqValue = arg.srcFn.ineqValue
 		filter.eqVals = arg.srcFn.eqTokens
@@ -980,6 +1076,9 @@ type functionContext struct {
 	fname          string
 	fnType         FuncType
 	regex          *cregexp.Regexp
+	prefixes       []string
+	matchValue     string
+	maxDistance    int
 	isFuncAtRoot   bool
 	isStringFn     bool
 	atype          types.TypeID
@@ -1118,7 +1217,8 @@ func parseSrcFn(q *protos.Query) (*functionContext, error) {
 		if !found {
 			return nil, x.Errorf("Attribute %s is not indexed with type %s", attr, required)
 		}
-		if fc.tokens, err = getStringTokens(q.SrcFunc.Args, langForFunc(q.Langs), fnType); err != nil {
+		if fc.tokens, err = getStringTokens(attr, q.SrcFunc.Args, langForFunc(q.Langs),
+			fnType); err != nil {
 			return nil, err
 		}
 		fnName := strings.ToLower(q.SrcFunc.Name)
@@ -1146,6 +1246,52 @@ func parseSrcFn(q *protos.Query) (*functionContext, error) {
 		x.AssertTrue(fnName == "allof" || fnName == "anyof")
 		fc.intersectDest = strings.HasSuffix(fnName, "allof")
 		fc.n = len(fc.tokens)
+	case PrefixFn:
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		tokenizer, found := pickNGramTokenizer(attr)
+		if !found {
+			return nil, x.Errorf("Attribute %s is not indexed with type edgengram or ngram",
+				attr)
+		}
+		if fc.tokens, err = tokenizer.PrefixTokens(q.SrcFunc.Args[0]); err != nil {
+			return nil, err
+		}
+		if len(fc.tokens) == 0 {
+			return nil, x.Errorf("Function '%s' requires a prefix with at least one term",
+				q.SrcFunc.Name)
+		}
+		// The values are checked against the whole terms, as the grams may be cut.
+		if fc.prefixes, err = tok.GetTokens(q.SrcFunc.Args); err != nil {
+			return nil, err
+		}
+		fc.intersectDest = true
+		fc.n = len(fc.tokens)
+	case MatchFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		fc.matchValue = q.SrcFunc.Args[0]
+		if fc.maxDistance, err = strconv.Atoi(q.SrcFunc.Args[1]); err != nil ||
+			fc.maxDistance < 0 {
+			return nil, x.Errorf("Function '%s' requires a non-negative distance, but got %s",
+				q.SrcFunc.Name, q.SrcFunc.Args[1])
+		}
+		fc.n = 0
+	case ScoreFn:
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		required, found := verifyStringIndex(attr, FullTextSearchFn)
+		if !found {
+			return nil, x.Errorf("Attribute %s is not indexed with type %s", attr, required)
+		}
+		if fc.tokens, err = getStringTokens(attr, q.SrcFunc.Args, langForFunc(q.Langs),
+			FullTextSearchFn); err != nil {
+			return nil, err
+		}
+		fc.n = 0
 	case RegexFn:
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
@@ -1531,6 +1677,9 @@ func handleHasFunction(ctx context.Context, q *protos.Query, out *protos.Result)
 			}
 		}
 		w++
+		if q.Budget > 0 && uint64(w) > q.Budget {
+			return ErrBudgetExceeded
+		}
 		tlist.Uids = append(tlist.Uids, pk.Uid)
 	}
 
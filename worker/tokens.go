@@ -65,19 +65,37 @@ func verifyCustomIndex(attr string, tokenizerName string) bool {
 }
 
 // Return string tokens from function arguments. It maps function type to correct tokenizer.
+// Full-text arguments are analyzed like the values of attr are.
 // Note: regexp functions require regexp compilation of argument, not tokenization.
-func getStringTokens(funcArgs []string, lang string, funcType FuncType) ([]string, error) {
+func getStringTokens(attr string, funcArgs []string, lang string,
+	funcType FuncType) ([]string, error) {
 	if lang == "." {
 		lang = "en"
 	}
 	switch funcType {
 	case FullTextSearchFn:
-		return tok.GetTextTokens(funcArgs, lang)
+		return tok.GetTextTokens(funcArgs, lang, schema.State().TextAnalyzer(attr))
 	default:
 		return tok.GetTokens(funcArgs)
 	}
 }
 
+// pickNGramTokenizer returns the n-gram tokenizer of attr, preferring edgengram as all
+// of its grams are prefixes.
+func pickNGramTokenizer(attr string) (tok.NGramTokenizer, bool) {
+	var picked tok.NGramTokenizer
+	if !schema.State().IsIndexed(attr) {
+		return picked, false
+	}
+	var found bool
+	for _, t := range schema.State().Tokenizer(attr) {
+		if nt, ok := t.(tok.NGramTokenizer); ok && (!found || nt.Edge) {
+			picked, found = nt, true
+		}
+	}
+	return picked, found
+}
+
 func pickTokenizer(attr string, f string) (tok.Tokenizer, error) {
 	// Get the tokenizers and choose the corresponding one.
 	if !schema.State().IsIndexed(attr) {
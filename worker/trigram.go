@@ -33,6 +33,8 @@ const maxUidsForTrigram = 1000000
 
 var regexTooWideErr = errors.New("Regular expression is too wide-ranging and can't be executed efficiently.")
 
+var matchTooWideErr = errors.New("Match string shares trigrams with too many values and can't be executed efficiently.")
+
 func uidsForRegex(attr string, arg funcArgs,
 	query *cindex.Query, intersect *protos.List) (*protos.List, error) {
 	var results *protos.List
@@ -114,3 +116,48 @@ func uidsForRegex(attr string, arg funcArgs,
 	}
 	return results, nil
 }
+
+// uidsForMatch returns the uids whose values may be within the distance of the string of
+// a match function, leaving it to the caller to check them. Under a filter, those are the
+// uids being filtered.
+func uidsForMatch(attr string, arg funcArgs) (*protos.List, error) {
+	if arg.q.UidList != nil {
+		return arg.q.UidList, nil
+	}
+	query, err := matchQuery(arg.srcFn.matchValue, arg.srcFn.maxDistance)
+	if err != nil {
+		return nil, err
+	}
+	empty := protos.List{}
+	results, err := uidsForRegex(attr, arg, query, &empty)
+	if err == regexTooWideErr {
+		return nil, matchTooWideErr
+	}
+	return results, err
+}
+
+// matchQuery returns the trigram query for the values within the distance of the string
+// of a match function. The string is split in distance+1 parts, as every edit changes at
+// most one of them the values have all the trigrams of at least one of the parts.
+func matchQuery(s string, distance int) (*cindex.Query, error) {
+	tooShort := x.Errorf("Match string %q is too short for a distance of %d, it should "+
+		"have at least 3 characters for every edit allowed and one more", s, distance)
+	runes := []rune(s)
+	if distance >= len(runes) {
+		return nil, tooShort
+	}
+	parts := distance + 1
+	query := &cindex.Query{Op: cindex.QOr}
+	for i := 0; i < parts; i++ {
+		part := string(runes[i*len(runes)/parts : (i+1)*len(runes)/parts])
+		trigrams, err := tok.TrigramTokenizer{}.Tokens(part)
+		if err != nil {
+			return nil, err
+		}
+		if len(trigrams) == 0 {
+			return nil, tooShort
+		}
+		query.Sub = append(query.Sub, &cindex.Query{Op: cindex.QAnd, Trigram: trigrams})
+	}
+	return query, nil
+}
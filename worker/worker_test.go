@@ -31,6 +31,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -146,6 +147,39 @@ func TestProcessTask(t *testing.T) {
 		}, algo.ToUintsListForTest(r.UidMatrix))
 }
 
+func TestProcessTaskReadKeys(t *testing.T) {
+	dir, ps := initTest(t, `
+		neighbour: uid .
+		friend:string @index(term) .
+	`)
+	defer os.RemoveAll(dir)
+	defer ps.Close()
+
+	query := newQuery("neighbour", []uint64{10, 11}, nil)
+	r, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.Empty(t, r.Keys)
+
+	query.ReadKeys = true
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{string(x.DataKey("neighbour", 10)),
+		string(x.DataKey("neighbour", 11))}, r.Keys)
+
+	// The index keys of the terms are read even if no value has them.
+	query = newQuery("friend", nil, []string{"anyofterms", "", "hey photon"})
+	query.ReadKeys = true
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	var terms []string
+	for _, key := range r.Keys {
+		pk := x.Parse([]byte(key))
+		require.True(t, pk.IsIndex())
+		terms = append(terms, pk.Term[1:])
+	}
+	require.Equal(t, []string{"hey", "photon"}, terms)
+}
+
 // newQuery creates a Query task and returns it.
 func newQuery(attr string, uids []uint64, srcFunc []string) *protos.Query {
 	x.AssertTrue(uids == nil || srcFunc == nil)
@@ -621,6 +655,161 @@ func TestProcessSortOffsetCount(t *testing.T) {
 }
 */
 
+func TestProcessTaskPrefix(t *testing.T) {
+	dir, ps := initTest(t, `friend:string @index(edgengram) .`)
+	defer os.RemoveAll(dir)
+	defer ps.Close()
+
+	edge := &protos.DirectedEdge{
+		Value:  []byte("phone booth"),
+		Label:  "author0",
+		Attr:   "friend",
+		Entity: 11,
+	}
+	addEdge(t, edge, getOrCreate(x.DataKey("friend", 11)))
+
+	query := newQuery("friend", nil, []string{"prefix", "", "pho"})
+	r, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{10, 11, 12}}, algo.ToUintsListForTest(r.UidMatrix))
+
+	query = newQuery("friend", nil, []string{"prefix", "", "phot"})
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{10, 12}}, algo.ToUintsListForTest(r.UidMatrix))
+}
+
+func TestProcessTaskMatch(t *testing.T) {
+	dir, ps := initTest(t, `friend:string @index(trigram) .`)
+	defer os.RemoveAll(dir)
+	defer ps.Close()
+
+	edge := &protos.DirectedEdge{
+		Value:  []byte("proton"),
+		Label:  "author0",
+		Attr:   "friend",
+		Entity: 11,
+	}
+	addEdge(t, edge, getOrCreate(x.DataKey("friend", 11)))
+
+	// The trigrams are only looked up at root, which has no uids to filter.
+	query := newQuery("friend", nil, []string{"match", "", "fhoton", "1"})
+	query.UidList = nil
+	r, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{10, 12}}, algo.ToUintsListForTest(r.UidMatrix))
+
+	// The typo in proton leaves its first part.
+	query = newQuery("friend", nil, []string{"match", "", "protom", "1"})
+	query.UidList = nil
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{11}}, algo.ToUintsListForTest(r.UidMatrix))
+
+	// The parts of the string would be shorter than a trigram.
+	query = newQuery("friend", nil, []string{"match", "", "fhoton", "2"})
+	query.UidList = nil
+	_, err = helpProcessTask(context.Background(), query, 1)
+	require.Error(t, err)
+}
+
+func TestProcessTaskScore(t *testing.T) {
+	dir, ps := initTest(t, `friend:string @index(fulltext) .`)
+	defer os.RemoveAll(dir)
+	defer ps.Close()
+
+	for uid, value := range map[uint64]string{
+		11: "photon reflects photon",
+		13: "mirror reflects photon",
+		14: "mirror",
+	} {
+		edge := &protos.DirectedEdge{
+			Value:  []byte(value),
+			Label:  "author0",
+			Attr:   "friend",
+			Entity: uid,
+		}
+		addEdge(t, edge, getOrCreate(x.DataKey("friend", uid)))
+	}
+
+	query := newQuery("friend", []uint64{10, 11, 12, 13, 14}, nil)
+	query.SrcFunc = &protos.SrcFunction{Name: "score", Args: []string{"photon"}}
+	r, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.Len(t, r.ValueMatrix, 5)
+	var scores []float64
+	for _, vl := range r.ValueMatrix {
+		v, err := types.Convert(types.Val{Tid: types.BinaryID, Value: vl.Values[0].Val},
+			types.FloatID)
+		require.NoError(t, err)
+		scores = append(scores, v.Value.(float64))
+	}
+	// 10 and 12 are "photon", and the values of 11 and 13 have three terms.
+	require.InDelta(t, bm25(1, 1, 4, 5, 9.0/5), scores[0], 1e-9)
+	require.Equal(t, scores[0], scores[2])
+	require.InDelta(t, bm25(2, 3, 4, 5, 9.0/5), scores[1], 1e-9)
+	require.True(t, scores[1] > scores[3])
+	require.True(t, scores[0] > scores[3])
+	require.Equal(t, 0.0, scores[4])
+}
+
+func TestProcessTaskAnalyzer(t *testing.T) {
+	dir, ps := initTest(t, `
+		analyzer recipes {
+			token_filters: [lowercase, synonyms, porter]
+			synonyms: ["eggplant => aubergine"]
+		}
+		friend:string @index(fulltext(recipes)) .
+	`)
+	defer os.RemoveAll(dir)
+	defer ps.Close()
+
+	for uid, value := range map[uint64]string{
+		11: "Roasted Aubergines",
+		13: "eggplant curry",
+	} {
+		edge := &protos.DirectedEdge{
+			Value:  []byte(value),
+			Label:  "author0",
+			Attr:   "friend",
+			Entity: uid,
+		}
+		addEdge(t, edge, getOrCreate(x.DataKey("friend", uid)))
+	}
+
+	// The function is analyzed like the values, by the analyzer of the index.
+	query := newQuery("friend", nil, []string{"anyoftext", "", "Eggplant"})
+	query.UidList = nil
+	r, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{11, 13}}, algo.ToUintsListForTest(r.UidMatrix))
+
+	query = newQuery("friend", nil, []string{"alloftext", "", "aubergine curries"})
+	query.UidList = nil
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{13}, {13}}, algo.ToUintsListForTest(r.UidMatrix))
+}
+
+func TestAccessPath(t *testing.T) {
+	tests := []struct {
+		fn   functionContext
+		path string
+	}{
+		{functionContext{fnType: NotAFunction}, "uid lookup"},
+		{functionContext{fnType: StandardFn}, "index"},
+		{functionContext{fnType: CompareAttrFn, tokens: []string{"a"}}, "index"},
+		{functionContext{fnType: CompareAttrFn}, "uid lookup"},
+		{functionContext{fnType: CompareScalarFn, isFuncAtRoot: true}, "count index"},
+		{functionContext{fnType: CompareScalarFn}, "uid lookup"},
+		{functionContext{fnType: HasFn, isFuncAtRoot: true}, "scan"},
+		{functionContext{fnType: HasFn}, "uid lookup"},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.path, tc.fn.accessPath())
+	}
+}
+
 func TestMain(m *testing.M) {
 	x.Init(true)
 	posting.Config.AllottedMemory = 1024.0
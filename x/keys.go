@@ -34,6 +34,8 @@ const (
 	// keys of same attributes are located together
 	defaultPrefix = byte(0x00)
 	byteSchema    = byte(0x01)
+	byteTypeDef   = byte(0x02)
+	byteAnalyzer  = byte(0x03)
 )
 
 func writeAttr(buf []byte, attr string) []byte {
@@ -58,6 +60,52 @@ func SchemaKey(attr string) []byte {
 	return buf
 }
 
+// TypeKey returns the key for the definition of the given type. Like schema keys,
+// type keys are stored separately with their own prefix.
+func TypeKey(typeName string) []byte {
+	buf := make([]byte, 1+2+len(typeName))
+	buf[0] = byteTypeDef
+	rest := buf[1:]
+
+	writeAttr(rest, typeName)
+	return buf
+}
+
+// TypeName returns the name of the type stored in the given type key.
+func TypeName(key []byte) (string, error) {
+	if len(key) < 3 || key[0] != byteTypeDef {
+		return "", Errorf("Invalid type key: %q", key)
+	}
+	sz := int(binary.BigEndian.Uint16(key[1:3]))
+	if len(key) != 3+sz {
+		return "", Errorf("Invalid type key: %q", key)
+	}
+	return string(key[3:]), nil
+}
+
+// AnalyzerKey returns the key for the definition of the given full-text analyzer. Like
+// type keys, analyzer keys are stored separately with their own prefix.
+func AnalyzerKey(name string) []byte {
+	buf := make([]byte, 1+2+len(name))
+	buf[0] = byteAnalyzer
+	rest := buf[1:]
+
+	writeAttr(rest, name)
+	return buf
+}
+
+// AnalyzerName returns the name of the analyzer stored in the given analyzer key.
+func AnalyzerName(key []byte) (string, error) {
+	if len(key) < 3 || key[0] != byteAnalyzer {
+		return "", Errorf("Invalid analyzer key: %q", key)
+	}
+	sz := int(binary.BigEndian.Uint16(key[1:3]))
+	if len(key) != 3+sz {
+		return "", Errorf("Invalid analyzer key: %q", key)
+	}
+	return string(key[3:]), nil
+}
+
 func DataKey(attr string, uid uint64) []byte {
 	buf := make([]byte, 2+len(attr)+2+8)
 	buf[0] = defaultPrefix
@@ -240,6 +288,20 @@ func SchemaPrefix() []byte {
 	return buf[:]
 }
 
+// TypePrefix returns the prefix for type keys.
+func TypePrefix() []byte {
+	var buf [1]byte
+	buf[0] = byteTypeDef
+	return buf[:]
+}
+
+// AnalyzerPrefix returns the prefix for analyzer keys.
+func AnalyzerPrefix() []byte {
+	var buf [1]byte
+	buf[0] = byteAnalyzer
+	return buf[:]
+}
+
 // PredicatePrefix returns the prefix for all keys belonging
 // to this predicate except schema key.
 func PredicatePrefix(predicate string) []byte {
@@ -263,6 +325,9 @@ func Parse(key []byte) *ParsedKey {
 	switch p.bytePrefix {
 	case byteSchema:
 		return p
+	case byteTypeDef, byteAnalyzer:
+		// Type and analyzer definitions don't belong to any predicate.
+		return nil
 	default:
 	}
 
@@ -91,3 +91,27 @@ func TestSchemaKey(t *testing.T) {
 		require.Equal(t, sattr, pk.Attr)
 	}
 }
+
+func TestTypeKey(t *testing.T) {
+	key := TypeKey("Person")
+	require.Nil(t, Parse(key))
+
+	name, err := TypeName(key)
+	require.NoError(t, err)
+	require.Equal(t, "Person", name)
+
+	_, err = TypeName(SchemaKey("Person"))
+	require.Error(t, err)
+}
+
+func TestAnalyzerKey(t *testing.T) {
+	key := AnalyzerKey("recipes")
+	require.Nil(t, Parse(key))
+
+	name, err := AnalyzerName(key)
+	require.NoError(t, err)
+	require.Equal(t, "recipes", name)
+
+	_, err = AnalyzerName(TypeKey("recipes"))
+	require.Error(t, err)
+}
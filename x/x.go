@@ -51,6 +51,8 @@ const (
 	GrpcMaxSize             = 256 << 20
 	// The attr used to store list of predicates for a node.
 	PredicateListAttr = "_predicate_"
+	// The attr used to store the types of a node.
+	TypeAttr = "_type_"
 
 	PortInternal = 7080
 	PortHTTP     = 8080